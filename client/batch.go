@@ -32,6 +32,16 @@ func (b *Batch) AddBlockResultsRequest(blockNum uint64) error {
 	return nil
 }
 
+// AddValidatorsRequest adds a new validator set request (validators fetch) to the batch
+func (b *Batch) AddValidatorsRequest(blockNum uint64) error {
+	bn := int64(blockNum)
+	if err := b.batch.Validators(&bn); err != nil {
+		return fmt.Errorf("unable to add validators request, %w", err)
+	}
+
+	return nil
+}
+
 // Execute sends the batch off for processing by the node
 func (b *Batch) Execute(ctx context.Context) ([]any, error) {
 	return b.batch.Send(ctx)
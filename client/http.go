@@ -6,6 +6,7 @@ import (
 
 	rpcClient "github.com/gnolang/gno/tm2/pkg/bft/rpc/client"
 	core_types "github.com/gnolang/gno/tm2/pkg/bft/rpc/core/types"
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
 
 	clientTypes "github.com/gnolang/tx-indexer/client/types"
 )
@@ -73,3 +74,32 @@ func (c *Client) GetBlockResults(ctx context.Context, blockNum uint64) (*core_ty
 
 	return results, nil
 }
+
+func (c *Client) GetValidators(ctx context.Context, blockNum uint64) (*core_types.ResultValidators, error) {
+	bn := int64(blockNum)
+
+	validators, err := c.client.Validators(ctx, &bn)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get validators, %w", err)
+	}
+
+	return validators, nil
+}
+
+func (c *Client) GetUnconfirmedTxs(ctx context.Context, limit int) (*core_types.ResultUnconfirmedTxs, error) {
+	txs, err := c.client.UnconfirmedTxs(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get unconfirmed txs, %w", err)
+	}
+
+	return txs, nil
+}
+
+func (c *Client) BroadcastTxSync(ctx context.Context, tx types.Tx) (*core_types.ResultBroadcastTx, error) {
+	result, err := c.client.BroadcastTxSync(ctx, tx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to broadcast tx, %w", err)
+	}
+
+	return result, nil
+}
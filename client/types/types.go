@@ -10,6 +10,9 @@ type Batch interface {
 	// AddBlockResultsRequest adds a new block results request (block results fetch) to the batch
 	AddBlockResultsRequest(uint64) error
 
+	// AddValidatorsRequest adds a new validator set request (validators fetch) to the batch
+	AddValidatorsRequest(uint64) error
+
 	// Execute sends the batch off for processing by the node
 	Execute(context.Context) ([]any, error)
 
@@ -16,7 +16,9 @@ import (
 	"github.com/gnolang/tx-indexer/client"
 	"github.com/gnolang/tx-indexer/events"
 	"github.com/gnolang/tx-indexer/fetch"
+	"github.com/gnolang/tx-indexer/mempool"
 	"github.com/gnolang/tx-indexer/serve"
+	"github.com/gnolang/tx-indexer/serve/filters"
 	"github.com/gnolang/tx-indexer/serve/graph"
 	"github.com/gnolang/tx-indexer/serve/health"
 	"github.com/gnolang/tx-indexer/storage"
@@ -38,7 +40,15 @@ type startCfg struct {
 
 	rateLimit int
 
+	mempoolPollInterval time.Duration
+	mempoolMaxTxs       int
+
+	filterCleanupInterval time.Duration
+	maxFilterChanges      int
+	maxClientFilters      int
+
 	disableIntrospection bool
+	enableMempool        bool
 }
 
 // newStartCmd creates the indexer start command
@@ -117,6 +127,49 @@ func (c *startCfg) registerFlags(fs *flag.FlagSet) {
 		false,
 		"disable GraphQL introspection queries if needed. This will cause malfunctions when using the GraphQL playground",
 	)
+
+	fs.BoolVar(
+		&c.enableMempool,
+		"enable-mempool",
+		false,
+		"track the pending transactions of the node mempool, until they are included or dropped",
+	)
+
+	fs.DurationVar(
+		&c.mempoolPollInterval,
+		"mempool-poll-interval",
+		mempool.DefaultPollInterval,
+		"the interval for polling the node mempool, if mempool tracking is enabled",
+	)
+
+	fs.IntVar(
+		&c.mempoolMaxTxs,
+		"mempool-max-txs",
+		mempool.DefaultMaxSize,
+		"the maximum amount of pending transactions kept in memory, if mempool tracking is enabled",
+	)
+
+	fs.DurationVar(
+		&c.filterCleanupInterval,
+		"filter-cleanup-interval",
+		filters.DefaultCleanupInterval,
+		"the interval after which unused (not polled) JSON-RPC filters are removed",
+	)
+
+	fs.IntVar(
+		&c.maxFilterChanges,
+		"max-filter-changes",
+		filters.DefaultMaxFilterChanges,
+		"the maximum amount of changes a JSON-RPC filter buffers between polls, "+
+			"the rest are recovered from storage. Unlimited if 0",
+	)
+
+	fs.IntVar(
+		&c.maxClientFilters,
+		"max-client-filters",
+		filters.DefaultMaxClientFilters,
+		"the maximum amount of JSON-RPC filters per client IP. Unlimited if 0",
+	)
 }
 
 // exec executes the indexer start command
@@ -148,6 +201,11 @@ func (c *startCfg) exec(ctx context.Context) error {
 		}
 	}()
 
+	// Backfill the block fee summaries missing from older DBs
+	if err := fetch.BackfillBlockFees(ctx, db, logger.Named("fetcher")); err != nil {
+		return fmt.Errorf("unable to backfill block fees, %w", err)
+	}
+
 	// Create an Event Manager instance
 	em := events.NewManager()
 
@@ -169,11 +227,34 @@ func (c *startCfg) exec(ctx context.Context) error {
 		fetch.WithMaxChunkSize(c.maxChunkSize),
 	)
 
+	// Create the mempool poller, if enabled
+	var (
+		pool   *mempool.Pool
+		poller *mempool.Poller
+	)
+
+	if c.enableMempool {
+		pool = mempool.NewPool(c.mempoolMaxTxs)
+		poller = mempool.NewPoller(
+			pool,
+			tm2Client,
+			em,
+			mempool.WithLogger(
+				logger.Named("mempool"),
+			),
+			mempool.WithPollInterval(c.mempoolPollInterval),
+		)
+	}
+
 	// Create the JSON-RPC service
 	j := setupJSONRPC(
 		db,
 		em,
+		tm2Client,
 		logger,
+		filters.WithCleanupInterval(c.filterCleanupInterval),
+		filters.WithMaxFilterChanges(c.maxFilterChanges),
+		filters.WithMaxClientFilters(c.maxClientFilters),
 	)
 
 	mux := chi.NewMux()
@@ -196,7 +277,7 @@ func (c *startCfg) exec(ctx context.Context) error {
 	}
 
 	mux = j.SetupRoutes(mux)
-	mux = graph.Setup(db, em, mux, c.disableIntrospection)
+	mux = graph.Setup(db, em, pool, mux, c.disableIntrospection)
 	mux = health.Setup(db, f, mux)
 
 	// Create the HTTP server
@@ -208,6 +289,11 @@ func (c *startCfg) exec(ctx context.Context) error {
 	// Add the fetcher service
 	w.add(f.FetchChainData)
 
+	// Add the mempool poller, if enabled
+	if poller != nil {
+		w.add(poller.Run)
+	}
+
 	// Add the JSON-RPC service
 	w.add(hs.Serve)
 
@@ -222,7 +308,9 @@ func (c *startCfg) exec(ctx context.Context) error {
 func setupJSONRPC(
 	db *storage.Pebble,
 	em *events.Manager,
+	tm2Client *client.Client,
 	logger *zap.Logger,
+	filterOpts ...filters.Option,
 ) *serve.JSONRPC {
 	j := serve.NewJSONRPC(
 		em,
@@ -240,8 +328,14 @@ func setupJSONRPC(
 	// Block handlers
 	j.RegisterBlockEndpoints(db)
 
+	// Lookup handlers
+	j.RegisterLookupEndpoints(db)
+
 	// Sub handlers
-	j.RegisterSubEndpoints(db)
+	j.RegisterSubEndpoints(db, filterOpts...)
+
+	// Broadcast handlers
+	j.RegisterBroadcastEndpoints(tm2Client, db)
 
 	return j
 }
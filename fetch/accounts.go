@@ -0,0 +1,211 @@
+package fetch
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/gnolang/gno/gno.land/pkg/sdk/vm"
+	bft_types "github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/sdk/bank"
+	"github.com/gnolang/gno/tm2/pkg/std"
+
+	"github.com/gnolang/tx-indexer/storage"
+	storageErrors "github.com/gnolang/tx-indexer/storage/errors"
+	"github.com/gnolang/tx-indexer/types"
+)
+
+// accountTx is a single address <> transaction link
+type accountTx struct {
+	address  string
+	blockNum uint64
+	txIndex  uint32
+}
+
+var (
+	_ txIndexer      = &accountIndexer{}
+	_ genesisIndexer = &accountIndexer{}
+)
+
+// accountIndexer keeps the account activity summaries
+// up to date with the transactions of a single slot
+type accountIndexer struct {
+	storage  storage.Reader
+	accounts map[string]*types.Account
+	txs      []accountTx
+}
+
+// newAccountIndexer creates a new account indexer for a single slot write
+func newAccountIndexer(storage storage.Reader) *accountIndexer {
+	return &accountIndexer{
+		storage:  storage,
+		accounts: make(map[string]*types.Account),
+		txs:      make([]accountTx, 0),
+	}
+}
+
+// indexGenesis records the genesis balances of the accounts
+func (ai *accountIndexer) indexGenesis(genesis *types.Genesis) error {
+	for _, balance := range genesis.Balances {
+		account, err := ai.getAccount(balance.Address)
+		if err != nil {
+			return err
+		}
+
+		account.GenesisBalance = account.GenesisBalance.Add(balance.Coins)
+	}
+
+	return nil
+}
+
+// indexTx applies the transaction to the summaries of all the addresses
+// taking part in it (signers and bank recipients).
+// Coin movements, realm calls and package deployments are only
+// accounted for if the transaction was successful
+func (ai *accountIndexer) indexTx(block *bft_types.Block, txResult *bft_types.TxResult, tx *std.Tx) error {
+	var (
+		success      = txResult.Response.IsOK()
+		participants = make([]string, 0)
+		seen         = make(map[string]struct{})
+	)
+
+	participate := func(address string) (*types.Account, error) {
+		account, err := ai.getAccount(address)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := seen[address]; !ok {
+			seen[address] = struct{}{}
+			participants = append(participants, address)
+		}
+
+		return account, nil
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		for _, signer := range msg.GetSigners() {
+			if _, err := participate(signer.String()); err != nil {
+				return err
+			}
+		}
+
+		switch m := msg.(type) {
+		case bank.MsgSend:
+			sender, err := participate(m.FromAddress.String())
+			if err != nil {
+				return err
+			}
+
+			recipient, err := participate(m.ToAddress.String())
+			if err != nil {
+				return err
+			}
+
+			if success {
+				sender.Sent = sender.Sent.AddUnsafe(m.Amount)
+				recipient.Received = recipient.Received.AddUnsafe(m.Amount)
+			}
+		case vm.MsgCall:
+			caller, err := participate(m.Caller.String())
+			if err != nil {
+				return err
+			}
+
+			if success {
+				caller.RealmsCalled = insertSorted(caller.RealmsCalled, m.PkgPath)
+			}
+		case vm.MsgAddPackage:
+			creator, err := participate(m.Creator.String())
+			if err != nil {
+				return err
+			}
+
+			if success && m.Package != nil {
+				creator.PackagesDeployed = insertSorted(creator.PackagesDeployed, m.Package.Path)
+			}
+		}
+	}
+
+	for _, address := range participants {
+		account := ai.accounts[address]
+
+		if account.TxCount == 0 {
+			account.FirstSeenHeight = block.Height
+			account.FirstSeenTime = block.Time
+		}
+
+		account.LastSeenHeight = block.Height
+		account.LastSeenTime = block.Time
+		account.TxCount++
+
+		ai.txs = append(ai.txs, accountTx{
+			address:  address,
+			blockNum: uint64(txResult.Height),
+			txIndex:  txResult.Index,
+		})
+	}
+
+	return nil
+}
+
+// getAccount fetches the account summary from the slot cache,
+// falling back to the storage, or creating a new one if it doesn't exist
+func (ai *accountIndexer) getAccount(address string) (*types.Account, error) {
+	if account, ok := ai.accounts[address]; ok {
+		return account, nil
+	}
+
+	account, err := ai.storage.GetAccount(address)
+	if errors.Is(err, storageErrors.ErrNotFound) {
+		account = &types.Account{
+			Address: address,
+		}
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to fetch account %s, %w", address, err)
+	}
+
+	ai.accounts[address] = account
+
+	return account, nil
+}
+
+// flush writes the account data gathered so far to the batch
+func (ai *accountIndexer) flush(wb storage.Batch) error {
+	for _, tx := range ai.txs {
+		if err := wb.SetAccountTx(tx.address, tx.blockNum, tx.txIndex); err != nil {
+			return fmt.Errorf("unable to save account tx, %w", err)
+		}
+	}
+
+	addresses := make([]string, 0, len(ai.accounts))
+	for address, account := range ai.accounts {
+		if account.TxCount == 0 && account.GenesisBalance.IsZero() {
+			// Never took part in an indexed transaction, nor in the genesis
+			continue
+		}
+
+		addresses = append(addresses, address)
+	}
+
+	sort.Strings(addresses)
+
+	for _, address := range addresses {
+		if err := wb.SetAccount(ai.accounts[address]); err != nil {
+			return fmt.Errorf("unable to save account %s, %w", address, err)
+		}
+	}
+
+	return nil
+}
+
+// insertSorted inserts the value into the sorted list, if not present
+func insertSorted(list []string, value string) []string {
+	index, found := slices.BinarySearch(list, value)
+	if found {
+		return list
+	}
+
+	return slices.Insert(list, index, value)
+}
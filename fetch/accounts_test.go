@@ -0,0 +1,153 @@
+package fetch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gnolang/gno/gno.land/pkg/sdk/vm"
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	"github.com/gnolang/gno/tm2/pkg/sdk/bank"
+	"github.com/gnolang/gno/tm2/pkg/std"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/tx-indexer/internal/mock"
+	storageErrors "github.com/gnolang/tx-indexer/storage/errors"
+	indexerTypes "github.com/gnolang/tx-indexer/types"
+)
+
+// newTxResult creates a new tx result from the given messages
+func newTxResult(t *testing.T, height int64, index uint32, success bool, msgs ...std.Msg) *types.TxResult {
+	t.Helper()
+
+	encodedTx, err := amino.Marshal(&std.Tx{
+		Msgs: msgs,
+	})
+	require.NoError(t, err)
+
+	response := abci.ResponseDeliverTx{}
+	if !success {
+		response.Error = abci.StringError("failed")
+	}
+
+	return &types.TxResult{
+		Height:   height,
+		Index:    index,
+		Tx:       encodedTx,
+		Response: response,
+	}
+}
+
+func TestAccountIndexer_IndexTx(t *testing.T) {
+	t.Parallel()
+
+	var (
+		sender    = crypto.AddressFromPreimage([]byte("sender"))
+		recipient = crypto.AddressFromPreimage([]byte("recipient"))
+
+		existingAccount = &indexerTypes.Account{
+			Address:         recipient.String(),
+			FirstSeenHeight: 1,
+			FirstSeenTime:   time.Unix(1, 0),
+			LastSeenHeight:  1,
+			LastSeenTime:    time.Unix(1, 0),
+			TxCount:         1,
+			Received:        std.NewCoins(std.NewCoin("ugnot", 10)),
+		}
+
+		savedAccounts = make(map[string]*indexerTypes.Account)
+		savedLinks    = make(map[string][]uint32)
+
+		mockStorage = &mock.Storage{
+			GetAccountFn: func(address string) (*indexerTypes.Account, error) {
+				if address == existingAccount.Address {
+					return existingAccount, nil
+				}
+
+				return nil, storageErrors.ErrNotFound
+			},
+		}
+
+		mockBatch = &mock.WriteBatch{
+			SetAccountFn: func(account *indexerTypes.Account) error {
+				savedAccounts[account.Address] = account
+
+				return nil
+			},
+			SetAccountTxFn: func(address string, _ uint64, txIndex uint32) error {
+				savedLinks[address] = append(savedLinks[address], txIndex)
+
+				return nil
+			},
+		}
+
+		block = &types.Block{
+			Header: types.Header{
+				Height: 10,
+				Time:   time.Unix(10, 0),
+			},
+		}
+
+		coins = std.NewCoins(std.NewCoin("ugnot", 100))
+	)
+
+	txs := []*types.TxResult{
+		newTxResult(t, 10, 0, true, bank.MsgSend{
+			FromAddress: sender,
+			ToAddress:   recipient,
+			Amount:      coins,
+		}),
+		newTxResult(t, 10, 1, true, vm.MsgCall{
+			Caller:  sender,
+			PkgPath: "gno.land/r/demo/users",
+			Func:    "Register",
+		}),
+		newTxResult(t, 10, 2, true, vm.MsgAddPackage{
+			Creator: sender,
+			Package: &std.MemPackage{
+				Name: "avl",
+				Path: "gno.land/p/demo/avl",
+			},
+		}),
+		// Failed transactions are counted, but don't move funds
+		newTxResult(t, 10, 3, false, bank.MsgSend{
+			FromAddress: sender,
+			ToAddress:   recipient,
+			Amount:      coins,
+		}),
+	}
+
+	ai := newAccountIndexer(mockStorage)
+
+	for _, tx := range txs {
+		require.NoError(t, indexTx([]txIndexer{ai}, block, tx))
+	}
+
+	require.NoError(t, ai.flush(mockBatch))
+
+	// Make sure the sender summary is correct
+	senderAccount, ok := savedAccounts[sender.String()]
+	require.True(t, ok)
+
+	assert.Equal(t, int64(10), senderAccount.FirstSeenHeight)
+	assert.Equal(t, int64(10), senderAccount.LastSeenHeight)
+	assert.Equal(t, uint64(4), senderAccount.TxCount)
+	assert.Equal(t, coins, senderAccount.Sent)
+	assert.Empty(t, senderAccount.Received)
+	assert.Equal(t, []string{"gno.land/r/demo/users"}, senderAccount.RealmsCalled)
+	assert.Equal(t, []string{"gno.land/p/demo/avl"}, senderAccount.PackagesDeployed)
+	assert.Equal(t, []uint32{0, 1, 2, 3}, savedLinks[sender.String()])
+
+	// Make sure the recipient summary was updated, not overwritten
+	recipientAccount, ok := savedAccounts[recipient.String()]
+	require.True(t, ok)
+
+	assert.Equal(t, int64(1), recipientAccount.FirstSeenHeight)
+	assert.Equal(t, int64(10), recipientAccount.LastSeenHeight)
+	assert.Equal(t, uint64(3), recipientAccount.TxCount)
+	assert.Equal(t, std.NewCoins(std.NewCoin("ugnot", 110)), recipientAccount.Received)
+	assert.Equal(t, []uint32{0, 3}, savedLinks[recipient.String()])
+}
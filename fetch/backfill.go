@@ -0,0 +1,159 @@
+package fetch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	bft_types "github.com/gnolang/gno/tm2/pkg/bft/types"
+	"go.uber.org/zap"
+
+	"github.com/gnolang/tx-indexer/storage"
+	storageErrors "github.com/gnolang/tx-indexer/storage/errors"
+)
+
+// feeBackfillChunkSize is the number of blocks backfilled within a single batch
+const feeBackfillChunkSize = 1_000
+
+// BackfillBlockFees saves the fee summaries of the indexed blocks that are missing one,
+// so fee statistics can be served from the stored summaries only.
+// The fetcher saves a summary for every block with decodable transactions, so only the blocks
+// indexed before the summaries were introduced, that is below the first stored summary, can miss one.
+// It's meant to be called once on startup, before the fetcher service is started
+func BackfillBlockFees(ctx context.Context, store storage.Storage, logger *zap.Logger) error {
+	latest, err := store.GetLatestHeight()
+	if err != nil {
+		if errors.Is(err, storageErrors.ErrNotFound) {
+			// Nothing was indexed yet
+			return nil
+		}
+
+		return fmt.Errorf("unable to fetch latest height, %w", err)
+	}
+
+	first, found, err := firstBlockFeesHeight(store)
+	if err != nil {
+		return err
+	}
+
+	lastMissing := latest
+
+	if found {
+		if first == 0 {
+			// Summaries are stored from the genesis block onward
+			return nil
+		}
+
+		lastMissing = first - 1
+	}
+
+	logger.Info(
+		"Backfilling block fee summaries",
+		zap.Uint64("from", 0),
+		zap.Uint64("to", lastMissing),
+	)
+
+	for from := uint64(0); from <= lastMissing; from += feeBackfillChunkSize {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		to := min(from+feeBackfillChunkSize-1, lastMissing)
+
+		if err := backfillBlockFeesRange(store, from, to); err != nil {
+			return err
+		}
+
+		logger.Debug(
+			"Backfilled block fee summaries for range",
+			zap.Uint64("from", from),
+			zap.Uint64("to", to),
+		)
+	}
+
+	return nil
+}
+
+// firstBlockFeesHeight returns the height of the first stored block fee summary, if any
+func firstBlockFeesHeight(store storage.Reader) (uint64, bool, error) {
+	it, err := store.BlockFeesIterator(0, 0)
+	if err != nil {
+		return 0, false, fmt.Errorf("unable to iterate block fees, %w", err)
+	}
+
+	defer it.Close()
+
+	if !it.Next() {
+		if err := it.Error(); err != nil {
+			return 0, false, fmt.Errorf("unable to iterate block fees, %w", err)
+		}
+
+		return 0, false, nil
+	}
+
+	fees, err := it.Value()
+	if err != nil {
+		return 0, false, fmt.Errorf("unable to read block fees, %w", err)
+	}
+
+	return uint64(fees.Height), true, nil
+}
+
+// backfillBlockFeesRange saves the fee summaries of the blocks
+// between the provided block numbers (inclusive), in a single batch
+func backfillBlockFeesRange(store storage.Storage, fromBlockNum, toBlockNum uint64) error {
+	it, err := store.TxIterator(fromBlockNum, toBlockNum, 0, 0)
+	if err != nil {
+		return fmt.Errorf("unable to iterate transactions, %w", err)
+	}
+
+	defer it.Close()
+
+	var (
+		fi    = newFeeIndexer()
+		block *bft_types.Block
+	)
+
+	for it.Next() {
+		txResult, err := it.Value()
+		if err != nil {
+			return fmt.Errorf("unable to read transaction, %w", err)
+		}
+
+		// A 0 upper bound leaves the iterator unbounded
+		if uint64(txResult.Height) > toBlockNum {
+			break
+		}
+
+		if block == nil || block.Height != txResult.Height {
+			block, err = store.GetBlock(uint64(txResult.Height))
+			if err != nil {
+				return fmt.Errorf("unable to fetch block %d, %w", txResult.Height, err)
+			}
+		}
+
+		if err := indexTx([]txIndexer{fi}, block, txResult); err != nil {
+			return fmt.Errorf("unable to index tx fee, %w", err)
+		}
+	}
+
+	if err := it.Error(); err != nil {
+		return fmt.Errorf("unable to iterate transactions, %w", err)
+	}
+
+	if len(fi.blocks) == 0 {
+		return nil
+	}
+
+	wb := store.WriteBatch()
+
+	if err := fi.flush(wb); err != nil {
+		return rollbackWithError(wb, err)
+	}
+
+	if err := wb.Commit(); err != nil {
+		return fmt.Errorf("unable to persist block fees, %w", err)
+	}
+
+	return nil
+}
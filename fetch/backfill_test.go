@@ -0,0 +1,205 @@
+package fetch
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/std"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gnolang/tx-indexer/storage"
+	indexerTypes "github.com/gnolang/tx-indexer/types"
+)
+
+// newBackfillStorage creates a new storage containing the given blocks and transactions,
+// along with the given block fee summaries
+func newBackfillStorage(
+	t *testing.T,
+	blocks []*types.Block,
+	txs []*types.TxResult,
+	fees []*indexerTypes.BlockFees,
+) storage.Storage {
+	t.Helper()
+
+	s, err := storage.NewPebble(t.TempDir())
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, s.Close())
+	})
+
+	if len(blocks) == 0 {
+		return s
+	}
+
+	wb := s.WriteBatch()
+
+	for _, block := range blocks {
+		require.NoError(t, wb.SetBlock(block))
+	}
+
+	for _, tx := range txs {
+		require.NoError(t, wb.SetTx(tx))
+	}
+
+	for _, blockFees := range fees {
+		require.NoError(t, wb.SetBlockFees(blockFees))
+	}
+
+	require.NoError(t, wb.SetLatestHeight(uint64(blocks[len(blocks)-1].Height)))
+	require.NoError(t, wb.Commit())
+
+	return s
+}
+
+// storedBlockFees returns all the stored block fee summaries
+func storedBlockFees(t *testing.T, s storage.Storage) []*indexerTypes.BlockFees {
+	t.Helper()
+
+	it, err := s.BlockFeesIterator(0, 0)
+	require.NoError(t, err)
+
+	defer it.Close()
+
+	fees := make([]*indexerTypes.BlockFees, 0)
+
+	for it.Next() {
+		blockFees, err := it.Value()
+		require.NoError(t, err)
+
+		fees = append(fees, blockFees)
+	}
+
+	require.NoError(t, it.Error())
+
+	return fees
+}
+
+func TestBackfillBlockFees(t *testing.T) {
+	t.Parallel()
+
+	var (
+		fee = std.NewFee(100, std.NewCoin("ugnot", 10))
+
+		blocks = []*types.Block{
+			{Header: types.Header{Height: 0, Time: time.Unix(100, 0).UTC()}},
+			{Header: types.Header{Height: 1, Time: time.Unix(101, 0).UTC()}},
+			{Header: types.Header{Height: 2, Time: time.Unix(102, 0).UTC()}},
+			{Header: types.Header{Height: 3, Time: time.Unix(103, 0).UTC()}},
+		}
+	)
+
+	newFeeTx := func(height int64, index uint32) *types.TxResult {
+		encodedTx, err := amino.Marshal(&std.Tx{
+			Fee: fee,
+		})
+		require.NoError(t, err)
+
+		return &types.TxResult{
+			Height: height,
+			Index:  index,
+			Tx:     encodedTx,
+			Response: abci.ResponseDeliverTx{
+				GasUsed: 80,
+			},
+		}
+	}
+
+	newBlockFees := func(height int64, txs ...*types.TxResult) *indexerTypes.BlockFees {
+		blockFees := &indexerTypes.BlockFees{
+			Height: height,
+			Time:   blocks[height].Time,
+		}
+
+		for _, tx := range txs {
+			blockFees.Txs = append(blockFees.Txs, indexerTypes.NewTxFee(&std.Tx{Fee: fee}, tx))
+		}
+
+		return blockFees
+	}
+
+	t.Run("empty storage", func(t *testing.T) {
+		t.Parallel()
+
+		s := newBackfillStorage(t, nil, nil, nil)
+
+		require.NoError(t, BackfillBlockFees(context.Background(), s, zap.NewNop()))
+
+		assert.Empty(t, storedBlockFees(t, s))
+	})
+
+	t.Run("no stored summaries", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			genesisTx = newFeeTx(0, 0)
+			firstTx   = newFeeTx(2, 0)
+			secondTx  = newFeeTx(2, 1)
+			invalidTx = &types.TxResult{Height: 3, Index: 0, Tx: []byte("not an amino transaction")}
+			s         = newBackfillStorage(t, blocks, []*types.TxResult{genesisTx, firstTx, secondTx, invalidTx}, nil)
+			expected  = []*indexerTypes.BlockFees{
+				newBlockFees(0, genesisTx),
+				newBlockFees(2, firstTx, secondTx),
+			}
+		)
+
+		require.NoError(t, BackfillBlockFees(context.Background(), s, zap.NewNop()))
+
+		assert.Equal(t, expected, storedBlockFees(t, s))
+	})
+
+	t.Run("summaries stored from a later block", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			missingTx = newFeeTx(1, 0)
+			storedTx  = newFeeTx(3, 0)
+
+			// The stored summary is left untouched
+			stored = newBlockFees(3)
+
+			s = newBackfillStorage(
+				t,
+				blocks,
+				[]*types.TxResult{missingTx, storedTx},
+				[]*indexerTypes.BlockFees{stored},
+			)
+		)
+
+		require.NoError(t, BackfillBlockFees(context.Background(), s, zap.NewNop()))
+
+		assert.Equal(
+			t,
+			[]*indexerTypes.BlockFees{
+				newBlockFees(1, missingTx),
+				stored,
+			},
+			storedBlockFees(t, s),
+		)
+	})
+
+	t.Run("summaries stored from genesis", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			stored = newBlockFees(0)
+
+			s = newBackfillStorage(
+				t,
+				blocks,
+				[]*types.TxResult{newFeeTx(1, 0)},
+				[]*indexerTypes.BlockFees{stored},
+			)
+		)
+
+		require.NoError(t, BackfillBlockFees(context.Background(), s, zap.NewNop()))
+
+		assert.Equal(t, []*indexerTypes.BlockFees{stored}, storedBlockFees(t, s))
+	})
+}
@@ -0,0 +1,95 @@
+package fetch
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/gnolang/gno/gno.land/pkg/sdk/vm"
+	bft_types "github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/std"
+
+	"github.com/gnolang/tx-indexer/storage"
+	"github.com/gnolang/tx-indexer/types"
+)
+
+// failedMsgLogRegex matches the log of the message that failed the transaction
+var failedMsgLogRegex = regexp.MustCompile(`(?m)^msg:(\d+),success:false,`)
+
+var _ txIndexer = &failureIndexer{}
+
+// failureIndexer records the failed realm calls made within a single slot
+type failureIndexer struct {
+	failures []*types.RealmFailure
+}
+
+// newFailureIndexer creates a new realm failure indexer for a single slot write
+func newFailureIndexer() *failureIndexer {
+	return &failureIndexer{
+		failures: make([]*types.RealmFailure, 0),
+	}
+}
+
+// indexTx records the failure of the vm.MsgCall that failed the transaction.
+// If the transaction failed before running its messages (ex. insufficient fees),
+// the failure is recorded for all of its vm.MsgCall messages
+func (fi *failureIndexer) indexTx(_ *bft_types.Block, txResult *bft_types.TxResult, tx *std.Tx) error {
+	txError := types.NewTxError(txResult.Response)
+	if txError == nil {
+		return nil
+	}
+
+	// The stacktrace is only relevant to the transaction itself
+	txError.GnoStacktrace = ""
+
+	failedIndex, found := failedMsgIndex(txResult.Response.Log)
+
+	for index, msg := range tx.GetMsgs() {
+		if found && index != failedIndex {
+			continue
+		}
+
+		call, ok := msg.(vm.MsgCall)
+		if !ok {
+			continue
+		}
+
+		fi.failures = append(fi.failures, &types.RealmFailure{
+			PkgPath:  call.PkgPath,
+			Func:     call.Func,
+			Error:    *txError,
+			Height:   txResult.Height,
+			Index:    txResult.Index,
+			MsgIndex: uint32(index),
+		})
+	}
+
+	return nil
+}
+
+// flush writes the realm failures gathered so far to the batch
+func (fi *failureIndexer) flush(wb storage.Batch) error {
+	for _, failure := range fi.failures {
+		if err := wb.SetRealmFailure(failure); err != nil {
+			return fmt.Errorf("unable to save failure of %s.%s, %w", failure.PkgPath, failure.Func, err)
+		}
+	}
+
+	return nil
+}
+
+// failedMsgIndex returns the index of the message that failed the transaction,
+// parsed from the transaction log
+func failedMsgIndex(log string) (int, bool) {
+	match := failedMsgLogRegex.FindStringSubmatch(log)
+	if match == nil {
+		return 0, false
+	}
+
+	index, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0, false
+	}
+
+	return index, true
+}
@@ -0,0 +1,124 @@
+package fetch
+
+import (
+	"testing"
+
+	"github.com/gnolang/gno/gno.land/pkg/sdk/vm"
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	"github.com/gnolang/gno/tm2/pkg/sdk/bank"
+	"github.com/gnolang/gno/tm2/pkg/std"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/tx-indexer/internal/mock"
+	indexerTypes "github.com/gnolang/tx-indexer/types"
+)
+
+func TestFailureIndexer_IndexTx(t *testing.T) {
+	t.Parallel()
+
+	var (
+		caller = crypto.AddressFromPreimage([]byte("caller"))
+
+		saved = make([]*indexerTypes.RealmFailure, 0)
+
+		mockBatch = &mock.WriteBatch{
+			SetRealmFailureFn: func(failure *indexerTypes.RealmFailure) error {
+				saved = append(saved, failure)
+
+				return nil
+			},
+		}
+
+		register = vm.MsgCall{
+			Caller:  caller,
+			PkgPath: "gno.land/r/demo/users",
+			Func:    "Register",
+		}
+
+		transfer = vm.MsgCall{
+			Caller:  caller,
+			PkgPath: "gno.land/r/demo/foo20",
+			Func:    "Transfer",
+		}
+
+		send = bank.MsgSend{
+			FromAddress: caller,
+			ToAddress:   caller,
+		}
+	)
+
+	newTx := func(index uint32, response abci.ResponseDeliverTx, msgs ...std.Msg) *types.TxResult {
+		encodedTx, err := amino.Marshal(&std.Tx{
+			Msgs: msgs,
+		})
+		require.NoError(t, err)
+
+		return &types.TxResult{
+			Height:   10,
+			Index:    index,
+			Tx:       encodedTx,
+			Response: response,
+		}
+	}
+
+	newResponse := func(err abci.Error, log string) abci.ResponseDeliverTx {
+		return abci.ResponseDeliverTx{
+			ResponseBase: abci.ResponseBase{
+				Error: err,
+				Log:   log,
+			},
+		}
+	}
+
+	txs := []*types.TxResult{
+		// Successful calls are not recorded
+		newTx(0, newResponse(nil, ""), register),
+		// Only the failing message is recorded
+		newTx(
+			1,
+			newResponse(
+				abci.StringError("user already exists"),
+				"msg:0,success:true,log:,events:[]\nmsg:1,success:false,log:VM panic,events:[]",
+			),
+			transfer,
+			register,
+		),
+		// Failures before running the messages are recorded for all the calls
+		newTx(2, newResponse(std.InsufficientFeeError{}, ""), send, register, transfer),
+		// Failing non-call messages are not recorded
+		newTx(
+			3,
+			newResponse(std.InsufficientCoinsError{}, "msg:0,success:false,log:,events:[]"),
+			send,
+			register,
+		),
+	}
+
+	fi := newFailureIndexer()
+
+	for _, tx := range txs {
+		require.NoError(t, indexTx([]txIndexer{fi}, &types.Block{}, tx))
+	}
+
+	require.NoError(t, fi.flush(mockBatch))
+
+	require.Len(t, saved, 3)
+
+	assert.Equal(t, "gno.land/r/demo/users", saved[0].PkgPath)
+	assert.Equal(t, "Register", saved[0].Func)
+	assert.Equal(t, uint32(1), saved[0].Index)
+	assert.Equal(t, uint32(1), saved[0].MsgIndex)
+	assert.Equal(t, "user already exists", saved[0].Error.Message)
+
+	assert.Equal(t, "Register", saved[1].Func)
+	assert.Equal(t, uint32(1), saved[1].MsgIndex)
+	assert.Equal(t, "InsufficientFeeError", saved[1].Error.Name)
+
+	assert.Equal(t, "Transfer", saved[2].Func)
+	assert.Equal(t, uint32(2), saved[2].MsgIndex)
+	assert.Equal(t, "InsufficientFeeError", saved[2].Error.Name)
+}
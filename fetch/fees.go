@@ -0,0 +1,77 @@
+package fetch
+
+import (
+	"fmt"
+
+	bft_types "github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/std"
+
+	"github.com/gnolang/tx-indexer/storage"
+	"github.com/gnolang/tx-indexer/types"
+)
+
+var _ txIndexer = &feeIndexer{}
+
+// feeIndexer builds the fee summaries of the blocks within a single slot,
+// so fee statistics don't require decoding the block transactions on every read
+type feeIndexer struct {
+	blocks []*types.BlockFees // block fee summaries, in height order
+}
+
+// newFeeIndexer creates a new block fee indexer for a single slot write
+func newFeeIndexer() *feeIndexer {
+	return &feeIndexer{
+		blocks: make([]*types.BlockFees, 0),
+	}
+}
+
+// indexTx adds the transaction fee to the summary of its block
+func (fi *feeIndexer) indexTx(block *bft_types.Block, txResult *bft_types.TxResult, tx *std.Tx) error {
+	// Transactions are indexed in height order,
+	// so only the latest summary can belong to the block
+	if len(fi.blocks) == 0 || fi.blocks[len(fi.blocks)-1].Height != block.Height {
+		fi.blocks = append(fi.blocks, &types.BlockFees{
+			Height: block.Height,
+			Time:   block.Time,
+		})
+	}
+
+	fees := fi.blocks[len(fi.blocks)-1]
+
+	fees.Txs = append(fees.Txs, types.NewTxFee(tx, txResult))
+
+	return nil
+}
+
+// blockFees returns the fee summary of the block at the given height,
+// if it was indexed last. Blocks without decodable transactions have no summary
+func (fi *feeIndexer) blockFees(height int64) *types.BlockFees {
+	if len(fi.blocks) == 0 || fi.blocks[len(fi.blocks)-1].Height != height {
+		return nil
+	}
+
+	return fi.blocks[len(fi.blocks)-1]
+}
+
+// flush writes the block fee summaries gathered so far to the batch
+func (fi *feeIndexer) flush(wb storage.Batch) error {
+	for _, fees := range fi.blocks {
+		if err := wb.SetBlockFees(fees); err != nil {
+			return fmt.Errorf("unable to save block fees %d, %w", fees.Height, err)
+		}
+	}
+
+	return nil
+}
+
+// indexedBlockFees returns the fee summary of the block at the given height
+// gathered by the fee indexer, if any
+func indexedBlockFees(indexers []txIndexer, height int64) *types.BlockFees {
+	for _, indexer := range indexers {
+		if fi, ok := indexer.(*feeIndexer); ok {
+			return fi.blockFees(height)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package fetch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/std"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/tx-indexer/internal/mock"
+	indexerTypes "github.com/gnolang/tx-indexer/types"
+)
+
+func TestFeeIndexer_IndexTx(t *testing.T) {
+	t.Parallel()
+
+	var (
+		saved = make([]*indexerTypes.BlockFees, 0)
+
+		mockBatch = &mock.WriteBatch{
+			SetBlockFeesFn: func(fees *indexerTypes.BlockFees) error {
+				saved = append(saved, fees)
+
+				return nil
+			},
+		}
+
+		blocks = []*types.Block{
+			{Header: types.Header{Height: 10, Time: time.Unix(10, 0)}},
+			{Header: types.Header{Height: 11, Time: time.Unix(11, 0)}},
+		}
+	)
+
+	newFeeTx := func(height int64, index uint32, fee std.Fee, gasUsed int64) *types.TxResult {
+		encodedTx, err := amino.Marshal(&std.Tx{
+			Fee: fee,
+		})
+		require.NoError(t, err)
+
+		return &types.TxResult{
+			Height: height,
+			Index:  index,
+			Tx:     encodedTx,
+			Response: abci.ResponseDeliverTx{
+				GasUsed: gasUsed,
+			},
+		}
+	}
+
+	fi := newFeeIndexer()
+
+	require.NoError(t, indexTx([]txIndexer{fi}, blocks[0], newFeeTx(10, 0, std.NewFee(100, std.NewCoin("ugnot", 10)), 80)))
+	require.NoError(t, indexTx([]txIndexer{fi}, blocks[0], newFeeTx(10, 1, std.NewFee(200, std.NewCoin("ugnot", 40)), 150)))
+	require.NoError(t, indexTx([]txIndexer{fi}, blocks[1], newFeeTx(11, 0, std.NewFee(50, std.NewCoin("foo", 5)), 50)))
+
+	// Make sure only the summary of the latest indexed block is served
+	assert.Nil(t, indexedBlockFees([]txIndexer{fi}, 10))
+	assert.Nil(t, indexedBlockFees([]txIndexer{fi}, 12))
+
+	require.NotNil(t, indexedBlockFees([]txIndexer{fi}, 11))
+	assert.Len(t, indexedBlockFees([]txIndexer{fi}, 11).Txs, 1)
+
+	require.NoError(t, fi.flush(mockBatch))
+
+	assert.Equal(t, []*indexerTypes.BlockFees{
+		{
+			Height: 10,
+			Time:   time.Unix(10, 0),
+			Txs: []indexerTypes.TxFee{
+				{Denom: "ugnot", Amount: 10, GasWanted: 100, GasUsed: 80, Index: 0},
+				{Denom: "ugnot", Amount: 40, GasWanted: 200, GasUsed: 150, Index: 1},
+			},
+		},
+		{
+			Height: 11,
+			Time:   time.Unix(11, 0),
+			Txs: []indexerTypes.TxFee{
+				{Denom: "foo", Amount: 5, GasWanted: 50, GasUsed: 50, Index: 0},
+			},
+		},
+	}, saved)
+}
@@ -86,7 +86,7 @@ func (f *Fetcher) fetchGenesisData(ctx context.Context) error {
 
 	f.logger.Info("Fetching genesis")
 
-	block, err := getGenesisBlock(ctx, f.client)
+	block, genesis, err := getGenesisBlock(ctx, f.client)
 	if err != nil {
 		return fmt.Errorf("failed to fetch genesis block: %w", err)
 	}
@@ -122,6 +122,7 @@ func (f *Fetcher) fetchGenesisData(ctx context.Context) error {
 			from: 0,
 			to:   0,
 		},
+		genesis: genesis,
 	}
 
 	return f.writeSlot(s)
@@ -255,7 +256,25 @@ func (f *Fetcher) FetchChainData(ctx context.Context) error {
 }
 
 func (f *Fetcher) writeSlot(s *slot) error {
-	wb := f.storage.WriteBatch()
+	var (
+		wb       = f.storage.WriteBatch()
+		indexers = newTxIndexers(f.storage)
+
+		// blockEvents are only signaled once the batch is committed,
+		// so listeners never see blocks that are rolled back
+		blockEvents = make([]*types.NewBlock, 0, len(s.chunk.blocks))
+	)
+
+	// Save the genesis state, as the baseline of the indexed data
+	if s.genesis != nil {
+		if err := wb.SetGenesis(s.genesis); err != nil {
+			return rollbackWithError(wb, fmt.Errorf("unable to save genesis state, %w", err))
+		}
+
+		if err := indexGenesis(indexers, s.genesis); err != nil {
+			return rollbackWithError(wb, fmt.Errorf("unable to index genesis state, %w", err))
+		}
+	}
 
 	// Save the fetched data
 	for blockIndex, block := range s.chunk.blocks {
@@ -272,6 +291,31 @@ func (f *Fetcher) writeSlot(s *slot) error {
 
 		f.logger.Debug("Added block data to batch", zap.Int64("number", block.Height))
 
+		// Save the BeginBlock / EndBlock results, if any
+		blockResults := &types.BlockResults{
+			Height: block.Height,
+		}
+
+		if blockIndex < len(s.chunk.blockResults) && s.chunk.blockResults[blockIndex] != nil {
+			blockResults = s.chunk.blockResults[blockIndex]
+		}
+
+		if !blockResults.IsEmpty() {
+			if err := wb.SetBlockResults(blockResults); err != nil {
+				f.logger.Error("unable to save block results", zap.String("err", err.Error()))
+			}
+		}
+
+		// Index the block itself, along with its validator set, if fetched
+		var validators []*bft_types.Validator
+		if blockIndex < len(s.chunk.validators) {
+			validators = s.chunk.validators[blockIndex]
+		}
+
+		if err := indexBlock(indexers, block, validators); err != nil {
+			return rollbackWithError(wb, fmt.Errorf("unable to index block, %w", err))
+		}
+
 		// Get block results
 		txResults := s.chunk.results[blockIndex]
 
@@ -287,15 +331,19 @@ func (f *Fetcher) writeSlot(s *slot) error {
 				"Added tx to batch",
 				zap.String("hash", base64.StdEncoding.EncodeToString(txResult.Tx.Hash())),
 			)
-		}
 
-		// Alert any listeners of a new saved block
-		event := &types.NewBlock{
-			Block:   block,
-			Results: txResults,
+			if err := indexTx(indexers, block, txResult); err != nil {
+				return rollbackWithError(wb, fmt.Errorf("unable to index tx, %w", err))
+			}
 		}
 
-		f.events.SignalEvent(event)
+		// Prepare the new saved block alert for any listeners
+		blockEvents = append(blockEvents, &types.NewBlock{
+			Block:        block,
+			BlockResults: blockResults,
+			Fees:         indexedBlockFees(indexers, block.Height),
+			Results:      txResults,
+		})
 	}
 
 	f.logger.Info(
@@ -304,24 +352,40 @@ func (f *Fetcher) writeSlot(s *slot) error {
 		zap.Uint64("to", s.chunkRange.to),
 	)
 
+	// Save the data derived from the transactions
+	if err := flushIndexers(indexers, wb); err != nil {
+		return rollbackWithError(wb, fmt.Errorf("unable to save indexed tx data, %w", err))
+	}
+
 	// Save the latest height data
 	if err := wb.SetLatestHeight(s.chunkRange.to); err != nil {
-		if rErr := wb.Rollback(); rErr != nil {
-			return fmt.Errorf("unable to save latest height info, %w, %w", err, rErr)
-		}
-
-		return fmt.Errorf("unable to save latest height info, %w", err)
+		return rollbackWithError(wb, fmt.Errorf("unable to save latest height info, %w", err))
 	}
 
 	if err := wb.Commit(); err != nil {
 		return fmt.Errorf("error persisting block information into storage, %w", err)
 	}
 
+	// Alert any listeners of the new saved blocks
+	for _, event := range blockEvents {
+		f.events.SignalEvent(event)
+	}
+
 	f.latestChunkSize = len(s.chunk.blocks)
 
 	return nil
 }
 
+// rollbackWithError rolls back the batch, and returns the
+// given error joined with the rollback error, if any
+func rollbackWithError(wb storage.Batch, err error) error {
+	if rErr := wb.Rollback(); rErr != nil {
+		return fmt.Errorf("%w, %w", err, rErr)
+	}
+
+	return err
+}
+
 func (f *Fetcher) IsReady(ctx context.Context) (bool, error) {
 	if f.latestChunkSize == int(f.maxChunkSize) {
 		return false, fmt.Errorf("the data synchronization process is still in progress and hasn't "+
@@ -336,26 +400,26 @@ func (f *Fetcher) IsReady(ctx context.Context) (bool, error) {
 	return true, nil
 }
 
-func getGenesisBlock(ctx context.Context, client Client) (*bft_types.Block, error) {
+func getGenesisBlock(ctx context.Context, client Client) (*bft_types.Block, *types.Genesis, error) {
 	gblock, err := client.GetGenesis(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("unable to get genesis block: %w", err)
+		return nil, nil, fmt.Errorf("unable to get genesis block: %w", err)
 	}
 
 	if gblock.Genesis == nil {
-		return nil, errInvalidGenesisState
+		return nil, nil, errInvalidGenesisState
 	}
 
 	genesisState, ok := gblock.Genesis.AppState.(gnoland.GnoGenesisState)
 	if !ok {
-		return nil, fmt.Errorf("unknown genesis state kind '%T'", gblock.Genesis.AppState)
+		return nil, nil, fmt.Errorf("unknown genesis state kind '%T'", gblock.Genesis.AppState)
 	}
 
 	txs := make([]bft_types.Tx, len(genesisState.Txs))
 	for i, tx := range genesisState.Txs {
 		txs[i], err = amino.Marshal(tx.Tx)
 		if err != nil {
-			return nil, fmt.Errorf("unable to marshal genesis tx: %w", err)
+			return nil, nil, fmt.Errorf("unable to marshal genesis tx: %w", err)
 		}
 	}
 
@@ -371,5 +435,10 @@ func getGenesisBlock(ctx context.Context, client Client) (*bft_types.Block, erro
 		},
 	}
 
-	return block, nil
+	genesis, err := newGenesis(gblock.Genesis, genesisState)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to decode genesis state: %w", err)
+	}
+
+	return block, genesis, nil
 }
@@ -13,6 +13,7 @@ import (
 	core_types "github.com/gnolang/gno/tm2/pkg/bft/rpc/core/types"
 	"github.com/gnolang/gno/tm2/pkg/bft/state"
 	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
 	"github.com/gnolang/gno/tm2/pkg/std"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -630,6 +631,12 @@ func TestFetcher_FetchTransactions_Valid_FullTransactions(t *testing.T) {
 				assert.EqualValues(t, txIndex, tx.Index)
 				assert.Equal(t, serializedTxs[txIndex], tx.Tx)
 			}
+
+			// Make sure the indexed fee summary is carried along
+			require.NotNil(t, eventData.Fees)
+
+			assert.Equal(t, blocks[index].Height, eventData.Fees.Height)
+			assert.Len(t, eventData.Fees.Txs, txCount)
 		}
 	})
 }
@@ -637,6 +644,46 @@ func TestFetcher_FetchTransactions_Valid_FullTransactions(t *testing.T) {
 func TestFetcher_FetchTransactions_Valid_EmptyBlocks(t *testing.T) {
 	t.Parallel()
 
+	var (
+		// The validator set changes in a block without transactions
+		updateHeight = int64(3)
+		update       = abci.ValidatorUpdate{
+			Address: crypto.AddressFromPreimage([]byte("validator")),
+			Power:   1,
+		}
+	)
+
+	// newResults creates the results of an empty block
+	newResults := func(num uint64) *core_types.ResultBlockResults {
+		results := &core_types.ResultBlockResults{
+			Height: int64(num),
+			Results: &state.ABCIResponses{
+				DeliverTxs: make([]abci.ResponseDeliverTx, 0),
+			},
+		}
+
+		if int64(num) == updateHeight {
+			results.Results.EndBlock.ValidatorUpdates = []abci.ValidatorUpdate{update}
+		}
+
+		return results
+	}
+
+	// validateBlockResults makes sure the block results of empty blocks are indexed
+	validateBlockResults := func(t *testing.T, event *indexerTypes.NewBlock) {
+		t.Helper()
+
+		require.NotNil(t, event.BlockResults)
+
+		if event.Block.Height != updateHeight {
+			assert.True(t, event.BlockResults.IsEmpty())
+
+			return
+		}
+
+		assert.Equal(t, []abci.ValidatorUpdate{update}, event.BlockResults.ValidatorUpdates)
+	}
+
 	t.Run("no txs in block, sequential", func(t *testing.T) {
 		t.Parallel()
 
@@ -710,18 +757,7 @@ func TestFetcher_FetchTransactions_Valid_EmptyBlocks(t *testing.T) {
 					}, nil
 				},
 				getBlockResultsFn: func(num uint64) (*core_types.ResultBlockResults, error) {
-					if num == 0 {
-						return &core_types.ResultBlockResults{
-							Height: int64(num),
-							Results: &state.ABCIResponses{
-								DeliverTxs: make([]abci.ResponseDeliverTx, 0),
-							},
-						}, nil
-					}
-
-					t.Fatalf("should not request results")
-
-					return nil, nil
+					return newResults(num), nil
 				},
 				getGenesisFn: func() (*core_types.ResultGenesis, error) {
 					return &core_types.ResultGenesis{
@@ -764,6 +800,8 @@ func TestFetcher_FetchTransactions_Valid_EmptyBlocks(t *testing.T) {
 
 			// Make sure the transaction results are valid
 			require.Len(t, event.Results, 0)
+
+			validateBlockResults(t, event)
 		}
 	})
 
@@ -846,25 +884,14 @@ func TestFetcher_FetchTransactions_Valid_EmptyBlocks(t *testing.T) {
 							return nil
 						},
 						addBlockResultsRequestFn: func(num uint64) error {
-							t.Fatalf("block %d should not have txs", num)
+							batch = append(batch, newResults(num))
 
 							return nil
 						},
 					}
 				},
 				getBlockResultsFn: func(num uint64) (*core_types.ResultBlockResults, error) {
-					if num == 0 {
-						return &core_types.ResultBlockResults{
-							Height: int64(num),
-							Results: &state.ABCIResponses{
-								DeliverTxs: make([]abci.ResponseDeliverTx, 0),
-							},
-						}, nil
-					}
-
-					t.Fatalf("should not request results")
-
-					return nil, nil
+					return newResults(num), nil
 				},
 				getLatestBlockNumberFn: func() (uint64, error) {
 					return uint64(blockNum), nil
@@ -910,6 +937,8 @@ func TestFetcher_FetchTransactions_Valid_EmptyBlocks(t *testing.T) {
 
 			// Make sure the transaction results are valid
 			require.Len(t, event.Results, 0)
+
+			validateBlockResults(t, event)
 		}
 	})
 }
@@ -1034,6 +1063,144 @@ func TestFetcher_InvalidBlocks(t *testing.T) {
 	assert.Len(t, capturedEvents, 0)
 }
 
+func TestFetcher_WriteSlot_Events(t *testing.T) {
+	t.Parallel()
+
+	newSlot := func(t *testing.T) *slot {
+		t.Helper()
+
+		blocks := generateBlocks(t, 3, generateTransactions(t, 1))
+		results := make([][]*types.TxResult, len(blocks))
+
+		for index, block := range blocks {
+			results[index] = []*types.TxResult{
+				{
+					Height: block.Height,
+					Tx:     block.Txs[0],
+				},
+			}
+		}
+
+		return &slot{
+			chunk: &chunk{
+				blocks:  blocks,
+				results: results,
+			},
+			chunkRange: chunkRange{
+				from: 0,
+				to:   uint64(len(blocks) - 1),
+			},
+		}
+	}
+
+	t.Run("events signaled after the commit", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			committed      bool
+			capturedEvents = make([]*indexerTypes.NewBlock, 0)
+
+			s = newSlot(t)
+
+			mockEvents = &mockEvents{
+				signalEventFn: func(e events.Event) {
+					// Make sure listeners can read the signaled block from storage
+					require.True(t, committed)
+
+					blockEvent, ok := e.(*indexerTypes.NewBlock)
+					require.True(t, ok)
+
+					capturedEvents = append(capturedEvents, blockEvent)
+				},
+			}
+
+			mockStorage = &mock.Storage{
+				GetWriteBatchFn: func() storage.Batch {
+					return &mock.WriteBatch{
+						CommitFn: func() error {
+							committed = true
+
+							return nil
+						},
+					}
+				},
+			}
+		)
+
+		f := New(mockStorage, &mockClient{}, mockEvents)
+
+		require.NoError(t, f.writeSlot(s))
+
+		require.Len(t, capturedEvents, len(s.chunk.blocks))
+
+		for index, event := range capturedEvents {
+			assert.Equal(t, s.chunk.blocks[index], event.Block)
+			assert.Equal(t, s.chunk.results[index], event.Results)
+		}
+	})
+
+	t.Run("no events for a rolled back batch", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			writeErr = errors.New("unable to write")
+
+			mockEvents = &mockEvents{
+				signalEventFn: func(_ events.Event) {
+					t.Fatal("no events should be signaled")
+				},
+			}
+
+			mockStorage = &mock.Storage{
+				GetWriteBatchFn: func() storage.Batch {
+					return &mock.WriteBatch{
+						SetLatestHeightFn: func(_ uint64) error {
+							return writeErr
+						},
+						CommitFn: func() error {
+							t.Fatal("the batch should not be committed")
+
+							return nil
+						},
+					}
+				},
+			}
+		)
+
+		f := New(mockStorage, &mockClient{}, mockEvents)
+
+		assert.ErrorIs(t, f.writeSlot(newSlot(t)), writeErr)
+	})
+
+	t.Run("no events for a failed commit", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			commitErr = errors.New("unable to commit")
+
+			mockEvents = &mockEvents{
+				signalEventFn: func(_ events.Event) {
+					t.Fatal("no events should be signaled")
+				},
+			}
+
+			mockStorage = &mock.Storage{
+				GetWriteBatchFn: func() storage.Batch {
+					return &mock.WriteBatch{
+						CommitFn: func() error {
+							return commitErr
+						},
+					}
+				},
+			}
+		)
+
+		f := New(mockStorage, &mockClient{}, mockEvents)
+
+		assert.ErrorIs(t, f.writeSlot(newSlot(t)), commitErr)
+	})
+}
+
 func TestFetcher_Genesis(t *testing.T) {
 	t.Parallel()
 
@@ -1043,6 +1210,8 @@ func TestFetcher_Genesis(t *testing.T) {
 		savedBlocks = map[int64]*types.Block{}
 		savedTxs    = map[string]*types.TxResult{}
 
+		savedGenesis *indexerTypes.Genesis
+
 		capturedEvents = make([]*indexerTypes.NewBlock, 0)
 
 		mockEvents = &mockEvents{
@@ -1060,6 +1229,11 @@ func TestFetcher_Genesis(t *testing.T) {
 			},
 			GetWriteBatchFn: func() storage.Batch {
 				return &mock.WriteBatch{
+					SetGenesisFn: func(genesis *indexerTypes.Genesis) error {
+						savedGenesis = genesis
+
+						return nil
+					},
 					SetBlockFn: func(block *types.Block) error {
 						_, ok := savedBlocks[block.Height]
 						require.False(t, ok)
@@ -1109,6 +1283,10 @@ func TestFetcher_Genesis(t *testing.T) {
 	_, ok := savedBlocks[0]
 	require.True(t, ok)
 
+	// Make sure the genesis state is saved along with the genesis block
+	require.NotNil(t, savedGenesis)
+	assert.Empty(t, savedGenesis.Packages)
+
 	for i := uint32(0); i < uint32(len(txs)); i++ {
 		tx, ok := savedTxs[fmt.Sprintf("0-%d", i)]
 		require.True(t, ok)
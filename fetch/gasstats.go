@@ -0,0 +1,99 @@
+package fetch
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/gnolang/gno/gno.land/pkg/sdk/vm"
+	bft_types "github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/std"
+
+	"github.com/gnolang/tx-indexer/storage"
+	"github.com/gnolang/tx-indexer/types"
+)
+
+// funcGasKey identifies the gas usage summary of a realm function within a block
+type funcGasKey struct {
+	pkgPath string
+	fn      string
+	height  int64
+}
+
+var _ txIndexer = &funcGasIndexer{}
+
+// funcGasIndexer builds the per-block gas usage summaries
+// of the realm functions called within a single slot
+type funcGasIndexer struct {
+	stats map[funcGasKey]*types.FuncGasStats
+}
+
+// newFuncGasIndexer creates a new realm function gas usage indexer for a single slot write
+func newFuncGasIndexer() *funcGasIndexer {
+	return &funcGasIndexer{
+		stats: make(map[funcGasKey]*types.FuncGasStats),
+	}
+}
+
+// indexTx accounts for the transaction in the summary of the called realm function.
+// Gas is only reported for the whole transaction, so only transactions
+// made of a single vm.MsgCall can be attributed to a function
+func (gi *funcGasIndexer) indexTx(_ *bft_types.Block, txResult *bft_types.TxResult, tx *std.Tx) error {
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return nil
+	}
+
+	call, ok := msgs[0].(vm.MsgCall)
+	if !ok {
+		return nil
+	}
+
+	key := funcGasKey{
+		pkgPath: call.PkgPath,
+		fn:      call.Func,
+		height:  txResult.Height,
+	}
+
+	stats, ok := gi.stats[key]
+	if !ok {
+		stats = &types.FuncGasStats{
+			PkgPath: call.PkgPath,
+			Func:    call.Func,
+			Height:  txResult.Height,
+		}
+
+		gi.stats[key] = stats
+	}
+
+	stats.AddCall(tx.Fee.GasWanted, txResult.Response.GasUsed, txResult.Response.IsOK())
+
+	return nil
+}
+
+// flush writes the realm function gas usage summaries gathered so far to the batch
+func (gi *funcGasIndexer) flush(wb storage.Batch) error {
+	keys := make([]funcGasKey, 0, len(gi.stats))
+	for key := range gi.stats {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].pkgPath != keys[j].pkgPath {
+			return keys[i].pkgPath < keys[j].pkgPath
+		}
+
+		if keys[i].fn != keys[j].fn {
+			return keys[i].fn < keys[j].fn
+		}
+
+		return keys[i].height < keys[j].height
+	})
+
+	for _, key := range keys {
+		if err := wb.SetFuncGasStats(gi.stats[key]); err != nil {
+			return fmt.Errorf("unable to save gas stats of %s.%s, %w", key.pkgPath, key.fn, err)
+		}
+	}
+
+	return nil
+}
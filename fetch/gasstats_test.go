@@ -0,0 +1,106 @@
+package fetch
+
+import (
+	"testing"
+
+	"github.com/gnolang/gno/gno.land/pkg/sdk/vm"
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	"github.com/gnolang/gno/tm2/pkg/std"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/tx-indexer/internal/mock"
+	indexerTypes "github.com/gnolang/tx-indexer/types"
+)
+
+func TestFuncGasIndexer_IndexTx(t *testing.T) {
+	t.Parallel()
+
+	var (
+		caller = crypto.AddressFromPreimage([]byte("caller"))
+
+		saved = make([]*indexerTypes.FuncGasStats, 0)
+
+		mockBatch = &mock.WriteBatch{
+			SetFuncGasStatsFn: func(stats *indexerTypes.FuncGasStats) error {
+				saved = append(saved, stats)
+
+				return nil
+			},
+		}
+
+		register = vm.MsgCall{
+			Caller:  caller,
+			PkgPath: "gno.land/r/demo/users",
+			Func:    "Register",
+		}
+
+		transfer = vm.MsgCall{
+			Caller:  caller,
+			PkgPath: "gno.land/r/demo/foo20",
+			Func:    "Transfer",
+		}
+	)
+
+	newCallTx := func(height int64, gasWanted, gasUsed int64, success bool, msgs ...std.Msg) *types.TxResult {
+		encodedTx, err := amino.Marshal(&std.Tx{
+			Msgs: msgs,
+			Fee:  std.NewFee(gasWanted, std.NewCoin("ugnot", 1)),
+		})
+		require.NoError(t, err)
+
+		response := abci.ResponseDeliverTx{
+			GasUsed: gasUsed,
+		}
+
+		if !success {
+			response.Error = abci.StringError("failed")
+		}
+
+		return &types.TxResult{
+			Height:   height,
+			Tx:       encodedTx,
+			Response: response,
+		}
+	}
+
+	txs := []*types.TxResult{
+		newCallTx(10, 1000, 300, true, register),
+		newCallTx(10, 1000, 600, false, register),
+		newCallTx(11, 2000, 500, true, register),
+		newCallTx(11, 500, 100, true, transfer),
+		// Multi-message transactions can't be attributed to a single function
+		newCallTx(11, 5000, 1000, true, register, transfer),
+	}
+
+	gi := newFuncGasIndexer()
+
+	for _, tx := range txs {
+		require.NoError(t, indexTx([]txIndexer{gi}, &types.Block{}, tx))
+	}
+
+	require.NoError(t, gi.flush(mockBatch))
+
+	require.Len(t, saved, 3)
+
+	// Make sure the summaries are saved in key order
+	assert.Equal(t, "gno.land/r/demo/foo20", saved[0].PkgPath)
+	assert.Equal(t, uint64(1), saved[0].Calls)
+
+	assert.Equal(t, "Register", saved[1].Func)
+	assert.EqualValues(t, 10, saved[1].Height)
+	assert.Equal(t, uint64(2), saved[1].Calls)
+	assert.Equal(t, uint64(1), saved[1].Failures)
+	assert.EqualValues(t, 2000, saved[1].TotalGasWanted)
+	assert.EqualValues(t, 900, saved[1].TotalGasUsed)
+	assert.EqualValues(t, 300, saved[1].MinGasUsed)
+	assert.EqualValues(t, 600, saved[1].MaxGasUsed)
+
+	assert.Equal(t, "Register", saved[2].Func)
+	assert.EqualValues(t, 11, saved[2].Height)
+	assert.Equal(t, uint64(1), saved[2].Calls)
+	assert.EqualValues(t, 500, saved[2].TotalGasUsed)
+}
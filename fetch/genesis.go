@@ -0,0 +1,63 @@
+package fetch
+
+import (
+	"fmt"
+
+	"github.com/gnolang/gno/gno.land/pkg/gnoland"
+	"github.com/gnolang/gno/gno.land/pkg/sdk/vm"
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	bft_types "github.com/gnolang/gno/tm2/pkg/bft/types"
+
+	"github.com/gnolang/tx-indexer/types"
+)
+
+// newGenesis decodes the genesis state beyond its transactions:
+// the initial balances, the module parameters and the deployed packages
+func newGenesis(doc *bft_types.GenesisDoc, state gnoland.GnoGenesisState) (*types.Genesis, error) {
+	genesis := &types.Genesis{
+		GenesisTime: doc.GenesisTime,
+		ChainID:     doc.ChainID,
+		Balances:    make([]types.GenesisBalance, 0, len(state.Balances)),
+		Packages:    make([]string, 0),
+	}
+
+	for _, balance := range state.Balances {
+		genesis.Balances = append(genesis.Balances, types.GenesisBalance{
+			Address: balance.Address.String(),
+			Coins:   balance.Amount,
+		})
+	}
+
+	for _, tx := range state.Txs {
+		for _, msg := range tx.Tx.GetMsgs() {
+			addPackage, ok := msg.(vm.MsgAddPackage)
+			if !ok || addPackage.Package == nil {
+				continue
+			}
+
+			genesis.Packages = append(genesis.Packages, addPackage.Package.Path)
+		}
+	}
+
+	params := []struct {
+		value  any
+		target *string
+		name   string
+	}{
+		{state.Auth.Params, &genesis.AuthParams, "auth"},
+		{state.Bank.Params, &genesis.BankParams, "bank"},
+		{state.VM.Params, &genesis.VMParams, "vm"},
+		{state.VM.RealmParams, &genesis.RealmParams, "realm"},
+	}
+
+	for _, param := range params {
+		encoded, err := amino.MarshalJSON(param.value)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode %s params, %w", param.name, err)
+		}
+
+		*param.target = string(encoded)
+	}
+
+	return genesis, nil
+}
@@ -0,0 +1,126 @@
+package fetch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gnolang/gno/gno.land/pkg/gnoland"
+	"github.com/gnolang/gno/gno.land/pkg/sdk/vm"
+	bft_types "github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	"github.com/gnolang/gno/tm2/pkg/sdk/auth"
+	"github.com/gnolang/gno/tm2/pkg/std"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/tx-indexer/internal/mock"
+	indexerTypes "github.com/gnolang/tx-indexer/types"
+)
+
+func TestNewGenesis(t *testing.T) {
+	t.Parallel()
+
+	var (
+		alice = crypto.AddressFromPreimage([]byte("alice"))
+		bob   = crypto.AddressFromPreimage([]byte("bob"))
+
+		doc = &bft_types.GenesisDoc{
+			GenesisTime: time.Unix(1000, 0).UTC(),
+			ChainID:     "dev",
+		}
+
+		state = gnoland.GnoGenesisState{
+			Balances: []gnoland.Balance{
+				{Address: alice, Amount: std.NewCoins(std.NewCoin("ugnot", 100))},
+				{Address: bob, Amount: std.NewCoins(std.NewCoin("ugnot", 50))},
+			},
+			Txs: []gnoland.TxWithMetadata{
+				{
+					Tx: std.Tx{
+						Msgs: []std.Msg{
+							vm.MsgAddPackage{
+								Creator: alice,
+								Package: &std.MemPackage{Path: "gno.land/p/demo/avl"},
+							},
+							vm.MsgAddPackage{
+								Creator: alice,
+								Package: &std.MemPackage{Path: "gno.land/r/demo/foo20"},
+							},
+						},
+					},
+				},
+			},
+			Auth: auth.DefaultGenesisState(),
+		}
+	)
+
+	genesis, err := newGenesis(doc, state)
+	require.NoError(t, err)
+
+	assert.Equal(t, doc.GenesisTime, genesis.GenesisTime)
+	assert.Equal(t, doc.ChainID, genesis.ChainID)
+	assert.Equal(t, []indexerTypes.GenesisBalance{
+		{Address: alice.String(), Coins: std.NewCoins(std.NewCoin("ugnot", 100))},
+		{Address: bob.String(), Coins: std.NewCoins(std.NewCoin("ugnot", 50))},
+	}, genesis.Balances)
+	assert.Equal(t, []string{"gno.land/p/demo/avl", "gno.land/r/demo/foo20"}, genesis.Packages)
+
+	// Make sure the params are kept as JSON
+	assert.Contains(t, genesis.AuthParams, "max_memo_bytes")
+	assert.NotEmpty(t, genesis.BankParams)
+	assert.NotEmpty(t, genesis.VMParams)
+}
+
+func TestIndexGenesis(t *testing.T) {
+	t.Parallel()
+
+	var (
+		alice = crypto.AddressFromPreimage([]byte("alice")).String()
+
+		genesis = &indexerTypes.Genesis{
+			Balances: []indexerTypes.GenesisBalance{
+				{Address: alice, Coins: std.NewCoins(std.NewCoin("ugnot", 100))},
+			},
+		}
+
+		accounts = make([]*indexerTypes.Account, 0)
+		deltas   = make([]*indexerTypes.BalanceDelta, 0)
+
+		mockBatch = &mock.WriteBatch{
+			SetAccountFn: func(account *indexerTypes.Account) error {
+				accounts = append(accounts, account)
+
+				return nil
+			},
+			SetBalanceDeltaFn: func(delta *indexerTypes.BalanceDelta) error {
+				deltas = append(deltas, delta)
+
+				return nil
+			},
+		}
+
+		indexers = []txIndexer{
+			newAccountIndexer(&mock.Storage{}),
+			newLedgerIndexer(&mock.Storage{}),
+		}
+	)
+
+	require.NoError(t, indexGenesis(indexers, genesis))
+	require.NoError(t, flushIndexers(indexers, mockBatch))
+
+	// Make sure genesis holders are saved, even without transactions
+	require.Len(t, accounts, 1)
+	assert.Equal(t, alice, accounts[0].Address)
+	assert.Equal(t, genesis.Balances[0].Coins, accounts[0].GenesisBalance)
+
+	// Make sure the genesis balances are the baseline of the balance history
+	assert.Equal(t, []*indexerTypes.BalanceDelta{
+		{
+			Address: alice,
+			Denom:   "ugnot",
+			Delta:   100,
+			Balance: 100,
+			Height:  0,
+		},
+	}, deltas)
+}
@@ -0,0 +1,111 @@
+package fetch
+
+import (
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	bft_types "github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/std"
+
+	"github.com/gnolang/tx-indexer/storage"
+	"github.com/gnolang/tx-indexer/types"
+)
+
+// txIndexer derives secondary data (accounts, packages...)
+// from the transactions written within a single slot
+type txIndexer interface {
+	// indexTx applies the decoded transaction to the indexer state
+	indexTx(block *bft_types.Block, txResult *bft_types.TxResult, tx *std.Tx) error
+
+	// flush writes the gathered data to the batch
+	flush(wb storage.Batch) error
+}
+
+// genesisIndexer is a txIndexer that also derives data from the genesis state
+type genesisIndexer interface {
+	// indexGenesis applies the genesis state to the indexer state,
+	// before any of the genesis transactions
+	indexGenesis(genesis *types.Genesis) error
+}
+
+// blockIndexer is a txIndexer that also derives data from the blocks themselves
+type blockIndexer interface {
+	// indexBlock applies the block to the indexer state, before any of its transactions.
+	// The validator set of the block is provided only when it was fetched, that is
+	// for the first block of a chunk, and for the blocks where the set changes
+	indexBlock(block *bft_types.Block, validators []*bft_types.Validator) error
+}
+
+// newTxIndexers creates the write path indexers for a single slot
+func newTxIndexers(storage storage.Reader) []txIndexer {
+	return []txIndexer{
+		newAccountIndexer(storage),
+		newPackageIndexer(storage),
+		newSearchIndexer(),
+		newFeeIndexer(),
+		newFuncGasIndexer(),
+		newFailureIndexer(),
+		newLedgerIndexer(storage),
+		newTokenIndexer(storage),
+		newNFTIndexer(storage),
+		newValidatorIndexer(storage),
+	}
+}
+
+// indexTx decodes the transaction, and applies it to all the indexers.
+// Transactions that can't be decoded carry no indexable information, and are skipped
+func indexTx(indexers []txIndexer, block *bft_types.Block, txResult *bft_types.TxResult) error {
+	var tx std.Tx
+	if err := amino.Unmarshal(txResult.Tx, &tx); err != nil {
+		return nil
+	}
+
+	for _, indexer := range indexers {
+		if err := indexer.indexTx(block, txResult, &tx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// indexGenesis applies the genesis state to the indexers deriving data from it
+func indexGenesis(indexers []txIndexer, genesis *types.Genesis) error {
+	for _, indexer := range indexers {
+		gi, ok := indexer.(genesisIndexer)
+		if !ok {
+			continue
+		}
+
+		if err := gi.indexGenesis(genesis); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// indexBlock applies the block to all the indexers that derive data from blocks
+func indexBlock(indexers []txIndexer, block *bft_types.Block, validators []*bft_types.Validator) error {
+	for _, indexer := range indexers {
+		bi, ok := indexer.(blockIndexer)
+		if !ok {
+			continue
+		}
+
+		if err := bi.indexBlock(block, validators); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// flushIndexers writes the data gathered by all the indexers to the batch
+func flushIndexers(indexers []txIndexer, wb storage.Batch) error {
+	for _, indexer := range indexers {
+		if err := indexer.flush(wb); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package fetch
+
+import (
+	"testing"
+
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/tx-indexer/internal/mock"
+)
+
+func TestIndexTx_InvalidTx(t *testing.T) {
+	t.Parallel()
+
+	var (
+		block = &types.Block{}
+		tx    = &types.TxResult{
+			Tx: []byte("totally invalid tx"),
+		}
+
+		ai = newAccountIndexer(&mock.Storage{})
+		pi = newPackageIndexer(&mock.Storage{})
+	)
+
+	require.NoError(t, indexTx([]txIndexer{ai, pi}, block, tx))
+	assert.Empty(t, ai.accounts)
+	assert.Empty(t, ai.txs)
+	assert.Empty(t, pi.packages)
+}
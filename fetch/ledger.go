@@ -0,0 +1,251 @@
+package fetch
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/gnolang/gno/gno.land/pkg/sdk/vm"
+	"github.com/gnolang/gno/gnovm/pkg/gnolang"
+	"github.com/gnolang/gno/gnovm/stdlibs/chain"
+	bft_types "github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	"github.com/gnolang/gno/tm2/pkg/sdk/bank"
+	"github.com/gnolang/gno/tm2/pkg/std"
+
+	"github.com/gnolang/tx-indexer/storage"
+	storageErrors "github.com/gnolang/tx-indexer/storage/errors"
+	"github.com/gnolang/tx-indexer/types"
+)
+
+var (
+	// feeCollector is the default address gas fees are paid to
+	feeCollector = crypto.AddressFromPreimage([]byte("fee_collector")).String()
+
+	// storageFeeCollector is the default address withheld storage deposit refunds are paid to
+	storageFeeCollector = crypto.AddressFromPreimage([]byte("storage_fee_collector")).String()
+)
+
+// balanceKey identifies the balance of an address in a single denomination
+type balanceKey struct {
+	address string
+	denom   string
+}
+
+// balanceDeltaKey identifies the balance change of an address
+// in a single denomination, within a single block
+type balanceDeltaKey struct {
+	balanceKey
+
+	height int64
+}
+
+var (
+	_ txIndexer      = &ledgerIndexer{}
+	_ genesisIndexer = &ledgerIndexer{}
+)
+
+// ledgerIndexer derives the native coin movements,
+// and the resulting balance changes, of the transactions of a single slot
+type ledgerIndexer struct {
+	storage   storage.Reader
+	deltas    map[balanceDeltaKey]int64
+	transfers []*types.Transfer
+}
+
+// newLedgerIndexer creates a new ledger indexer for a single slot write
+func newLedgerIndexer(storage storage.Reader) *ledgerIndexer {
+	return &ledgerIndexer{
+		storage:   storage,
+		deltas:    make(map[balanceDeltaKey]int64),
+		transfers: make([]*types.Transfer, 0),
+	}
+}
+
+// indexGenesis records the genesis balances, as the baseline
+// of the balance changes of the genesis block
+func (li *ledgerIndexer) indexGenesis(genesis *types.Genesis) error {
+	for _, balance := range genesis.Balances {
+		for _, coin := range balance.Coins {
+			li.deltas[balanceDeltaKey{balanceKey{balance.Address, coin.Denom}, 0}] += coin.Amount
+		}
+	}
+
+	return nil
+}
+
+// indexTx records the coin movements of the transaction: the gas fee,
+// the coins sent by its messages, and the storage deposits of its events.
+// Only successful transactions are accounted for
+func (li *ledgerIndexer) indexTx(_ *bft_types.Block, txResult *bft_types.TxResult, tx *std.Tx) error {
+	if !txResult.Response.IsOK() {
+		return nil
+	}
+
+	var (
+		position uint32
+		payer    string
+	)
+
+	transfer := func(kind types.TransferKind, from, to string, coins ...std.Coin) {
+		for _, coin := range coins {
+			if coin.Amount <= 0 {
+				continue
+			}
+
+			li.transfers = append(li.transfers, &types.Transfer{
+				From:     from,
+				To:       to,
+				Denom:    coin.Denom,
+				Kind:     kind,
+				Amount:   coin.Amount,
+				Height:   txResult.Height,
+				Index:    txResult.Index,
+				Position: position,
+			})
+
+			position++
+
+			li.deltas[balanceDeltaKey{balanceKey{from, coin.Denom}, txResult.Height}] -= coin.Amount
+			li.deltas[balanceDeltaKey{balanceKey{to, coin.Denom}, txResult.Height}] += coin.Amount
+		}
+	}
+
+	// The gas fee is paid by the first signer
+	if signers := tx.GetSigners(); len(signers) > 0 {
+		payer = signers[0].String()
+
+		transfer(types.TransferKindFee, payer, feeCollector, tx.Fee.GasFee)
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		switch m := msg.(type) {
+		case bank.MsgSend:
+			transfer(types.TransferKindSend, m.FromAddress.String(), m.ToAddress.String(), m.Amount...)
+		case vm.MsgCall:
+			if m.PkgPath == "" {
+				continue
+			}
+
+			transfer(
+				types.TransferKindCall,
+				m.Caller.String(),
+				gnolang.DerivePkgBech32Addr(m.PkgPath).String(),
+				m.Send...,
+			)
+		case vm.MsgAddPackage:
+			if m.Package == nil || m.Package.Path == "" {
+				continue
+			}
+
+			transfer(
+				types.TransferKindAddPackage,
+				m.Creator.String(),
+				gnolang.DerivePkgBech32Addr(m.Package.Path).String(),
+				m.Send...,
+			)
+		case vm.MsgRun:
+			// The coins are sent to the caller itself
+			transfer(types.TransferKindRun, m.Caller.String(), m.Caller.String(), m.Send...)
+		}
+	}
+
+	// Storage deposits are locked from, and refunded to, the transaction signer
+	for _, event := range txResult.Response.Events {
+		switch e := event.(type) {
+		case chain.StorageDepositEvent:
+			if e.PkgPath == "" {
+				continue
+			}
+
+			transfer(
+				types.TransferKindStorageDeposit,
+				payer,
+				gnolang.DeriveStorageDepositBech32Addr(e.PkgPath).String(),
+				e.FeeDelta,
+			)
+		case chain.StorageUnlockEvent:
+			if e.PkgPath == "" {
+				continue
+			}
+
+			receiver := payer
+			if e.RefundWithheld {
+				receiver = storageFeeCollector
+			}
+
+			transfer(
+				types.TransferKindStorageUnlock,
+				gnolang.DeriveStorageDepositBech32Addr(e.PkgPath).String(),
+				receiver,
+				e.FeeRefund,
+			)
+		}
+	}
+
+	return nil
+}
+
+// flush writes the coin movements and balance changes gathered so far to the batch
+func (li *ledgerIndexer) flush(wb storage.Batch) error {
+	for _, transfer := range li.transfers {
+		if err := wb.SetTransfer(transfer); err != nil {
+			return fmt.Errorf("unable to save transfer, %w", err)
+		}
+	}
+
+	keys := make([]balanceDeltaKey, 0, len(li.deltas))
+	for key := range li.deltas {
+		keys = append(keys, key)
+	}
+
+	// Balances are accumulated in height order
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].address != keys[j].address {
+			return keys[i].address < keys[j].address
+		}
+
+		if keys[i].denom != keys[j].denom {
+			return keys[i].denom < keys[j].denom
+		}
+
+		return keys[i].height < keys[j].height
+	})
+
+	balances := make(map[balanceKey]int64)
+
+	for _, key := range keys {
+		delta := li.deltas[key]
+		if delta == 0 {
+			// Self transfers, or movements cancelling out within the block
+			continue
+		}
+
+		balance, ok := balances[key.balanceKey]
+		if !ok {
+			latest, err := li.storage.GetLatestBalance(key.address, key.denom)
+			if err != nil && !errors.Is(err, storageErrors.ErrNotFound) {
+				return fmt.Errorf("unable to fetch balance of %s, %w", key.address, err)
+			}
+
+			if latest != nil {
+				balance = latest.Balance
+			}
+		}
+
+		balance += delta
+		balances[key.balanceKey] = balance
+
+		if err := wb.SetBalanceDelta(&types.BalanceDelta{
+			Address: key.address,
+			Denom:   key.denom,
+			Delta:   delta,
+			Balance: balance,
+			Height:  key.height,
+		}); err != nil {
+			return fmt.Errorf("unable to save balance of %s, %w", key.address, err)
+		}
+	}
+
+	return nil
+}
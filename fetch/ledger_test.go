@@ -0,0 +1,193 @@
+package fetch
+
+import (
+	"testing"
+
+	"github.com/gnolang/gno/gno.land/pkg/sdk/vm"
+	"github.com/gnolang/gno/gnovm/pkg/gnolang"
+	"github.com/gnolang/gno/gnovm/stdlibs/chain"
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	"github.com/gnolang/gno/tm2/pkg/sdk/bank"
+	"github.com/gnolang/gno/tm2/pkg/std"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/tx-indexer/internal/mock"
+	storageErrors "github.com/gnolang/tx-indexer/storage/errors"
+	indexerTypes "github.com/gnolang/tx-indexer/types"
+)
+
+func TestLedgerIndexer_IndexTx(t *testing.T) {
+	t.Parallel()
+
+	var (
+		alice = crypto.AddressFromPreimage([]byte("alice"))
+		bob   = crypto.AddressFromPreimage([]byte("bob"))
+
+		realm        = "gno.land/r/demo/foo20"
+		realmAddr    = gnolang.DerivePkgBech32Addr(realm).String()
+		depositsAddr = gnolang.DeriveStorageDepositBech32Addr(realm).String()
+
+		transfers = make([]*indexerTypes.Transfer, 0)
+		deltas    = make([]*indexerTypes.BalanceDelta, 0)
+
+		mockStorage = &mock.Storage{
+			GetLatestBalanceFn: func(address, denom string) (*indexerTypes.BalanceDelta, error) {
+				if address == alice.String() && denom == "ugnot" {
+					return &indexerTypes.BalanceDelta{
+						Address: address,
+						Denom:   denom,
+						Balance: 1000,
+						Height:  1,
+					}, nil
+				}
+
+				return nil, storageErrors.ErrNotFound
+			},
+		}
+
+		mockBatch = &mock.WriteBatch{
+			SetTransferFn: func(transfer *indexerTypes.Transfer) error {
+				transfers = append(transfers, transfer)
+
+				return nil
+			},
+			SetBalanceDeltaFn: func(delta *indexerTypes.BalanceDelta) error {
+				deltas = append(deltas, delta)
+
+				return nil
+			},
+		}
+	)
+
+	newTx := func(height int64, success bool, events []abci.Event, msgs ...std.Msg) *types.TxResult {
+		encodedTx, err := amino.Marshal(&std.Tx{
+			Msgs: msgs,
+			Fee:  std.NewFee(1000, std.NewCoin("ugnot", 10)),
+		})
+		require.NoError(t, err)
+
+		response := abci.ResponseDeliverTx{
+			ResponseBase: abci.ResponseBase{
+				Events: events,
+			},
+		}
+
+		if !success {
+			response.Error = abci.StringError("failed")
+		}
+
+		return &types.TxResult{
+			Height:   height,
+			Tx:       encodedTx,
+			Response: response,
+		}
+	}
+
+	txs := []*types.TxResult{
+		newTx(
+			10,
+			true,
+			[]abci.Event{
+				chain.StorageDepositEvent{
+					FeeDelta: std.NewCoin("ugnot", 5),
+					PkgPath:  realm,
+				},
+			},
+			bank.MsgSend{
+				FromAddress: alice,
+				ToAddress:   bob,
+				Amount:      std.NewCoins(std.NewCoin("ugnot", 100), std.NewCoin("foo", 3)),
+			},
+			vm.MsgCall{
+				Caller:  alice,
+				PkgPath: realm,
+				Func:    "Transfer",
+				Send:    std.NewCoins(std.NewCoin("ugnot", 50)),
+			},
+		),
+		// Failed transactions are not accounted for
+		newTx(
+			10,
+			false,
+			nil,
+			bank.MsgSend{
+				FromAddress: alice,
+				ToAddress:   bob,
+				Amount:      std.NewCoins(std.NewCoin("ugnot", 500)),
+			},
+		),
+		newTx(
+			11,
+			true,
+			nil,
+			bank.MsgSend{
+				FromAddress: bob,
+				ToAddress:   alice,
+				Amount:      std.NewCoins(std.NewCoin("ugnot", 40)),
+			},
+		),
+	}
+
+	li := newLedgerIndexer(mockStorage)
+
+	for _, tx := range txs {
+		require.NoError(t, indexTx([]txIndexer{li}, &types.Block{}, tx))
+	}
+
+	require.NoError(t, li.flush(mockBatch))
+
+	// Make sure all the movements are recorded, in transaction order
+	kinds := make([]indexerTypes.TransferKind, 0, len(transfers))
+	for _, transfer := range transfers {
+		kinds = append(kinds, transfer.Kind)
+	}
+
+	assert.Equal(t, []indexerTypes.TransferKind{
+		indexerTypes.TransferKindFee,
+		indexerTypes.TransferKindSend,
+		indexerTypes.TransferKindSend,
+		indexerTypes.TransferKindCall,
+		indexerTypes.TransferKindStorageDeposit,
+		indexerTypes.TransferKindFee,
+		indexerTypes.TransferKindSend,
+	}, kinds)
+
+	assert.Equal(t, realmAddr, transfers[3].To)
+	assert.Equal(t, alice.String(), transfers[4].From)
+	assert.Equal(t, depositsAddr, transfers[4].To)
+	assert.Equal(t, uint32(4), transfers[4].Position)
+
+	// Make sure the balances are accumulated on top of the stored ones
+	balances := make(map[string]map[int64]*indexerTypes.BalanceDelta)
+	for _, delta := range deltas {
+		key := delta.Address + "/" + delta.Denom
+
+		if balances[key] == nil {
+			balances[key] = make(map[int64]*indexerTypes.BalanceDelta)
+		}
+
+		balances[key][delta.Height] = delta
+	}
+
+	aliceUgnot := balances[alice.String()+"/ugnot"]
+	require.Len(t, aliceUgnot, 2)
+
+	assert.Equal(t, int64(-165), aliceUgnot[10].Delta)
+	assert.Equal(t, int64(835), aliceUgnot[10].Balance)
+	assert.Equal(t, int64(40), aliceUgnot[11].Delta)
+	assert.Equal(t, int64(875), aliceUgnot[11].Balance)
+
+	bobUgnot := balances[bob.String()+"/ugnot"]
+	require.Len(t, bobUgnot, 2)
+
+	assert.Equal(t, int64(100), bobUgnot[10].Balance)
+	assert.Equal(t, int64(50), bobUgnot[11].Balance)
+
+	assert.Equal(t, int64(3), balances[bob.String()+"/foo"][10].Balance)
+	assert.Equal(t, int64(50), balances[realmAddr+"/ugnot"][10].Balance)
+	assert.Equal(t, int64(5), balances[depositsAddr+"/ugnot"][10].Balance)
+}
@@ -14,6 +14,8 @@ type (
 	getBlockDelegate             func(uint64) (*core_types.ResultBlock, error)
 	getBlockResultsDelegate      func(uint64) (*core_types.ResultBlockResults, error)
 	getGenesisDelegate           func() (*core_types.ResultGenesis, error)
+	getValidatorsDelegate        func(uint64) (*core_types.ResultValidators, error)
+	getUnconfirmedTxsDelegate    func(int) (*core_types.ResultUnconfirmedTxs, error)
 
 	createBatchDelegate func() clientTypes.Batch
 )
@@ -23,6 +25,8 @@ type mockClient struct {
 	getBlockFn             getBlockDelegate
 	getBlockResultsFn      getBlockResultsDelegate
 	getGenesisFn           getGenesisDelegate
+	getValidatorsFn        getValidatorsDelegate
+	getUnconfirmedTxsFn    getUnconfirmedTxsDelegate
 
 	createBatchFn createBatchDelegate
 }
@@ -59,6 +63,22 @@ func (m *mockClient) GetBlockResults(ctx context.Context, blockNum uint64) (*cor
 	return nil, nil
 }
 
+func (m *mockClient) GetValidators(ctx context.Context, blockNum uint64) (*core_types.ResultValidators, error) {
+	if m.getValidatorsFn != nil {
+		return m.getValidatorsFn(blockNum)
+	}
+
+	return nil, nil
+}
+
+func (m *mockClient) GetUnconfirmedTxs(ctx context.Context, limit int) (*core_types.ResultUnconfirmedTxs, error) {
+	if m.getUnconfirmedTxsFn != nil {
+		return m.getUnconfirmedTxsFn(limit)
+	}
+
+	return nil, nil
+}
+
 func (m *mockClient) CreateBatch() clientTypes.Batch {
 	if m.createBatchFn != nil {
 		return m.createBatchFn()
@@ -70,6 +90,7 @@ func (m *mockClient) CreateBatch() clientTypes.Batch {
 type (
 	addBlockRequestDelegate        func(uint64) error
 	addBlockResultsRequestDelegate func(uint64) error
+	addValidatorsRequestDelegate   func(uint64) error
 	executeDelegate                func(context.Context) ([]any, error)
 	countDelegate                  func() int
 )
@@ -77,6 +98,7 @@ type (
 type mockBatch struct {
 	addBlockRequestFn        addBlockRequestDelegate
 	addBlockResultsRequestFn addBlockResultsRequestDelegate
+	addValidatorsRequestFn   addValidatorsRequestDelegate
 	executeFn                executeDelegate
 	countFn                  countDelegate
 }
@@ -97,6 +119,14 @@ func (m *mockBatch) AddBlockResultsRequest(num uint64) error {
 	return nil
 }
 
+func (m *mockBatch) AddValidatorsRequest(num uint64) error {
+	if m.addValidatorsRequestFn != nil {
+		return m.addValidatorsRequestFn(num)
+	}
+
+	return nil
+}
+
 func (m *mockBatch) Execute(ctx context.Context) ([]any, error) {
 	if m.executeFn != nil {
 		return m.executeFn(ctx)
@@ -0,0 +1,373 @@
+package fetch
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/gnolang/gno/gno.land/pkg/sdk/vm"
+	"github.com/gnolang/gno/gnovm/pkg/gnolang"
+	"github.com/gnolang/gno/gnovm/stdlibs/chain"
+	bft_types "github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/std"
+
+	"github.com/gnolang/tx-indexer/storage"
+	storageErrors "github.com/gnolang/tx-indexer/storage/errors"
+	"github.com/gnolang/tx-indexer/types"
+)
+
+const (
+	grc721MintEvent     = "Mint"
+	grc721TransferEvent = "Transfer"
+	grc721BurnEvent     = "Burn"
+
+	// grc721TokenIDAttr is the event attribute holding the token ID
+	grc721TokenIDAttr = "tokenId"
+
+	// grc721SymbolAttr is the event attribute holding the collection symbol
+	grc721SymbolAttr = "slug"
+
+	// grc721PkgName is the name of the package declaring the GRC721 collection constructor
+	grc721PkgName = "grc721"
+
+	// grc721Constructor is the GRC721 collection constructor, announcing the name and symbol
+	grc721Constructor = "NewBasicNFT"
+)
+
+// nftKey identifies a single GRC721 token
+type nftKey struct {
+	collection string
+	tokenID    string
+}
+
+// nftState is the ownership state of a GRC721 token within a single slot
+type nftState struct {
+	nft       *types.NFT
+	prevOwner string // owner before the slot, to be removed from the owner index
+}
+
+var _ txIndexer = &nftIndexer{}
+
+// nftIndexer derives the GRC721 collections, the ownership changes
+// and the resulting token owners, of the transactions of a single slot
+type nftIndexer struct {
+	storage     storage.Reader
+	collections map[string]*types.NFTCollection
+	nfts        map[nftKey]*nftState
+	events      []*types.NFTEvent
+}
+
+// newNFTIndexer creates a new GRC721 ownership indexer for a single slot write
+func newNFTIndexer(storage storage.Reader) *nftIndexer {
+	return &nftIndexer{
+		storage:     storage,
+		collections: make(map[string]*types.NFTCollection),
+		nfts:        make(map[nftKey]*nftState),
+		events:      make([]*types.NFTEvent, 0),
+	}
+}
+
+// indexTx records the GRC721 collections announced by the deployed realms,
+// and the GRC721 ownership events emitted by the transaction.
+// Only successful transactions are accounted for, and events
+// that don't follow the GRC721 format are skipped
+func (ni *nftIndexer) indexTx(_ *bft_types.Block, txResult *bft_types.TxResult, tx *std.Tx) error {
+	if !txResult.Response.IsOK() {
+		return nil
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		addPackage, ok := msg.(vm.MsgAddPackage)
+		if !ok || addPackage.Package == nil || !gnolang.IsRealmPath(addPackage.Package.Path) {
+			continue
+		}
+
+		for _, call := range parsePackageCalls(addPackage.Package.Files, grc721PkgName, grc721Constructor) {
+			if len(call.Args) != 2 {
+				continue
+			}
+
+			name, nameOk := stringLiteral(call.Args[0])
+			symbol, symbolOk := stringLiteral(call.Args[1])
+
+			if !nameOk || !symbolOk {
+				continue
+			}
+
+			collection, err := ni.collection(addPackage.Package.Path, txResult.Height)
+			if err != nil {
+				return err
+			}
+
+			collection.Name = name
+			collection.Symbol = symbol
+		}
+	}
+
+	// GRC721 events are emitted by the GRC721 package, on behalf of the collection realm
+	target := targetRealm(tx)
+
+	for eventIndex, abciEvent := range txResult.Response.Events {
+		event, ok := abciEvent.(chain.Event)
+		if !ok {
+			continue
+		}
+
+		switch event.Type {
+		case grc721MintEvent, grc721TransferEvent, grc721BurnEvent:
+		default:
+			continue
+		}
+
+		record, ok := parseNFTEvent(event)
+		if !ok {
+			continue
+		}
+
+		record.Collection = event.PkgPath
+		if !gnolang.IsRealmPath(record.Collection) {
+			record.Collection = target
+		}
+
+		if record.Collection == "" {
+			continue
+		}
+
+		record.Height = txResult.Height
+		record.Index = txResult.Index
+		record.EventIndex = uint32(eventIndex)
+
+		if err := ni.apply(record, event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// apply records the ownership change, updating the token owner
+// and the collection counters
+func (ni *nftIndexer) apply(record *types.NFTEvent, event chain.Event) error {
+	collection, err := ni.collection(record.Collection, record.Height)
+	if err != nil {
+		return err
+	}
+
+	if collection.Symbol == "" {
+		for _, attr := range event.Attributes {
+			if attr.Key == grc721SymbolAttr {
+				collection.Symbol = attr.Value
+			}
+		}
+	}
+
+	state, err := ni.nft(record.Collection, record.TokenID)
+	if err != nil {
+		return err
+	}
+
+	switch record.Kind {
+	case types.NFTEventMint:
+		collection.Minted++
+
+		state.nft.MintHeight = record.Height
+		state.nft.Burned = false
+	case types.NFTEventTransfer:
+		collection.Transfers++
+	case types.NFTEventBurn:
+		collection.Burned++
+
+		state.nft.Burned = true
+	}
+
+	state.nft.Owner = record.To
+	state.nft.Height = record.Height
+
+	ni.events = append(ni.events, record)
+
+	return nil
+}
+
+// collection returns the collection of the given realm,
+// fetching it from the storage, or registering it, the first time it is seen
+func (ni *nftIndexer) collection(pkgPath string, height int64) (*types.NFTCollection, error) {
+	if collection, ok := ni.collections[pkgPath]; ok {
+		return collection, nil
+	}
+
+	collection, err := ni.storage.GetNFTCollection(pkgPath)
+	if err != nil && !errors.Is(err, storageErrors.ErrNotFound) {
+		return nil, fmt.Errorf("unable to fetch collection %s, %w", pkgPath, err)
+	}
+
+	if collection == nil {
+		collection = &types.NFTCollection{
+			PkgPath:         pkgPath,
+			FirstSeenHeight: height,
+		}
+	}
+
+	ni.collections[pkgPath] = collection
+
+	return collection, nil
+}
+
+// nft returns the ownership state of the given token,
+// fetching it from the storage the first time it is seen
+func (ni *nftIndexer) nft(collection, tokenID string) (*nftState, error) {
+	key := nftKey{collection, tokenID}
+
+	if state, ok := ni.nfts[key]; ok {
+		return state, nil
+	}
+
+	nft, err := ni.storage.GetNFT(collection, tokenID)
+	if err != nil && !errors.Is(err, storageErrors.ErrNotFound) {
+		return nil, fmt.Errorf("unable to fetch token %s of %s, %w", tokenID, collection, err)
+	}
+
+	state := &nftState{
+		nft: nft,
+	}
+
+	if nft == nil {
+		state.nft = &types.NFT{
+			Collection: collection,
+			TokenID:    tokenID,
+		}
+	} else {
+		state.prevOwner = nft.Owner
+	}
+
+	ni.nfts[key] = state
+
+	return state, nil
+}
+
+// flush writes the ownership changes, the collections
+// and the token owners gathered so far to the batch
+func (ni *nftIndexer) flush(wb storage.Batch) error {
+	for _, event := range ni.events {
+		if err := wb.SetNFTEvent(event); err != nil {
+			return fmt.Errorf("unable to save NFT event, %w", err)
+		}
+	}
+
+	paths := make([]string, 0, len(ni.collections))
+	for pkgPath := range ni.collections {
+		paths = append(paths, pkgPath)
+	}
+
+	sort.Strings(paths)
+
+	for _, pkgPath := range paths {
+		if err := wb.SetNFTCollection(ni.collections[pkgPath]); err != nil {
+			return fmt.Errorf("unable to save collection %s, %w", pkgPath, err)
+		}
+	}
+
+	keys := make([]nftKey, 0, len(ni.nfts))
+	for key := range ni.nfts {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].collection != keys[j].collection {
+			return keys[i].collection < keys[j].collection
+		}
+
+		return keys[i].tokenID < keys[j].tokenID
+	})
+
+	for _, key := range keys {
+		state := ni.nfts[key]
+
+		if state.prevOwner != "" && state.prevOwner != state.nft.Owner {
+			if err := wb.RemoveNFTOwner(state.prevOwner, key.collection, key.tokenID); err != nil {
+				return fmt.Errorf("unable to remove owner of %s, %w", key.tokenID, err)
+			}
+		}
+
+		if err := wb.SetNFT(state.nft); err != nil {
+			return fmt.Errorf("unable to save token %s of %s, %w", key.tokenID, key.collection, err)
+		}
+	}
+
+	return nil
+}
+
+// parseNFTEvent validates the GRC721 event attributes,
+// and extracts the ownership change, without its collection and position
+func parseNFTEvent(event chain.Event) (*types.NFTEvent, bool) {
+	attrs, ok := eventAttributes(event)
+	if !ok {
+		return nil, false
+	}
+
+	tokenID := attrs[grc721TokenIDAttr]
+	if tokenID == "" {
+		return nil, false
+	}
+
+	// GRC20 transfers share the event type, but move a value instead of a token ID
+	if _, isToken := attrs["value"]; isToken {
+		return nil, false
+	}
+
+	record := &types.NFTEvent{
+		TokenID: tokenID,
+		From:    attrs["from"],
+		To:      attrs["to"],
+	}
+
+	switch event.Type {
+	case grc721MintEvent:
+		record.Kind = types.NFTEventMint
+
+		ok = record.From == "" && isTokenHolder(record.To, false)
+	case grc721TransferEvent:
+		record.Kind = types.NFTEventTransfer
+
+		ok = isTokenHolder(record.From, false) && isTokenHolder(record.To, false)
+	case grc721BurnEvent:
+		record.Kind = types.NFTEventBurn
+
+		ok = isTokenHolder(record.From, false) && record.To == ""
+	default:
+		ok = false
+	}
+
+	return record, ok
+}
+
+// targetRealm returns the realm the transaction messages are all addressed to,
+// if there is a single one. Events emitted by pure packages are attributed to it
+func targetRealm(tx *std.Tx) string {
+	var target string
+
+	for _, msg := range tx.GetMsgs() {
+		var pkgPath string
+
+		switch m := msg.(type) {
+		case vm.MsgCall:
+			pkgPath = m.PkgPath
+		case vm.MsgAddPackage:
+			if m.Package != nil {
+				pkgPath = m.Package.Path
+			}
+		case vm.MsgRun:
+			// Scripts can call any realm
+			return ""
+		default:
+			continue
+		}
+
+		if !gnolang.IsRealmPath(pkgPath) || (target != "" && target != pkgPath) {
+			return ""
+		}
+
+		target = pkgPath
+	}
+
+	return target
+}
@@ -0,0 +1,256 @@
+package fetch
+
+import (
+	"testing"
+
+	"github.com/gnolang/gno/gno.land/pkg/sdk/vm"
+	"github.com/gnolang/gno/gnovm/stdlibs/chain"
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	"github.com/gnolang/gno/tm2/pkg/std"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/tx-indexer/internal/mock"
+	storageErrors "github.com/gnolang/tx-indexer/storage/errors"
+	indexerTypes "github.com/gnolang/tx-indexer/types"
+)
+
+// newNFTEvent creates a GRC721 event, from the given attribute key / value pairs
+func newNFTEvent(typ string, attrs ...string) chain.Event {
+	event := newTransferEvent("gno.land/p/demo/tokens/grc721", attrs...)
+	event.Type = typ
+
+	return event
+}
+
+func TestNFTIndexer_IndexTx(t *testing.T) {
+	t.Parallel()
+
+	var (
+		alice  = crypto.AddressFromPreimage([]byte("alice"))
+		bob    = crypto.AddressFromPreimage([]byte("bob"))
+		caller = crypto.AddressFromPreimage([]byte("caller"))
+
+		realm = "gno.land/r/demo/foo721"
+
+		events      = make([]*indexerTypes.NFTEvent, 0)
+		collections = make([]*indexerTypes.NFTCollection, 0)
+		nfts        = make([]*indexerTypes.NFT, 0)
+		removed     = make([]string, 0)
+
+		mockStorage = &mock.Storage{
+			GetNFTFn: func(collection, tokenID string) (*indexerTypes.NFT, error) {
+				if collection == realm && tokenID == "1" {
+					return &indexerTypes.NFT{
+						Collection: collection,
+						TokenID:    tokenID,
+						Owner:      alice.String(),
+						MintHeight: 1,
+						Height:     1,
+					}, nil
+				}
+
+				return nil, storageErrors.ErrNotFound
+			},
+		}
+
+		mockBatch = &mock.WriteBatch{
+			SetNFTEventFn: func(event *indexerTypes.NFTEvent) error {
+				events = append(events, event)
+
+				return nil
+			},
+			SetNFTCollectionFn: func(collection *indexerTypes.NFTCollection) error {
+				collections = append(collections, collection)
+
+				return nil
+			},
+			SetNFTFn: func(nft *indexerTypes.NFT) error {
+				nfts = append(nfts, nft)
+
+				return nil
+			},
+			RemoveNFTOwnerFn: func(owner, _, tokenID string) error {
+				removed = append(removed, owner+"/"+tokenID)
+
+				return nil
+			},
+		}
+	)
+
+	newTx := func(height int64, events []abci.Event, msgs ...std.Msg) *types.TxResult {
+		encodedTx, err := amino.Marshal(&std.Tx{
+			Msgs: msgs,
+		})
+		require.NoError(t, err)
+
+		return &types.TxResult{
+			Height: height,
+			Tx:     encodedTx,
+			Response: abci.ResponseDeliverTx{
+				ResponseBase: abci.ResponseBase{
+					Events: events,
+				},
+			},
+		}
+	}
+
+	call := vm.MsgCall{
+		Caller:  caller,
+		PkgPath: realm,
+		Func:    "TransferFrom",
+	}
+
+	txs := []*types.TxResult{
+		newTx(
+			10,
+			[]abci.Event{
+				newNFTEvent(grc721MintEvent, "slug", "FNFT", "to", bob.String(), "tokenId", "2"),
+			},
+			vm.MsgAddPackage{
+				Creator: caller,
+				Package: &std.MemPackage{
+					Name: "foo721",
+					Path: realm,
+					Files: []*std.MemFile{
+						{
+							Name: "foo721.gno",
+							Body: `package foo721
+
+import "gno.land/p/demo/tokens/grc721"
+
+var foo = grc721.NewBasicNFT("FooNFT", "FNFT")
+`,
+						},
+					},
+				},
+			},
+		),
+		newTx(
+			11,
+			[]abci.Event{
+				newNFTEvent(grc721TransferEvent, "slug", "FNFT", "from", alice.String(), "to", bob.String(), "tokenId", "1"),
+				newNFTEvent(grc721BurnEvent, "slug", "FNFT", "from", bob.String(), "tokenId", "2"),
+				// Non-standard events are skipped
+				newNFTEvent(grc721TransferEvent, "slug", "FNFT", "from", alice.String(), "to", "bob", "tokenId", "3"),
+				newNFTEvent(grc721MintEvent, "slug", "FNFT", "to", bob.String()),
+				newNFTEvent(grc721TransferEvent, "token", "FOO", "from", alice.String(), "to", bob.String(), "value", "1"),
+			},
+			call,
+		),
+		// Events of scripts can't be attributed to a collection
+		newTx(
+			12,
+			[]abci.Event{
+				newNFTEvent(grc721TransferEvent, "slug", "FNFT", "from", bob.String(), "to", alice.String(), "tokenId", "1"),
+			},
+			vm.MsgRun{
+				Caller: caller,
+			},
+		),
+	}
+
+	ni := newNFTIndexer(mockStorage)
+
+	for _, tx := range txs {
+		require.NoError(t, indexTx([]txIndexer{ni}, &types.Block{}, tx))
+	}
+
+	require.NoError(t, ni.flush(mockBatch))
+
+	// Make sure the full provenance is recorded, in transaction order
+	assert.Equal(t, []*indexerTypes.NFTEvent{
+		{
+			Collection: realm,
+			TokenID:    "2",
+			Kind:       indexerTypes.NFTEventMint,
+			To:         bob.String(),
+			Height:     10,
+		},
+		{
+			Collection: realm,
+			TokenID:    "1",
+			Kind:       indexerTypes.NFTEventTransfer,
+			From:       alice.String(),
+			To:         bob.String(),
+			Height:     11,
+		},
+		{
+			Collection: realm,
+			TokenID:    "2",
+			Kind:       indexerTypes.NFTEventBurn,
+			From:       bob.String(),
+			Height:     11,
+			EventIndex: 1,
+		},
+	}, events)
+
+	// Make sure the collection is announced and accounted for
+	assert.Equal(t, []*indexerTypes.NFTCollection{
+		{
+			PkgPath:         realm,
+			Name:            "FooNFT",
+			Symbol:          "FNFT",
+			Minted:          1,
+			Burned:          1,
+			Transfers:       1,
+			FirstSeenHeight: 10,
+		},
+	}, collections)
+
+	// Make sure the owner index follows the ownership changes
+	assert.Equal(t, []*indexerTypes.NFT{
+		{
+			Collection: realm,
+			TokenID:    "1",
+			Owner:      bob.String(),
+			MintHeight: 1,
+			Height:     11,
+		},
+		{
+			Collection: realm,
+			TokenID:    "2",
+			MintHeight: 10,
+			Height:     11,
+			Burned:     true,
+		},
+	}, nfts)
+
+	assert.Equal(t, []string{alice.String() + "/1"}, removed)
+}
+
+func TestTargetRealm(t *testing.T) {
+	t.Parallel()
+
+	var (
+		caller = crypto.AddressFromPreimage([]byte("caller"))
+
+		foo = vm.MsgCall{Caller: caller, PkgPath: "gno.land/r/demo/foo721"}
+		bar = vm.MsgCall{Caller: caller, PkgPath: "gno.land/r/demo/bar721"}
+		pkg = vm.MsgCall{Caller: caller, PkgPath: "gno.land/p/demo/avl"}
+		run = vm.MsgRun{Caller: caller}
+	)
+
+	testTable := []struct {
+		name     string
+		expected string
+		msgs     []std.Msg
+	}{
+		{"single realm", foo.PkgPath, []std.Msg{foo, foo}},
+		{"multiple realms", "", []std.Msg{foo, bar}},
+		{"pure package", "", []std.Msg{pkg}},
+		{"script", "", []std.Msg{foo, run}},
+		{"no messages", "", nil},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testCase.expected, targetRealm(&std.Tx{Msgs: testCase.msgs}))
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package fetch
+
+import (
+	"errors"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gnolang/gno/gno.land/pkg/sdk/vm"
+	bft_types "github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/std"
+
+	"github.com/gnolang/tx-indexer/storage"
+	storageErrors "github.com/gnolang/tx-indexer/storage/errors"
+	"github.com/gnolang/tx-indexer/types"
+)
+
+// maxRecentPackageCallers is the number of most recent
+// vm.MsgCall calls kept for each package
+const maxRecentPackageCallers = 20
+
+var _ txIndexer = &packageIndexer{}
+
+// packageIndexer keeps the package catalog up to date
+// with the transactions of a single slot
+type packageIndexer struct {
+	storage  storage.Reader
+	packages map[string]*types.Package
+	deployed []string // paths successfully deployed within the slot
+}
+
+// newPackageIndexer creates a new package indexer for a single slot write
+func newPackageIndexer(storage storage.Reader) *packageIndexer {
+	return &packageIndexer{
+		storage:  storage,
+		packages: make(map[string]*types.Package),
+	}
+}
+
+// indexTx registers the package deployments (vm.MsgAddPackage),
+// and the calls made to known packages (vm.MsgCall)
+func (pi *packageIndexer) indexTx(_ *bft_types.Block, txResult *bft_types.TxResult, tx *std.Tx) error {
+	success := txResult.Response.IsOK()
+
+	for _, msg := range tx.GetMsgs() {
+		switch m := msg.(type) {
+		case vm.MsgAddPackage:
+			if m.Package == nil {
+				continue
+			}
+
+			existing, err := pi.getPackage(m.Package.Path)
+			if err != nil && !errors.Is(err, storageErrors.ErrNotFound) {
+				return err
+			}
+
+			if existing != nil && existing.Success {
+				// Packages are immutable once deployed,
+				// so any subsequent deployment attempt is ignored
+				continue
+			}
+
+			files := make([]string, 0, len(m.Package.Files))
+			for _, file := range m.Package.Files {
+				files = append(files, file.Name)
+			}
+
+			pkg := &types.Package{
+				Path:         m.Package.Path,
+				Name:         m.Package.Name,
+				Creator:      m.Creator.String(),
+				Files:        files,
+				Imports:      parseImports(m.Package.Files),
+				DeployHeight: txResult.Height,
+				DeployIndex:  txResult.Index,
+				Success:      success,
+			}
+
+			if existing != nil {
+				pkg.RecentCallers = existing.RecentCallers
+			}
+
+			pi.packages[pkg.Path] = pkg
+
+			if success {
+				pi.deployed = append(pi.deployed, pkg.Path)
+			}
+		case vm.MsgCall:
+			pkg, err := pi.getPackage(m.PkgPath)
+			if errors.Is(err, storageErrors.ErrNotFound) {
+				// Calls to unknown packages (ex. stdlibs) are not tracked
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			call := types.PackageCall{
+				Caller:  m.Caller.String(),
+				Func:    m.Func,
+				Height:  txResult.Height,
+				Index:   txResult.Index,
+				Success: success,
+			}
+
+			callers := append([]types.PackageCall{call}, pkg.RecentCallers...)
+			if len(callers) > maxRecentPackageCallers {
+				callers = callers[:maxRecentPackageCallers]
+			}
+
+			pkg.RecentCallers = callers
+		}
+	}
+
+	return nil
+}
+
+// getPackage fetches the package from the slot cache, falling back to the storage
+func (pi *packageIndexer) getPackage(path string) (*types.Package, error) {
+	if pkg, ok := pi.packages[path]; ok {
+		return pkg, nil
+	}
+
+	pkg, err := pi.storage.GetPackage(path)
+	if err != nil {
+		if errors.Is(err, storageErrors.ErrNotFound) {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("unable to fetch package %s, %w", path, err)
+	}
+
+	pi.packages[path] = pkg
+
+	return pkg, nil
+}
+
+// flush writes the package data gathered so far to the batch
+func (pi *packageIndexer) flush(wb storage.Batch) error {
+	paths := make([]string, 0, len(pi.packages))
+	for path := range pi.packages {
+		paths = append(paths, path)
+	}
+
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		if err := wb.SetPackage(pi.packages[path]); err != nil {
+			return fmt.Errorf("unable to save package %s, %w", path, err)
+		}
+	}
+
+	// Packages are immutable once successfully deployed,
+	// so their import links only need to be written once
+	for _, path := range pi.deployed {
+		for _, imported := range pi.packages[path].Imports {
+			if err := wb.SetPackageImport(imported, path); err != nil {
+				return fmt.Errorf("unable to save package import %s -> %s, %w", path, imported, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseImports extracts the sorted, unique import paths
+// of the package source files. Test files are not part of the package,
+// and files that can't be parsed are skipped
+func parseImports(files []*std.MemFile) []string {
+	var (
+		fset    = token.NewFileSet()
+		imports = make([]string, 0)
+	)
+
+	for _, file := range files {
+		if !strings.HasSuffix(file.Name, ".gno") ||
+			strings.HasSuffix(file.Name, "_test.gno") ||
+			strings.HasSuffix(file.Name, "_filetest.gno") {
+			continue
+		}
+
+		parsed, err := parser.ParseFile(fset, file.Name, file.Body, parser.ImportsOnly)
+		if err != nil {
+			continue
+		}
+
+		for _, spec := range parsed.Imports {
+			path, err := strconv.Unquote(spec.Path.Value)
+			if err != nil {
+				continue
+			}
+
+			imports = append(imports, path)
+		}
+	}
+
+	slices.Sort(imports)
+
+	return slices.Compact(imports)
+}
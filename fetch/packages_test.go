@@ -0,0 +1,247 @@
+package fetch
+
+import (
+	"testing"
+
+	"github.com/gnolang/gno/gno.land/pkg/sdk/vm"
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	"github.com/gnolang/gno/tm2/pkg/std"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/tx-indexer/internal/mock"
+	storageErrors "github.com/gnolang/tx-indexer/storage/errors"
+	indexerTypes "github.com/gnolang/tx-indexer/types"
+)
+
+func TestPackageIndexer_IndexTx(t *testing.T) {
+	t.Parallel()
+
+	var (
+		creator = crypto.AddressFromPreimage([]byte("creator"))
+		caller  = crypto.AddressFromPreimage([]byte("caller"))
+
+		existingPackage = &indexerTypes.Package{
+			Path:         "gno.land/r/demo/users",
+			Name:         "users",
+			Creator:      creator.String(),
+			Files:        []string{"users.gno"},
+			DeployHeight: 1,
+			Success:      true,
+		}
+
+		savedPackages = make(map[string]*indexerTypes.Package)
+		savedImports  = make(map[string][]string)
+
+		mockStorage = &mock.Storage{
+			GetPackageFn: func(path string) (*indexerTypes.Package, error) {
+				if path == existingPackage.Path {
+					return existingPackage, nil
+				}
+
+				return nil, storageErrors.ErrNotFound
+			},
+		}
+
+		mockBatch = &mock.WriteBatch{
+			SetPackageFn: func(pkg *indexerTypes.Package) error {
+				savedPackages[pkg.Path] = pkg
+
+				return nil
+			},
+			SetPackageImportFn: func(importedPath, importerPath string) error {
+				savedImports[importerPath] = append(savedImports[importerPath], importedPath)
+
+				return nil
+			},
+		}
+
+		block = &types.Block{
+			Header: types.Header{
+				Height: 10,
+			},
+		}
+
+		newPackage = &std.MemPackage{
+			Name: "avl",
+			Path: "gno.land/p/demo/avl",
+			Files: []*std.MemFile{
+				{Name: "avl.gno", Body: "package avl\n\nimport \"strings\""},
+				{Name: "node.gno", Body: "package avl\n\nimport (\n\t\"gno.land/p/demo/ufmt\"\n\t\"strings\"\n)"},
+			},
+		}
+	)
+
+	txs := []*types.TxResult{
+		// Failed deployment, overwritten by the next one
+		newTxResult(t, 10, 0, false, vm.MsgAddPackage{
+			Creator: caller,
+			Package: newPackage,
+		}),
+		newTxResult(t, 10, 1, true, vm.MsgAddPackage{
+			Creator: creator,
+			Package: newPackage,
+		}),
+		// Redeployment of an existing package is ignored
+		newTxResult(t, 10, 2, false, vm.MsgAddPackage{
+			Creator: caller,
+			Package: &std.MemPackage{
+				Name: "users",
+				Path: existingPackage.Path,
+			},
+		}),
+		newTxResult(t, 10, 3, true, vm.MsgCall{
+			Caller:  caller,
+			PkgPath: existingPackage.Path,
+			Func:    "Register",
+		}),
+		newTxResult(t, 10, 4, false, vm.MsgCall{
+			Caller:  caller,
+			PkgPath: existingPackage.Path,
+			Func:    "Invite",
+		}),
+		// Calls to unknown packages are not tracked
+		newTxResult(t, 10, 5, true, vm.MsgCall{
+			Caller:  caller,
+			PkgPath: "gno.land/r/demo/unknown",
+			Func:    "Render",
+		}),
+	}
+
+	pi := newPackageIndexer(mockStorage)
+
+	for _, tx := range txs {
+		require.NoError(t, indexTx([]txIndexer{pi}, block, tx))
+	}
+
+	require.NoError(t, pi.flush(mockBatch))
+	require.Len(t, savedPackages, 2)
+
+	// Make sure the new package was saved
+	avl, ok := savedPackages[newPackage.Path]
+	require.True(t, ok)
+
+	assert.Equal(t, "avl", avl.Name)
+	assert.Equal(t, creator.String(), avl.Creator)
+	assert.Equal(t, []string{"avl.gno", "node.gno"}, avl.Files)
+	assert.Equal(t, []string{"gno.land/p/demo/ufmt", "strings"}, avl.Imports)
+	assert.Equal(t, int64(10), avl.DeployHeight)
+	assert.Equal(t, uint32(1), avl.DeployIndex)
+	assert.True(t, avl.Success)
+	assert.Empty(t, avl.RecentCallers)
+
+	// Make sure only the successful deployment was linked to its imports
+	assert.Equal(t, map[string][]string{
+		newPackage.Path: {"gno.land/p/demo/ufmt", "strings"},
+	}, savedImports)
+
+	// Make sure the existing package only got the new callers
+	users, ok := savedPackages[existingPackage.Path]
+	require.True(t, ok)
+
+	assert.Equal(t, creator.String(), users.Creator)
+	assert.Equal(t, int64(1), users.DeployHeight)
+	assert.Equal(t, []indexerTypes.PackageCall{
+		{
+			Caller:  caller.String(),
+			Func:    "Invite",
+			Height:  10,
+			Index:   4,
+			Success: false,
+		},
+		{
+			Caller:  caller.String(),
+			Func:    "Register",
+			Height:  10,
+			Index:   3,
+			Success: true,
+		},
+	}, users.RecentCallers)
+}
+
+func TestPackageIndexer_RecentCallersLimit(t *testing.T) {
+	t.Parallel()
+
+	var (
+		caller = crypto.AddressFromPreimage([]byte("caller"))
+
+		pkg = &indexerTypes.Package{
+			Path:    "gno.land/r/demo/users",
+			Success: true,
+		}
+
+		mockStorage = &mock.Storage{
+			GetPackageFn: func(_ string) (*indexerTypes.Package, error) {
+				return pkg, nil
+			},
+		}
+
+		block = &types.Block{}
+	)
+
+	pi := newPackageIndexer(mockStorage)
+
+	for i := 0; i < maxRecentPackageCallers*2; i++ {
+		tx := newTxResult(t, 10, uint32(i), true, vm.MsgCall{
+			Caller:  caller,
+			PkgPath: pkg.Path,
+			Func:    "Register",
+		})
+
+		require.NoError(t, indexTx([]txIndexer{pi}, block, tx))
+	}
+
+	require.Len(t, pkg.RecentCallers, maxRecentPackageCallers)
+
+	// Make sure the newest calls are kept
+	assert.Equal(t, uint32(maxRecentPackageCallers*2-1), pkg.RecentCallers[0].Index)
+	assert.Equal(t, uint32(maxRecentPackageCallers), pkg.RecentCallers[maxRecentPackageCallers-1].Index)
+}
+
+func TestParseImports(t *testing.T) {
+	t.Parallel()
+
+	files := []*std.MemFile{
+		{
+			Name: "users.gno",
+			Body: `package users
+
+import (
+	"std"
+
+	"gno.land/p/demo/avl"
+	ufmt "gno.land/p/demo/ufmt"
+)`,
+		},
+		{
+			Name: "render.gno",
+			Body: `package users
+
+import "gno.land/p/demo/ufmt"`,
+		},
+		// Test files are not part of the package
+		{
+			Name: "users_test.gno",
+			Body: `package users
+
+import "testing"`,
+		},
+		// Non-source files are ignored
+		{
+			Name: "gno.mod",
+			Body: "module gno.land/r/demo/users",
+		},
+		// Invalid sources are skipped
+		{
+			Name: "invalid.gno",
+			Body: "totally invalid source",
+		},
+	}
+
+	assert.Equal(
+		t,
+		[]string{"gno.land/p/demo/avl", "gno.land/p/demo/ufmt", "std"},
+		parseImports(files),
+	)
+}
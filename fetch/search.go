@@ -0,0 +1,111 @@
+package fetch
+
+import (
+	"fmt"
+
+	"github.com/gnolang/gno/gno.land/pkg/sdk/vm"
+	"github.com/gnolang/gno/gnovm/stdlibs/chain"
+	bft_types "github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/std"
+
+	"github.com/gnolang/tx-indexer/search"
+	"github.com/gnolang/tx-indexer/storage"
+	"github.com/gnolang/tx-indexer/types"
+)
+
+// searchDocument is a single full-text search document, with its term positions
+type searchDocument struct {
+	doc   *types.SearchDocument
+	terms map[string][]uint32
+}
+
+var _ txIndexer = &searchIndexer{}
+
+// searchIndexer maintains the full-text search index over
+// package source files, transaction memos and GnoEvent attribute values
+type searchIndexer struct {
+	docs []searchDocument
+}
+
+// newSearchIndexer creates a new full-text search indexer for a single slot write
+func newSearchIndexer() *searchIndexer {
+	return &searchIndexer{
+		docs: make([]searchDocument, 0),
+	}
+}
+
+// indexTx extracts the searchable content of the transaction.
+// Package sources are only indexed for successful deployments
+func (si *searchIndexer) indexTx(_ *bft_types.Block, txResult *bft_types.TxResult, tx *std.Tx) error {
+	if txResult.Response.IsOK() {
+		for _, msg := range tx.GetMsgs() {
+			addPackage, ok := msg.(vm.MsgAddPackage)
+			if !ok || addPackage.Package == nil {
+				continue
+			}
+
+			for _, file := range addPackage.Package.Files {
+				si.add(&types.SearchDocument{
+					Kind:        types.SearchKindPackageFile,
+					PackagePath: addPackage.Package.Path,
+					FileName:    file.Name,
+					Height:      txResult.Height,
+					Index:       txResult.Index,
+				}, file.Body)
+			}
+		}
+	}
+
+	si.add(&types.SearchDocument{
+		Kind:   types.SearchKindMemo,
+		Height: txResult.Height,
+		Index:  txResult.Index,
+	}, tx.GetMemo())
+
+	for eventIndex, abciEvent := range txResult.Response.Events {
+		event, ok := abciEvent.(chain.Event)
+		if !ok {
+			continue
+		}
+
+		for attributeIndex, attribute := range event.Attributes {
+			si.add(&types.SearchDocument{
+				Kind:           types.SearchKindEvent,
+				PackagePath:    event.PkgPath,
+				AttributeKey:   attribute.Key,
+				Height:         txResult.Height,
+				Index:          txResult.Index,
+				EventIndex:     uint32(eventIndex),
+				AttributeIndex: uint32(attributeIndex),
+			}, attribute.Value)
+		}
+	}
+
+	return nil
+}
+
+// add tokenizes the text of the document, skipping it if there is nothing to index
+func (si *searchIndexer) add(doc *types.SearchDocument, text string) {
+	terms, length := search.Terms(text)
+	if length == 0 {
+		return
+	}
+
+	doc.Length = length
+
+	si.docs = append(si.docs, searchDocument{
+		doc:   doc,
+		terms: terms,
+	})
+}
+
+// flush writes the search documents gathered so far to the batch
+func (si *searchIndexer) flush(wb storage.Batch) error {
+	for _, d := range si.docs {
+		if err := wb.SetSearchDocument(d.doc, d.terms); err != nil {
+			return fmt.Errorf("unable to save search document %s, %w", d.doc.ID(), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package fetch
+
+import (
+	"testing"
+
+	"github.com/gnolang/gno/gno.land/pkg/sdk/vm"
+	"github.com/gnolang/gno/gnovm/stdlibs/chain"
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	"github.com/gnolang/gno/tm2/pkg/std"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/tx-indexer/internal/mock"
+	indexerTypes "github.com/gnolang/tx-indexer/types"
+)
+
+func TestSearchIndexer_IndexTx(t *testing.T) {
+	t.Parallel()
+
+	var (
+		creator = crypto.AddressFromPreimage([]byte("creator"))
+
+		saved = make(map[string]map[string][]uint32)
+
+		mockBatch = &mock.WriteBatch{
+			SetSearchDocumentFn: func(doc *indexerTypes.SearchDocument, terms map[string][]uint32) error {
+				saved[doc.ID()] = terms
+
+				return nil
+			},
+		}
+
+		addPackage = vm.MsgAddPackage{
+			Creator: creator,
+			Package: &std.MemPackage{
+				Name: "avl",
+				Path: "gno.land/p/demo/avl",
+				Files: []*std.MemFile{
+					{Name: "avl.gno", Body: "package avl"},
+				},
+			},
+		}
+	)
+
+	encodedTx, err := amino.Marshal(&std.Tx{
+		Msgs: []std.Msg{addPackage},
+		Memo: "deploying avl",
+	})
+	require.NoError(t, err)
+
+	txs := []*types.TxResult{
+		{
+			Height: 10,
+			Index:  0,
+			Tx:     encodedTx,
+			Response: abci.ResponseDeliverTx{
+				ResponseBase: abci.ResponseBase{
+					Events: []abci.Event{
+						chain.Event{
+							Type:    "Registered",
+							PkgPath: "gno.land/r/demo/users",
+							Attributes: []chain.EventAttribute{
+								{Key: "name", Value: "Alice"},
+								{Key: "empty", Value: ""},
+							},
+						},
+					},
+				},
+			},
+		},
+		// Failed deployments don't have their sources indexed
+		newTxResult(t, 10, 1, false, addPackage),
+	}
+
+	si := newSearchIndexer()
+
+	for _, tx := range txs {
+		require.NoError(t, indexTx([]txIndexer{si}, &types.Block{}, tx))
+	}
+
+	require.NoError(t, si.flush(mockBatch))
+
+	assert.Equal(t, map[string]map[string][]uint32{
+		"package_file/10/0/gno.land/p/demo/avl/avl.gno": {
+			"package": {0},
+			"avl":     {1},
+		},
+		"memo/10/0": {
+			"deploying": {0},
+			"avl":       {1},
+		},
+		"event/10/0/0/0": {
+			"alice": {0},
+		},
+	}, saved)
+}
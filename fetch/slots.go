@@ -3,19 +3,24 @@ package fetch
 import (
 	"github.com/gnolang/gno/tm2/pkg/bft/types"
 	queue "github.com/madz-lab/insertion-queue"
+
+	indexerTypes "github.com/gnolang/tx-indexer/types"
 )
 
 // chunk represents a single blockchain
 // data range
 type chunk struct {
-	blocks  []*types.Block
-	results [][]*types.TxResult // summarized results
+	blocks       []*types.Block
+	results      [][]*types.TxResult          // summarized results
+	blockResults []*indexerTypes.BlockResults // BeginBlock / EndBlock results
+	validators   [][]*types.Validator         // validator sets, only fetched when they change
 }
 
 // slot is a single chunk slot
 type slot struct {
-	chunk      *chunk     // retrieved data chunk
-	chunkRange chunkRange // retrieved data chunk range
+	chunk      *chunk                // retrieved data chunk
+	genesis    *indexerTypes.Genesis // genesis state, only set for the genesis slot
+	chunkRange chunkRange            // retrieved data chunk range
 }
 
 func (s *slot) Less(i queue.Item) bool {
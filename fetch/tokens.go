@@ -0,0 +1,490 @@
+package fetch
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gnolang/gno/gno.land/pkg/sdk/vm"
+	"github.com/gnolang/gno/gnovm/pkg/gnolang"
+	"github.com/gnolang/gno/gnovm/stdlibs/chain"
+	bft_types "github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	"github.com/gnolang/gno/tm2/pkg/std"
+
+	"github.com/gnolang/tx-indexer/storage"
+	storageErrors "github.com/gnolang/tx-indexer/storage/errors"
+	"github.com/gnolang/tx-indexer/types"
+)
+
+const (
+	grc20TransferEvent = "Transfer"
+	grc20ApprovalEvent = "Approval"
+
+	// grc20PkgName is the name of the package declaring the GRC20 token constructor
+	grc20PkgName = "grc20"
+
+	// grc20Constructor is the GRC20 token constructor, announcing the name, symbol and decimals
+	grc20Constructor = "NewToken"
+)
+
+// tokenBalanceKey identifies the balance of an address in a single token
+type tokenBalanceKey struct {
+	address string
+	token   string
+}
+
+var _ txIndexer = &tokenIndexer{}
+
+// tokenIndexer derives the GRC20 tokens, their movements,
+// and the resulting holder balances, of the transactions of a single slot
+type tokenIndexer struct {
+	storage   storage.Reader
+	tokens    map[string]*types.Token
+	balances  map[tokenBalanceKey]*types.TokenBalance
+	transfers []*types.TokenTransfer
+}
+
+// newTokenIndexer creates a new GRC20 token indexer for a single slot write
+func newTokenIndexer(storage storage.Reader) *tokenIndexer {
+	return &tokenIndexer{
+		storage:   storage,
+		tokens:    make(map[string]*types.Token),
+		balances:  make(map[tokenBalanceKey]*types.TokenBalance),
+		transfers: make([]*types.TokenTransfer, 0),
+	}
+}
+
+// indexTx records the GRC20 tokens announced by the deployed packages,
+// and the GRC20 events emitted by the transaction.
+// Only successful transactions are accounted for, and events
+// that don't follow the GRC20 format are skipped
+func (ti *tokenIndexer) indexTx(_ *bft_types.Block, txResult *bft_types.TxResult, tx *std.Tx) error {
+	if !txResult.Response.IsOK() {
+		return nil
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		addPackage, ok := msg.(vm.MsgAddPackage)
+		if !ok || addPackage.Package == nil || addPackage.Package.Path == "" {
+			continue
+		}
+
+		for _, announced := range parseTokenAnnouncements(addPackage.Package.Files) {
+			id := addPackage.Package.Path + "." + announced.Symbol
+
+			t, err := ti.token(id, addPackage.Package.Path, txResult.Height)
+			if err != nil {
+				return err
+			}
+
+			t.Name = announced.Name
+			t.Symbol = announced.Symbol
+			t.Decimals = announced.Decimals
+			t.Announced = true
+		}
+	}
+
+	for eventIndex, abciEvent := range txResult.Response.Events {
+		event, ok := abciEvent.(chain.Event)
+		if !ok {
+			continue
+		}
+
+		switch event.Type {
+		case grc20TransferEvent:
+			if err := ti.indexTransfer(event, txResult, uint32(eventIndex)); err != nil {
+				return err
+			}
+		case grc20ApprovalEvent:
+			attrs, ok := eventAttributes(event)
+			if !ok ||
+				!isTokenAmount(attrs["value"]) ||
+				!isTokenHolder(attrs["owner"], false) ||
+				!isTokenHolder(attrs["spender"], false) {
+				continue
+			}
+
+			id, pkgPath, ok := tokenID(event, attrs)
+			if !ok {
+				continue
+			}
+
+			if _, err := ti.token(id, pkgPath, txResult.Height); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// indexTransfer records the GRC20 `Transfer` event, updating
+// the token supply and the balances of the sender and the recipient
+func (ti *tokenIndexer) indexTransfer(event chain.Event, txResult *bft_types.TxResult, eventIndex uint32) error {
+	attrs, ok := eventAttributes(event)
+	if !ok {
+		return nil
+	}
+
+	// GRC721 transfers share the event type, but move a token ID instead of a value
+	if _, isNFT := attrs[grc721TokenIDAttr]; isNFT {
+		return nil
+	}
+
+	var (
+		from = attrs["from"]
+		to   = attrs["to"]
+	)
+
+	if !isTokenAmount(attrs["value"]) ||
+		!isTokenHolder(from, true) ||
+		!isTokenHolder(to, true) ||
+		(from == "" && to == "") {
+		return nil
+	}
+
+	id, pkgPath, ok := tokenID(event, attrs)
+	if !ok {
+		return nil
+	}
+
+	amount, _ := strconv.ParseInt(attrs["value"], 10, 64)
+
+	t, err := ti.token(id, pkgPath, txResult.Height)
+	if err != nil {
+		return err
+	}
+
+	t.TransferCount++
+
+	if from == "" {
+		t.TotalSupply += amount
+	} else {
+		if err := ti.move(from, id, -amount, txResult.Height); err != nil {
+			return err
+		}
+	}
+
+	if to == "" {
+		t.TotalSupply -= amount
+	} else {
+		if err := ti.move(to, id, amount, txResult.Height); err != nil {
+			return err
+		}
+	}
+
+	ti.transfers = append(ti.transfers, &types.TokenTransfer{
+		Token:      id,
+		From:       from,
+		To:         to,
+		Amount:     amount,
+		Height:     txResult.Height,
+		Index:      txResult.Index,
+		EventIndex: eventIndex,
+	})
+
+	return nil
+}
+
+// token returns the token with the given identifier,
+// fetching it from the storage, or registering it, the first time it is seen
+func (ti *tokenIndexer) token(id, pkgPath string, height int64) (*types.Token, error) {
+	if t, ok := ti.tokens[id]; ok {
+		return t, nil
+	}
+
+	t, err := ti.storage.GetToken(id)
+	if err != nil && !errors.Is(err, storageErrors.ErrNotFound) {
+		return nil, fmt.Errorf("unable to fetch token %s, %w", id, err)
+	}
+
+	if t == nil {
+		t = &types.Token{
+			ID:              id,
+			PkgPath:         pkgPath,
+			FirstSeenHeight: height,
+		}
+
+		if symbol := strings.TrimPrefix(id, pkgPath+"."); symbol != id {
+			t.Symbol = symbol
+		}
+	}
+
+	ti.tokens[id] = t
+
+	return t, nil
+}
+
+// move applies the balance change of the address in the given token
+func (ti *tokenIndexer) move(address, id string, delta, height int64) error {
+	key := tokenBalanceKey{address, id}
+
+	balance, ok := ti.balances[key]
+	if !ok {
+		stored, err := ti.storage.GetTokenBalance(address, id)
+		if err != nil && !errors.Is(err, storageErrors.ErrNotFound) {
+			return fmt.Errorf("unable to fetch token balance of %s, %w", address, err)
+		}
+
+		balance = stored
+		if balance == nil {
+			balance = &types.TokenBalance{
+				Token:   id,
+				Address: address,
+			}
+		}
+
+		ti.balances[key] = balance
+	}
+
+	balance.Balance += delta
+	balance.Height = height
+
+	return nil
+}
+
+// flush writes the token movements, the tokens
+// and the holder balances gathered so far to the batch
+func (ti *tokenIndexer) flush(wb storage.Batch) error {
+	for _, transfer := range ti.transfers {
+		if err := wb.SetTokenTransfer(transfer); err != nil {
+			return fmt.Errorf("unable to save token transfer, %w", err)
+		}
+	}
+
+	ids := make([]string, 0, len(ti.tokens))
+	for id := range ti.tokens {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		if err := wb.SetToken(ti.tokens[id]); err != nil {
+			return fmt.Errorf("unable to save token %s, %w", id, err)
+		}
+	}
+
+	keys := make([]tokenBalanceKey, 0, len(ti.balances))
+	for key := range ti.balances {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].address != keys[j].address {
+			return keys[i].address < keys[j].address
+		}
+
+		return keys[i].token < keys[j].token
+	})
+
+	for _, key := range keys {
+		if err := wb.SetTokenBalance(ti.balances[key]); err != nil {
+			return fmt.Errorf("unable to save token balance of %s, %w", key.address, err)
+		}
+	}
+
+	return nil
+}
+
+// eventAttributes maps the event attributes by key.
+// Events with duplicate attribute keys are ambiguous, and are rejected
+func eventAttributes(event chain.Event) (map[string]string, bool) {
+	attrs := make(map[string]string, len(event.Attributes))
+
+	for _, attr := range event.Attributes {
+		if _, ok := attrs[attr.Key]; ok {
+			return nil, false
+		}
+
+		attrs[attr.Key] = attr.Value
+	}
+
+	return attrs, true
+}
+
+// tokenID returns the identifier of the token the event refers to, and the path
+// of the realm the token belongs to. Standard events carry the identifier in the
+// `token` attribute, while legacy ones can only be attributed to the emitting realm
+func tokenID(event chain.Event, attrs map[string]string) (string, string, bool) {
+	if id, ok := attrs["token"]; ok {
+		index := strings.LastIndex(id, ".")
+		if index <= 0 || index == len(id)-1 {
+			return "", "", false
+		}
+
+		return id, id[:index], true
+	}
+
+	// Events emitted by pure packages can't be attributed to a single token
+	if !gnolang.IsRealmPath(event.PkgPath) {
+		return "", "", false
+	}
+
+	return event.PkgPath, event.PkgPath, true
+}
+
+// isTokenAmount checks if the value is a valid, non-negative token amount
+func isTokenAmount(value string) bool {
+	amount, err := strconv.ParseInt(value, 10, 64)
+
+	return err == nil && amount >= 0
+}
+
+// isTokenHolder checks if the value is a valid bech32 address,
+// or empty, when allowed (mints and burns)
+func isTokenHolder(value string, allowEmpty bool) bool {
+	if value == "" {
+		return allowEmpty
+	}
+
+	_, err := crypto.AddressFromBech32(value)
+
+	return err == nil
+}
+
+// tokenAnnouncement is the GRC20 token metadata declared in the package source
+type tokenAnnouncement struct {
+	Name     string
+	Symbol   string
+	Decimals int64
+}
+
+// parseTokenAnnouncements extracts the GRC20 tokens created with literal
+// arguments (`grc20.NewToken("Foo", "FOO", 4)`) in the package source files
+func parseTokenAnnouncements(files []*std.MemFile) []tokenAnnouncement {
+	announcements := make([]tokenAnnouncement, 0)
+
+	for _, call := range parsePackageCalls(files, grc20PkgName, grc20Constructor) {
+		if announced, ok := parseTokenConstructor(call); ok {
+			announcements = append(announcements, announced)
+		}
+	}
+
+	return announcements
+}
+
+// parseTokenConstructor extracts the token metadata from
+// the GRC20 constructor call, if all of its arguments are literals
+func parseTokenConstructor(call *ast.CallExpr) (tokenAnnouncement, bool) {
+	if len(call.Args) != 3 {
+		return tokenAnnouncement{}, false
+	}
+
+	name, ok := stringLiteral(call.Args[0])
+	if !ok {
+		return tokenAnnouncement{}, false
+	}
+
+	symbol, ok := stringLiteral(call.Args[1])
+	if !ok || symbol == "" {
+		return tokenAnnouncement{}, false
+	}
+
+	decimals, ok := call.Args[2].(*ast.BasicLit)
+	if !ok || decimals.Kind != token.INT {
+		return tokenAnnouncement{}, false
+	}
+
+	value, err := strconv.ParseInt(decimals.Value, 0, 64)
+	if err != nil {
+		return tokenAnnouncement{}, false
+	}
+
+	return tokenAnnouncement{
+		Name:     name,
+		Symbol:   symbol,
+		Decimals: value,
+	}, true
+}
+
+// parsePackageCalls extracts the calls to the given function of the imported
+// package (matched by name, ex. `grc20`) in the package source files.
+// Test files are not part of the package, and files that can't be parsed are skipped
+func parsePackageCalls(files []*std.MemFile, pkgName, funcName string) []*ast.CallExpr {
+	var (
+		fset  = token.NewFileSet()
+		calls = make([]*ast.CallExpr, 0)
+	)
+
+	for _, file := range files {
+		if !strings.HasSuffix(file.Name, ".gno") ||
+			strings.HasSuffix(file.Name, "_test.gno") ||
+			strings.HasSuffix(file.Name, "_filetest.gno") {
+			continue
+		}
+
+		parsed, err := parser.ParseFile(fset, file.Name, file.Body, parser.SkipObjectResolution)
+		if err != nil {
+			continue
+		}
+
+		// Only calls on the imported package are considered, under their local name
+		names := make(map[string]struct{})
+
+		for _, spec := range parsed.Imports {
+			importPath, err := strconv.Unquote(spec.Path.Value)
+			if err != nil || path.Base(importPath) != pkgName {
+				continue
+			}
+
+			name := pkgName
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+
+			names[name] = struct{}{}
+		}
+
+		if len(names) == 0 {
+			continue
+		}
+
+		ast.Inspect(parsed, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+
+			selector, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok || selector.Sel.Name != funcName {
+				return true
+			}
+
+			pkg, ok := selector.X.(*ast.Ident)
+			if !ok {
+				return true
+			}
+
+			if _, ok := names[pkg.Name]; ok {
+				calls = append(calls, call)
+			}
+
+			return true
+		})
+	}
+
+	return calls
+}
+
+// stringLiteral returns the value of the string literal expression
+func stringLiteral(expr ast.Expr) (string, bool) {
+	literal, ok := expr.(*ast.BasicLit)
+	if !ok || literal.Kind != token.STRING {
+		return "", false
+	}
+
+	value, err := strconv.Unquote(literal.Value)
+	if err != nil {
+		return "", false
+	}
+
+	return value, true
+}
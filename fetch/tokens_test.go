@@ -0,0 +1,252 @@
+package fetch
+
+import (
+	"testing"
+
+	"github.com/gnolang/gno/gno.land/pkg/sdk/vm"
+	"github.com/gnolang/gno/gnovm/stdlibs/chain"
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	"github.com/gnolang/gno/tm2/pkg/std"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/tx-indexer/internal/mock"
+	storageErrors "github.com/gnolang/tx-indexer/storage/errors"
+	indexerTypes "github.com/gnolang/tx-indexer/types"
+)
+
+// newTransferEvent creates a GRC20 transfer event, from the given attribute key / value pairs
+func newTransferEvent(pkgPath string, attrs ...string) chain.Event {
+	event := chain.Event{
+		Type:    grc20TransferEvent,
+		PkgPath: pkgPath,
+	}
+
+	for i := 0; i+1 < len(attrs); i += 2 {
+		event.Attributes = append(event.Attributes, chain.EventAttribute{
+			Key:   attrs[i],
+			Value: attrs[i+1],
+		})
+	}
+
+	return event
+}
+
+func TestTokenIndexer_IndexTx(t *testing.T) {
+	t.Parallel()
+
+	var (
+		alice = crypto.AddressFromPreimage([]byte("alice")).String()
+		bob   = crypto.AddressFromPreimage([]byte("bob")).String()
+
+		grc20Pkg = "gno.land/p/demo/tokens/grc20"
+		realm    = "gno.land/r/demo/foo20"
+		tokenID  = realm + ".FOO"
+
+		transfers = make([]*indexerTypes.TokenTransfer, 0)
+		tokens    = make([]*indexerTypes.Token, 0)
+		balances  = make([]*indexerTypes.TokenBalance, 0)
+
+		mockStorage = &mock.Storage{
+			GetTokenBalanceFn: func(address, token string) (*indexerTypes.TokenBalance, error) {
+				if address == alice && token == tokenID {
+					return &indexerTypes.TokenBalance{
+						Token:   token,
+						Address: address,
+						Balance: 1000,
+						Height:  1,
+					}, nil
+				}
+
+				return nil, storageErrors.ErrNotFound
+			},
+		}
+
+		mockBatch = &mock.WriteBatch{
+			SetTokenTransferFn: func(transfer *indexerTypes.TokenTransfer) error {
+				transfers = append(transfers, transfer)
+
+				return nil
+			},
+			SetTokenFn: func(token *indexerTypes.Token) error {
+				tokens = append(tokens, token)
+
+				return nil
+			},
+			SetTokenBalanceFn: func(balance *indexerTypes.TokenBalance) error {
+				balances = append(balances, balance)
+
+				return nil
+			},
+		}
+	)
+
+	newTx := func(height int64, success bool, events []abci.Event, msgs ...std.Msg) *types.TxResult {
+		encodedTx, err := amino.Marshal(&std.Tx{
+			Msgs: msgs,
+		})
+		require.NoError(t, err)
+
+		response := abci.ResponseDeliverTx{
+			ResponseBase: abci.ResponseBase{
+				Events: events,
+			},
+		}
+
+		if !success {
+			response.Error = abci.StringError("failed")
+		}
+
+		return &types.TxResult{
+			Height:   height,
+			Tx:       encodedTx,
+			Response: response,
+		}
+	}
+
+	txs := []*types.TxResult{
+		newTx(
+			10,
+			true,
+			[]abci.Event{
+				newTransferEvent(grc20Pkg, "token", tokenID, "from", "", "to", bob, "value", "500"),
+			},
+			vm.MsgAddPackage{
+				Package: &std.MemPackage{
+					Name: "foo20",
+					Path: realm,
+					Files: []*std.MemFile{
+						{
+							Name: "foo20.gno",
+							Body: `package foo20
+
+import g "gno.land/p/demo/tokens/grc20"
+
+var Token, ledger = g.NewToken("Foo", "FOO", 4)
+`,
+						},
+					},
+				},
+			},
+		),
+		// Failed transactions are not accounted for
+		newTx(
+			10,
+			false,
+			[]abci.Event{
+				newTransferEvent(grc20Pkg, "token", tokenID, "from", alice, "to", bob, "value", "100"),
+			},
+		),
+		newTx(
+			11,
+			true,
+			[]abci.Event{
+				newTransferEvent(grc20Pkg, "token", tokenID, "from", alice, "to", bob, "value", "100"),
+				newTransferEvent(grc20Pkg, "token", tokenID, "from", bob, "to", "", "value", "50"),
+				// Non-standard events are skipped
+				newTransferEvent(grc20Pkg, "token", tokenID, "from", alice, "to", bob, "value", "-5"),
+				newTransferEvent(grc20Pkg, "token", tokenID, "from", "alice", "to", bob, "value", "5"),
+				newTransferEvent(grc20Pkg, "from", alice, "to", bob, "value", "5"),
+				newTransferEvent(grc20Pkg, "token", tokenID, "from", alice, "to", bob, "tokenId", "1"),
+				newTransferEvent(grc20Pkg, "token", tokenID, "from", alice, "from", bob, "to", bob, "value", "5"),
+				// Legacy events are attributed to the emitting realm
+				newTransferEvent("gno.land/r/demo/bar20", "from", "", "to", alice, "value", "7"),
+			},
+		),
+	}
+
+	ti := newTokenIndexer(mockStorage)
+
+	for _, tx := range txs {
+		require.NoError(t, indexTx([]txIndexer{ti}, &types.Block{}, tx))
+	}
+
+	require.NoError(t, ti.flush(mockBatch))
+
+	// Make sure only the valid movements are recorded, in transaction order
+	assert.Equal(t, []*indexerTypes.TokenTransfer{
+		{Token: tokenID, From: "", To: bob, Amount: 500, Height: 10},
+		{Token: tokenID, From: alice, To: bob, Amount: 100, Height: 11},
+		{Token: tokenID, From: bob, To: "", Amount: 50, Height: 11, EventIndex: 1},
+		{Token: "gno.land/r/demo/bar20", From: "", To: alice, Amount: 7, Height: 11, EventIndex: 7},
+	}, transfers)
+
+	// Make sure the tokens are announced and accounted for
+	assert.Equal(t, []*indexerTypes.Token{
+		{
+			ID:              "gno.land/r/demo/bar20",
+			PkgPath:         "gno.land/r/demo/bar20",
+			TotalSupply:     7,
+			TransferCount:   1,
+			FirstSeenHeight: 11,
+		},
+		{
+			ID:              tokenID,
+			PkgPath:         realm,
+			Name:            "Foo",
+			Symbol:          "FOO",
+			Decimals:        4,
+			TotalSupply:     450,
+			TransferCount:   3,
+			FirstSeenHeight: 10,
+			Announced:       true,
+		},
+	}, tokens)
+
+	// Make sure the balances continue from the stored ones
+	byHolder := make(map[string]int64)
+	for _, balance := range balances {
+		byHolder[balance.Address+"/"+balance.Token] = balance.Balance
+	}
+
+	assert.Equal(t, map[string]int64{
+		alice + "/" + tokenID:                 900,
+		bob + "/" + tokenID:                   550,
+		alice + "/" + "gno.land/r/demo/bar20": 7,
+	}, byHolder)
+}
+
+func TestParseTokenAnnouncements(t *testing.T) {
+	t.Parallel()
+
+	files := []*std.MemFile{
+		{
+			Name: "token.gno",
+			Body: `package foo
+
+import (
+	"gno.land/p/demo/tokens/grc20"
+	other "gno.land/p/demo/other"
+)
+
+var (
+	name = "Dynamic"
+
+	a, _ = grc20.NewToken("Foo", "FOO", 6)
+	b, _ = grc20.NewToken(name, "DYN", 6)
+	c, _ = other.NewToken("Other", "OTH", 6)
+)
+`,
+		},
+		{
+			Name: "token_test.gno",
+			Body: `package foo
+
+import "gno.land/p/demo/tokens/grc20"
+
+var t, _ = grc20.NewToken("Test", "TST", 0)
+`,
+		},
+		{
+			Name: "invalid.gno",
+			Body: "package foo\n\nfunc {",
+		},
+	}
+
+	assert.Equal(t, []tokenAnnouncement{
+		{Name: "Foo", Symbol: "FOO", Decimals: 6},
+	}, parseTokenAnnouncements(files))
+}
@@ -24,6 +24,12 @@ type Client interface {
 	// for the specified block
 	GetBlockResults(context.Context, uint64) (*core_types.ResultBlockResults, error)
 
+	// GetValidators returns the validator set for the specified block
+	GetValidators(context.Context, uint64) (*core_types.ResultValidators, error)
+
+	// GetUnconfirmedTxs returns up to the given number of transactions in the node mempool
+	GetUnconfirmedTxs(context.Context, int) (*core_types.ResultUnconfirmedTxs, error)
+
 	// CreateBatch creates a new client batch
 	CreateBatch() clientTypes.Batch
 }
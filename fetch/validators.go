@@ -0,0 +1,175 @@
+package fetch
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	bft_types "github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	"github.com/gnolang/gno/tm2/pkg/std"
+
+	"github.com/gnolang/tx-indexer/storage"
+	storageErrors "github.com/gnolang/tx-indexer/storage/errors"
+	"github.com/gnolang/tx-indexer/types"
+)
+
+var (
+	_ txIndexer    = &validatorIndexer{}
+	_ blockIndexer = &validatorIndexer{}
+)
+
+// validatorIndexer records the validator set changes,
+// and the validator signatures of the blocks of a single slot
+type validatorIndexer struct {
+	storage    storage.Reader
+	sets       []*types.ValidatorSet // known sets, by ascending height
+	signatures []*types.BlockSignatures
+	newSets    int // number of sets, at the end of sets, not yet saved
+	loaded     bool
+}
+
+// newValidatorIndexer creates a new validator indexer for a single slot write
+func newValidatorIndexer(storage storage.Reader) *validatorIndexer {
+	return &validatorIndexer{
+		storage:    storage,
+		sets:       make([]*types.ValidatorSet, 0),
+		signatures: make([]*types.BlockSignatures, 0),
+	}
+}
+
+// indexBlock records the signatures of the previous block, committed in the given block,
+// and the validator set of the given block, if fetched and changed
+func (vi *validatorIndexer) indexBlock(block *bft_types.Block, validators []*bft_types.Validator) error {
+	if block.LastCommit != nil && len(block.LastCommit.Precommits) > 0 {
+		if err := vi.indexCommit(block.Height-1, block.LastCommit); err != nil {
+			return err
+		}
+	}
+
+	if validators == nil {
+		return nil
+	}
+
+	current, err := vi.setAt(block.Height)
+	if err != nil {
+		return err
+	}
+
+	if current != nil && bytes.Equal(current.Hash, block.ValidatorsHash) {
+		// The validator set didn't change
+		return nil
+	}
+
+	set := &types.ValidatorSet{
+		Hash:       block.ValidatorsHash,
+		Validators: make([]types.Validator, 0, len(validators)),
+		Height:     block.Height,
+	}
+
+	for _, validator := range validators {
+		set.Validators = append(set.Validators, types.Validator{
+			Address:     validator.Address.String(),
+			PubKey:      pubKeyToBech32(validator.PubKey),
+			VotingPower: validator.VotingPower,
+		})
+	}
+
+	vi.sets = append(vi.sets, set)
+	vi.newSets++
+
+	return nil
+}
+
+// indexCommit records the validators that signed the given block,
+// and the validators of its set that didn't
+func (vi *validatorIndexer) indexCommit(height int64, commit *bft_types.Commit) error {
+	set, err := vi.setAt(height)
+	if err != nil {
+		return err
+	}
+
+	signatures := &types.BlockSignatures{
+		Signers: make([]string, 0, len(commit.Precommits)),
+		Missed:  make([]string, 0),
+		Height:  height,
+	}
+
+	// Precommits are in the order of the validator set of the block
+	for index, precommit := range commit.Precommits {
+		if precommit != nil {
+			signatures.Signers = append(signatures.Signers, precommit.ValidatorAddress.String())
+
+			continue
+		}
+
+		if set != nil && index < len(set.Validators) {
+			signatures.Missed = append(signatures.Missed, set.Validators[index].Address)
+		}
+	}
+
+	vi.signatures = append(vi.signatures, signatures)
+
+	return nil
+}
+
+// setAt returns the validator set of the given block, if known,
+// fetching the latest stored set the first time it is needed
+func (vi *validatorIndexer) setAt(height int64) (*types.ValidatorSet, error) {
+	for i := len(vi.sets) - 1; i >= 0; i-- {
+		if vi.sets[i].Height <= height {
+			return vi.sets[i], nil
+		}
+	}
+
+	if vi.loaded || height < 0 {
+		return nil, nil //nolint:nilnil // unknown validator set
+	}
+
+	vi.loaded = true
+
+	set, err := vi.storage.GetValidatorSet(uint64(height))
+	if errors.Is(err, storageErrors.ErrNotFound) {
+		return nil, nil //nolint:nilnil // unknown validator set
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch validator set at %d, %w", height, err)
+	}
+
+	// Stored sets precede the ones of the slot
+	vi.sets = append([]*types.ValidatorSet{set}, vi.sets...)
+
+	return set, nil
+}
+
+// indexTx is a no-op, validator data is derived from the blocks
+func (vi *validatorIndexer) indexTx(_ *bft_types.Block, _ *bft_types.TxResult, _ *std.Tx) error {
+	return nil
+}
+
+// flush writes the validator set changes and the block signatures gathered so far to the batch
+func (vi *validatorIndexer) flush(wb storage.Batch) error {
+	for _, set := range vi.sets[len(vi.sets)-vi.newSets:] {
+		if err := wb.SetValidatorSet(set); err != nil {
+			return fmt.Errorf("unable to save validator set at %d, %w", set.Height, err)
+		}
+	}
+
+	for _, signatures := range vi.signatures {
+		if err := wb.SetBlockSignatures(signatures); err != nil {
+			return fmt.Errorf("unable to save signatures of block %d, %w", signatures.Height, err)
+		}
+	}
+
+	return nil
+}
+
+// pubKeyToBech32 encodes the public key in bech32, if present
+func pubKeyToBech32(pubKey crypto.PubKey) string {
+	if pubKey == nil {
+		return ""
+	}
+
+	return crypto.PubKeyToBech32(pubKey)
+}
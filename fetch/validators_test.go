@@ -0,0 +1,115 @@
+package fetch
+
+import (
+	"testing"
+
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/crypto/ed25519"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/tx-indexer/internal/mock"
+	storageErrors "github.com/gnolang/tx-indexer/storage/errors"
+	indexerTypes "github.com/gnolang/tx-indexer/types"
+)
+
+func TestValidatorIndexer_IndexBlock(t *testing.T) {
+	t.Parallel()
+
+	var (
+		alice = types.NewValidator(ed25519.GenPrivKey().PubKey(), 10)
+		bob   = types.NewValidator(ed25519.GenPrivKey().PubKey(), 5)
+
+		stored = &indexerTypes.ValidatorSet{
+			Hash: []byte("hash1"),
+			Validators: []indexerTypes.Validator{
+				{Address: alice.Address.String(), VotingPower: 10},
+			},
+			Height: 1,
+		}
+
+		sets       = make([]*indexerTypes.ValidatorSet, 0)
+		signatures = make([]*indexerTypes.BlockSignatures, 0)
+
+		mockStorage = &mock.Storage{
+			GetValidatorSetFn: func(height uint64) (*indexerTypes.ValidatorSet, error) {
+				if height >= 1 {
+					return stored, nil
+				}
+
+				return nil, storageErrors.ErrNotFound
+			},
+		}
+
+		mockBatch = &mock.WriteBatch{
+			SetValidatorSetFn: func(set *indexerTypes.ValidatorSet) error {
+				sets = append(sets, set)
+
+				return nil
+			},
+			SetBlockSignaturesFn: func(blockSignatures *indexerTypes.BlockSignatures) error {
+				signatures = append(signatures, blockSignatures)
+
+				return nil
+			},
+		}
+	)
+
+	newBlock := func(height int64, hash string, precommits ...*types.CommitSig) *types.Block {
+		return &types.Block{
+			Header: types.Header{
+				Height:         height,
+				ValidatorsHash: []byte(hash),
+			},
+			LastCommit: &types.Commit{
+				Precommits: precommits,
+			},
+		}
+	}
+
+	precommit := func(validator *types.Validator) *types.CommitSig {
+		return &types.CommitSig{
+			ValidatorAddress: validator.Address,
+		}
+	}
+
+	vi := newValidatorIndexer(mockStorage)
+
+	// The first block of the chunk has the stored set
+	require.NoError(t, vi.indexBlock(newBlock(5, "hash1", precommit(alice)), []*types.Validator{alice}))
+
+	// The set changes
+	require.NoError(t, vi.indexBlock(newBlock(6, "hash2", precommit(alice)), []*types.Validator{alice, bob}))
+
+	// Bob misses the block
+	require.NoError(t, vi.indexBlock(newBlock(7, "hash2", precommit(alice), nil), nil))
+
+	require.NoError(t, vi.flush(mockBatch))
+
+	// Make sure only the changed set is saved
+	assert.Equal(t, []*indexerTypes.ValidatorSet{
+		{
+			Hash: []byte("hash2"),
+			Validators: []indexerTypes.Validator{
+				{
+					Address:     alice.Address.String(),
+					PubKey:      pubKeyToBech32(alice.PubKey),
+					VotingPower: 10,
+				},
+				{
+					Address:     bob.Address.String(),
+					PubKey:      pubKeyToBech32(bob.PubKey),
+					VotingPower: 5,
+				},
+			},
+			Height: 6,
+		},
+	}, sets)
+
+	// Make sure the signatures are attributed to the previous blocks
+	assert.Equal(t, []*indexerTypes.BlockSignatures{
+		{Signers: []string{alice.Address.String()}, Missed: []string{}, Height: 4},
+		{Signers: []string{alice.Address.String()}, Missed: []string{}, Height: 5},
+		{Signers: []string{alice.Address.String()}, Missed: []string{bob.Address.String()}, Height: 6},
+	}, signatures)
+}
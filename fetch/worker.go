@@ -1,12 +1,16 @@
 package fetch
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 
 	core_types "github.com/gnolang/gno/tm2/pkg/bft/rpc/core/types"
+	"github.com/gnolang/gno/tm2/pkg/bft/state"
 	"github.com/gnolang/gno/tm2/pkg/bft/types"
+
+	indexerTypes "github.com/gnolang/tx-indexer/types"
 )
 
 // workerInfo is the work context for the fetch routine
@@ -35,12 +39,17 @@ func handleChunk(
 		blocks, err := getBlocksFromBatch(ctx, info.chunkRange, client)
 		errs = append(errs, err)
 
-		results, err := getTxResultFromBatch(ctx, blocks, client)
+		results, blockResults, err := getTxResultFromBatch(ctx, blocks, client)
+		errs = append(errs, err)
+
+		validators, err := getValidatorsFromBatch(ctx, blocks, client)
 		errs = append(errs, err)
 
 		return &chunk{
-			blocks:  blocks,
-			results: results,
+			blocks:       blocks,
+			results:      results,
+			blockResults: blockResults,
+			validators:   validators,
 		}, errors.Join(errs...)
 	}
 
@@ -121,26 +130,29 @@ func getBlocksSequentially(ctx context.Context, chunkRange chunkRange, client Cl
 	return blocks, errors.Join(errs...)
 }
 
-// getTxResultFromBatch gets the tx results using batch requests.
+// getTxResultFromBatch gets the tx results, along with the BeginBlock / EndBlock results,
+// using batch requests.
 // In case of encountering an error during fetching (remote temporarily closed, batch error...),
 // the fetch is attempted again using sequential tx result fetches
-func getTxResultFromBatch(ctx context.Context, blocks []*types.Block, client Client) ([][]*types.TxResult, error) {
+func getTxResultFromBatch(
+	ctx context.Context,
+	blocks []*types.Block,
+	client Client,
+) ([][]*types.TxResult, []*indexerTypes.BlockResults, error) {
 	var (
-		batch          = client.CreateBatch()
-		fetchedResults = make([][]*types.TxResult, len(blocks))
+		batch               = client.CreateBatch()
+		fetchedResults      = make([][]*types.TxResult, len(blocks))
+		fetchedBlockResults = make([]*indexerTypes.BlockResults, len(blocks))
 	)
 
-	// Create the results request batch
+	// Create the results request batch.
+	// Results are requested for empty blocks as well,
+	// since their BeginBlock / EndBlock can still emit events
+	// and validator updates
 	for _, block := range blocks {
-		if block.NumTxs == 0 {
-			// No need to request results
-			// for an empty block
-			continue
-		}
-
 		// Add the request to the batch
 		if err := batch.AddBlockResultsRequest(uint64(block.Height)); err != nil {
-			return nil, fmt.Errorf(
+			return nil, nil, fmt.Errorf(
 				"unable to add block results request for block %d, %w",
 				block.Height,
 				err,
@@ -151,7 +163,7 @@ func getTxResultFromBatch(ctx context.Context, blocks []*types.Block, client Cli
 	// Check if there is anything to execute
 	if batch.Count() == 0 {
 		// Batch is empty, nothing to fetch
-		return fetchedResults, nil
+		return fetchedResults, fetchedBlockResults, nil
 	}
 
 	// Get the block results
@@ -168,10 +180,10 @@ func getTxResultFromBatch(ctx context.Context, blocks []*types.Block, client Cli
 	}
 
 	// Extract the results
-	for resultsIndex, resultsRaw := range blockResultsRaw {
+	for _, resultsRaw := range blockResultsRaw {
 		results, ok := resultsRaw.(*core_types.ResultBlockResults)
 		if !ok {
-			return nil, errors.New("unable to cast batch result into ResultBlockResults")
+			return nil, nil, errors.New("unable to cast batch result into ResultBlockResults")
 		}
 
 		height := results.Height
@@ -191,24 +203,26 @@ func getTxResultFromBatch(ctx context.Context, blocks []*types.Block, client Cli
 			txResults[txIndex] = result
 		}
 
-		fetchedResults[resultsIndex] = txResults
+		fetchedResults[blockIndex] = txResults
+		fetchedBlockResults[blockIndex] = newBlockResults(height, results.Results)
 	}
 
-	return fetchedResults, nil
+	return fetchedResults, fetchedBlockResults, nil
 }
 
 // getTxResultsSequentially attempts to fetch tx results from the client, using sequential requests
-func getTxResultsSequentially(ctx context.Context, blocks []*types.Block, client Client) ([][]*types.TxResult, error) {
+func getTxResultsSequentially(
+	ctx context.Context,
+	blocks []*types.Block,
+	client Client,
+) ([][]*types.TxResult, []*indexerTypes.BlockResults, error) {
 	var (
-		errs    = make([]error, 0)
-		results = make([][]*types.TxResult, len(blocks))
+		errs                = make([]error, 0)
+		results             = make([][]*types.TxResult, len(blocks))
+		fetchedBlockResults = make([]*indexerTypes.BlockResults, len(blocks))
 	)
 
 	for index, block := range blocks {
-		if block.NumTxs == 0 {
-			continue
-		}
-
 		// Get the transaction execution results
 		blockResults, err := client.GetBlockResults(ctx, uint64(block.Height))
 		if err != nil {
@@ -239,7 +253,131 @@ func getTxResultsSequentially(ctx context.Context, blocks []*types.Block, client
 		}
 
 		results[index] = txResults
+		fetchedBlockResults[index] = newBlockResults(block.Height, blockResults.Results)
+	}
+
+	return results, fetchedBlockResults, errors.Join(errs...)
+}
+
+// newBlockResults extracts the BeginBlock / EndBlock results of the block
+func newBlockResults(height int64, responses *state.ABCIResponses) *indexerTypes.BlockResults {
+	results := &indexerTypes.BlockResults{
+		Height: height,
+	}
+
+	if responses == nil {
+		return results
+	}
+
+	results.BeginBlockEvents = responses.BeginBlock.Events
+	results.ValidatorUpdates = responses.EndBlock.ValidatorUpdates
+
+	// EndBlock events can be set on the response base as well
+	results.EndBlockEvents = append(results.EndBlockEvents, responses.EndBlock.ResponseBase.Events...)
+	results.EndBlockEvents = append(results.EndBlockEvents, responses.EndBlock.Events...)
+
+	return results
+}
+
+// validatorSetChanged checks if the validator set of the block
+// needs to be fetched, which is the case for the first block of the chunk,
+// and the blocks where the validator set changes
+func validatorSetChanged(blocks []*types.Block, index int) bool {
+	return index == 0 || !bytes.Equal(blocks[index].ValidatorsHash, blocks[index-1].ValidatorsHash)
+}
+
+// getValidatorsFromBatch gets the validator sets of the blocks using batch requests,
+// only for the blocks where the validator set changes.
+// In case of encountering an error during fetching (remote temporarily closed, batch error...),
+// the fetch is attempted again using sequential validator set fetches
+func getValidatorsFromBatch(ctx context.Context, blocks []*types.Block, client Client) ([][]*types.Validator, error) {
+	var (
+		batch             = client.CreateBatch()
+		fetchedValidators = make([][]*types.Validator, len(blocks))
+		requested         = make([]int, 0)
+	)
+
+	// Create the validators request batch
+	for index, block := range blocks {
+		if !validatorSetChanged(blocks, index) {
+			// The validator set is the same
+			// as the previous block's
+			continue
+		}
+
+		// Add the request to the batch
+		if err := batch.AddValidatorsRequest(uint64(block.Height)); err != nil {
+			return nil, fmt.Errorf(
+				"unable to add validators request for block %d, %w",
+				block.Height,
+				err,
+			)
+		}
+
+		requested = append(requested, index)
+	}
+
+	// Check if there is anything to execute
+	if batch.Count() == 0 {
+		// Batch is empty, nothing to fetch
+		return fetchedValidators, nil
+	}
+
+	// Get the validator sets
+	validatorsRaw, err := batch.Execute(context.Background())
+	if err != nil {
+		// Try to fetch sequentially
+		return getValidatorsSequentially(ctx, blocks, client)
+	}
+
+	if len(validatorsRaw) != len(requested) {
+		return nil, errors.New("invalid number of validators batch results")
+	}
+
+	// Extract the validator sets
+	for resultIndex, resultRaw := range validatorsRaw {
+		result, ok := resultRaw.(*core_types.ResultValidators)
+		if !ok {
+			return nil, errors.New("unable to cast batch result into ResultValidators")
+		}
+
+		fetchedValidators[requested[resultIndex]] = result.Validators
+	}
+
+	return fetchedValidators, nil
+}
+
+// getValidatorsSequentially attempts to fetch validator sets from the client, using sequential requests
+func getValidatorsSequentially(ctx context.Context, blocks []*types.Block, client Client) ([][]*types.Validator, error) {
+	var (
+		errs       = make([]error, 0)
+		validators = make([][]*types.Validator, len(blocks))
+	)
+
+	for index, block := range blocks {
+		if !validatorSetChanged(blocks, index) {
+			continue
+		}
+
+		// Get the validator set of the block
+		result, err := client.GetValidators(ctx, uint64(block.Height))
+		if err != nil {
+			errs = append(
+				errs,
+				fmt.Errorf(
+					"unable to get validators for block %d, %w",
+					block.Height,
+					err,
+				),
+			)
+
+			continue
+		}
+
+		if result != nil {
+			validators[index] = result.Validators
+		}
 	}
 
-	return results, errors.Join(errs...)
+	return validators, errors.Join(errs...)
 }
@@ -0,0 +1,194 @@
+package fetch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gnolang/gno/gnovm/stdlibs/chain"
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
+	core_types "github.com/gnolang/gno/tm2/pkg/bft/rpc/core/types"
+	"github.com/gnolang/gno/tm2/pkg/bft/state"
+	"github.com/gnolang/gno/tm2/pkg/crypto/ed25519"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	clientTypes "github.com/gnolang/tx-indexer/client/types"
+	indexerTypes "github.com/gnolang/tx-indexer/types"
+)
+
+func TestGetTxResultFromBatch_BlockResults(t *testing.T) {
+	t.Parallel()
+
+	var (
+		pubKey = ed25519.GenPrivKey().PubKey()
+
+		beginEvent = chain.Event{Type: "Begin", PkgPath: "gno.land/r/sys/foo"}
+		endEvent   = chain.Event{Type: "ValidatorAdded", PkgPath: "gno.land/r/sys/validators/v2"}
+		baseEvent  = chain.Event{Type: "End", PkgPath: "gno.land/r/sys/foo"}
+
+		update = abci.ValidatorUpdate{
+			Address: pubKey.Address(),
+			PubKey:  pubKey,
+			Power:   1,
+		}
+
+		blocks = generateBlocks(t, 3, generateTransactions(t, 1))
+	)
+
+	// Blocks without transactions can still update the validator set
+	blocks[1].NumTxs = 0
+	blocks[1].Txs = nil
+
+	newResults := func(height uint64) *core_types.ResultBlockResults {
+		results := &core_types.ResultBlockResults{
+			Height: int64(height),
+			Results: &state.ABCIResponses{
+				DeliverTxs: make([]abci.ResponseDeliverTx, 1),
+			},
+		}
+
+		if height == 1 {
+			results.Results.BeginBlock.Events = []abci.Event{beginEvent}
+			results.Results.EndBlock.ResponseBase.Events = []abci.Event{baseEvent}
+			results.Results.EndBlock.Events = []abci.Event{endEvent}
+			results.Results.EndBlock.ValidatorUpdates = []abci.ValidatorUpdate{update}
+		}
+
+		return results
+	}
+
+	expected := []*indexerTypes.BlockResults{
+		{Height: 0},
+		{
+			BeginBlockEvents: []abci.Event{beginEvent},
+			EndBlockEvents:   []abci.Event{baseEvent, endEvent},
+			ValidatorUpdates: []abci.ValidatorUpdate{update},
+			Height:           1,
+		},
+		{Height: 2},
+	}
+
+	t.Run("batch", func(t *testing.T) {
+		t.Parallel()
+
+		batch := make([]any, 0)
+
+		client := &mockClient{
+			createBatchFn: func() clientTypes.Batch {
+				return &mockBatch{
+					addBlockResultsRequestFn: func(num uint64) error {
+						batch = append(batch, newResults(num))
+
+						return nil
+					},
+					executeFn: func(_ context.Context) ([]any, error) {
+						return batch, nil
+					},
+					countFn: func() int {
+						return len(batch)
+					},
+				}
+			},
+		}
+
+		results, blockResults, err := getTxResultFromBatch(context.Background(), blocks, client)
+		require.NoError(t, err)
+
+		assert.Equal(t, expected, blockResults)
+
+		// Make sure the results are aligned with the blocks
+		require.Len(t, results, len(blocks))
+		assert.Len(t, results[0], 1)
+		assert.Empty(t, results[1])
+		assert.Len(t, results[2], 1)
+	})
+
+	t.Run("sequential", func(t *testing.T) {
+		t.Parallel()
+
+		client := &mockClient{
+			createBatchFn: func() clientTypes.Batch {
+				return &mockBatch{
+					executeFn: func(_ context.Context) ([]any, error) {
+						// Force an error
+						return nil, errors.New("something is flaky")
+					},
+					countFn: func() int {
+						return 1 // to trigger execution
+					},
+				}
+			},
+			getBlockResultsFn: func(num uint64) (*core_types.ResultBlockResults, error) {
+				return newResults(num), nil
+			},
+		}
+
+		_, blockResults, err := getTxResultFromBatch(context.Background(), blocks, client)
+		require.NoError(t, err)
+
+		assert.Equal(t, expected, blockResults)
+	})
+}
+
+func TestGetTxResultFromBatch_EmptyBlocks(t *testing.T) {
+	t.Parallel()
+
+	blocks := generateBlocks(t, 4, generateTransactions(t, 2))
+
+	// Leave a block without transactions between blocks with transactions,
+	// so misplaced results are caught
+	blocks[1].NumTxs = 0
+	blocks[1].Txs = nil
+
+	batch := make([]any, 0)
+
+	client := &mockClient{
+		createBatchFn: func() clientTypes.Batch {
+			return &mockBatch{
+				addBlockResultsRequestFn: func(num uint64) error {
+					deliverTxs := make([]abci.ResponseDeliverTx, 2)
+					for index := range deliverTxs {
+						deliverTxs[index].Info = fmt.Sprintf("block %d, tx %d", num, index)
+					}
+
+					batch = append(batch, &core_types.ResultBlockResults{
+						Height: int64(num),
+						Results: &state.ABCIResponses{
+							DeliverTxs: deliverTxs,
+						},
+					})
+
+					return nil
+				},
+				executeFn: func(_ context.Context) ([]any, error) {
+					return batch, nil
+				},
+				countFn: func() int {
+					return len(batch)
+				},
+			}
+		},
+	}
+
+	results, _, err := getTxResultFromBatch(context.Background(), blocks, client)
+	require.NoError(t, err)
+
+	require.Len(t, results, len(blocks))
+
+	// Make sure each block gets its own results
+	for blockIndex, block := range blocks {
+		require.Len(t, results[blockIndex], int(block.NumTxs))
+
+		for txIndex, result := range results[blockIndex] {
+			assert.Equal(t, block.Height, result.Height)
+			assert.Equal(t, block.Txs[txIndex], result.Tx)
+			assert.Equal(
+				t,
+				fmt.Sprintf("block %d, tx %d", block.Height, txIndex),
+				result.Response.Info,
+			)
+		}
+	}
+}
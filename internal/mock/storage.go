@@ -4,6 +4,8 @@ import (
 	"github.com/gnolang/gno/tm2/pkg/bft/types"
 
 	"github.com/gnolang/tx-indexer/storage"
+	storageErrors "github.com/gnolang/tx-indexer/storage/errors"
+	indexerTypes "github.com/gnolang/tx-indexer/types"
 )
 
 var _ storage.Storage = &Storage{}
@@ -14,6 +16,16 @@ type Storage struct {
 	GetBlockFn             func(uint64) (*types.Block, error)
 	GetTxFn                func(uint64, uint32) (*types.TxResult, error)
 	GetTxByHashFn          func(string) (*types.TxResult, error)
+	GetAccountFn           func(string) (*indexerTypes.Account, error)
+	GetPackageFn           func(string) (*indexerTypes.Package, error)
+	GetLatestBalanceFn     func(string, string) (*indexerTypes.BalanceDelta, error)
+	GetTokenFn             func(string) (*indexerTypes.Token, error)
+	GetTokenBalanceFn      func(string, string) (*indexerTypes.TokenBalance, error)
+	GetNFTCollectionFn     func(string) (*indexerTypes.NFTCollection, error)
+	GetNFTFn               func(string, string) (*indexerTypes.NFT, error)
+	GetGenesisFn           func() (*indexerTypes.Genesis, error)
+	GetValidatorSetFn      func(uint64) (*indexerTypes.ValidatorSet, error)
+	TxIteratorFn           func(uint64, uint64, uint32, uint32) (storage.Iterator[*types.TxResult], error)
 }
 
 func (m *Storage) GetLatestHeight() (uint64, error) {
@@ -24,6 +36,15 @@ func (m *Storage) GetLatestHeight() (uint64, error) {
 	return 0, nil
 }
 
+// GetGenesis fetches the genesis state, beyond its transactions
+func (m *Storage) GetGenesis() (*indexerTypes.Genesis, error) {
+	if m.GetGenesisFn != nil {
+		return m.GetGenesisFn()
+	}
+
+	panic("not implemented") // TODO: Implement
+}
+
 // GetBlock fetches the block by its number
 func (m *Storage) GetBlock(blockNum uint64) (*types.Block, error) {
 	if m.GetBlockFn != nil {
@@ -58,11 +79,15 @@ func (m *Storage) BlockIterator(_, _ uint64) (storage.Iterator[*types.Block], er
 // TxIterator iterates over transactions, limiting the results to be between the provided block numbers
 // and transaction indexes
 func (m *Storage) TxIterator(
-	_,
-	_ uint64,
-	_,
-	_ uint32,
+	fromBlockNum,
+	toBlockNum uint64,
+	fromTxIndex,
+	toTxIndex uint32,
 ) (storage.Iterator[*types.TxResult], error) {
+	if m.TxIteratorFn != nil {
+		return m.TxIteratorFn(fromBlockNum, toBlockNum, fromTxIndex, toTxIndex)
+	}
+
 	panic("not implemented") // TODO: Implement
 }
 
@@ -79,6 +104,196 @@ func (m *Storage) TxReverseIterator(
 	panic("not implemented") // TODO: Implement
 }
 
+// GetAccount fetches the activity summary of the given address
+func (m *Storage) GetAccount(address string) (*indexerTypes.Account, error) {
+	if m.GetAccountFn != nil {
+		return m.GetAccountFn(address)
+	}
+
+	return nil, storageErrors.ErrNotFound
+}
+
+// AccountTxIterator iterates over the transactions the given address took part in
+func (m *Storage) AccountTxIterator(_ string, _ uint64, _ uint32) (storage.Iterator[*types.TxResult], error) {
+	panic("not implemented") // TODO: Implement
+}
+
+// GetPackage fetches the package deployed at the given path
+func (m *Storage) GetPackage(path string) (*indexerTypes.Package, error) {
+	if m.GetPackageFn != nil {
+		return m.GetPackageFn(path)
+	}
+
+	return nil, storageErrors.ErrNotFound
+}
+
+// PackageIterator iterates over all the deployed packages
+func (m *Storage) PackageIterator() (storage.Iterator[*indexerTypes.Package], error) {
+	panic("not implemented") // TODO: Implement
+}
+
+// PackageImporterIterator iterates over the paths of the packages importing the given path
+func (m *Storage) PackageImporterIterator(_ string) (storage.Iterator[string], error) {
+	panic("not implemented") // TODO: Implement
+}
+
+// GetSearchDocument fetches the full-text search document using its ID
+func (m *Storage) GetSearchDocument(_ string) (*indexerTypes.SearchDocument, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+// SearchPostingIterator iterates over the full-text search postings of the given term
+func (m *Storage) SearchPostingIterator(_ string, _ bool) (storage.Iterator[*indexerTypes.SearchPosting], error) {
+	panic("not implemented") // TODO: Implement
+}
+
+// BlockFeesIterator iterates over the block fee summaries
+func (m *Storage) BlockFeesIterator(_, _ uint64) (storage.Iterator[*indexerTypes.BlockFees], error) {
+	panic("not implemented") // TODO: Implement
+}
+
+// FuncGasStatsIterator iterates over the gas usage summaries of the given realm function
+func (m *Storage) FuncGasStatsIterator(
+	_,
+	_ string,
+	_,
+	_ uint64,
+) (storage.Iterator[*indexerTypes.FuncGasStats], error) {
+	panic("not implemented") // TODO: Implement
+}
+
+// RealmFailureIterator iterates over the failed calls to the given realm
+func (m *Storage) RealmFailureIterator(_ string, _, _ uint64) (storage.Iterator[*indexerTypes.RealmFailure], error) {
+	panic("not implemented") // TODO: Implement
+}
+
+// TransferIterator iterates over the native coin movements
+func (m *Storage) TransferIterator(_, _ uint64) (storage.Iterator[*indexerTypes.Transfer], error) {
+	panic("not implemented") // TODO: Implement
+}
+
+// AddressTransferIterator iterates over the native coin movements of the given address
+func (m *Storage) AddressTransferIterator(_ string, _, _ uint64) (storage.Iterator[*indexerTypes.Transfer], error) {
+	panic("not implemented") // TODO: Implement
+}
+
+// GetLatestBalance fetches the most recent balance change of the given address in the given denomination
+func (m *Storage) GetLatestBalance(address, denom string) (*indexerTypes.BalanceDelta, error) {
+	if m.GetLatestBalanceFn != nil {
+		return m.GetLatestBalanceFn(address, denom)
+	}
+
+	return nil, storageErrors.ErrNotFound
+}
+
+// BalanceHistoryIterator iterates over the per-block balance changes of the given address in the given denomination
+func (m *Storage) BalanceHistoryIterator(
+	_,
+	_ string,
+	_,
+	_ uint64,
+) (storage.Iterator[*indexerTypes.BalanceDelta], error) {
+	panic("not implemented") // TODO: Implement
+}
+
+// GetToken fetches the GRC20 token with the given identifier
+func (m *Storage) GetToken(id string) (*indexerTypes.Token, error) {
+	if m.GetTokenFn != nil {
+		return m.GetTokenFn(id)
+	}
+
+	return nil, storageErrors.ErrNotFound
+}
+
+// TokenIterator iterates over all the known GRC20 tokens
+func (m *Storage) TokenIterator() (storage.Iterator[*indexerTypes.Token], error) {
+	panic("not implemented") // TODO: Implement
+}
+
+// TokenTransferIterator iterates over the GRC20 token movements
+func (m *Storage) TokenTransferIterator(_, _ uint64) (storage.Iterator[*indexerTypes.TokenTransfer], error) {
+	panic("not implemented") // TODO: Implement
+}
+
+// AddressTokenTransferIterator iterates over the GRC20 token movements of the given address
+func (m *Storage) AddressTokenTransferIterator(
+	_ string,
+	_, _ uint64,
+) (storage.Iterator[*indexerTypes.TokenTransfer], error) {
+	panic("not implemented") // TODO: Implement
+}
+
+// GetTokenBalance fetches the balance of the given address in the given GRC20 token
+func (m *Storage) GetTokenBalance(address, token string) (*indexerTypes.TokenBalance, error) {
+	if m.GetTokenBalanceFn != nil {
+		return m.GetTokenBalanceFn(address, token)
+	}
+
+	return nil, storageErrors.ErrNotFound
+}
+
+// TokenBalanceIterator iterates over the GRC20 token balances of the given address
+func (m *Storage) TokenBalanceIterator(_ string) (storage.Iterator[*indexerTypes.TokenBalance], error) {
+	panic("not implemented") // TODO: Implement
+}
+
+// GetNFTCollection fetches the GRC721 collection of the given realm
+func (m *Storage) GetNFTCollection(pkgPath string) (*indexerTypes.NFTCollection, error) {
+	if m.GetNFTCollectionFn != nil {
+		return m.GetNFTCollectionFn(pkgPath)
+	}
+
+	return nil, storageErrors.ErrNotFound
+}
+
+// NFTCollectionIterator iterates over all the known GRC721 collections
+func (m *Storage) NFTCollectionIterator() (storage.Iterator[*indexerTypes.NFTCollection], error) {
+	panic("not implemented") // TODO: Implement
+}
+
+// GetNFT fetches the ownership state of the given GRC721 token
+func (m *Storage) GetNFT(collection, tokenID string) (*indexerTypes.NFT, error) {
+	if m.GetNFTFn != nil {
+		return m.GetNFTFn(collection, tokenID)
+	}
+
+	return nil, storageErrors.ErrNotFound
+}
+
+// NFTOwnerIterator iterates over the GRC721 tokens currently owned by the given address
+func (m *Storage) NFTOwnerIterator(_ string) (storage.Iterator[*indexerTypes.NFT], error) {
+	panic("not implemented") // TODO: Implement
+}
+
+// NFTHistoryIterator iterates over the ownership changes of the given GRC721 token
+func (m *Storage) NFTHistoryIterator(_, _ string) (storage.Iterator[*indexerTypes.NFTEvent], error) {
+	panic("not implemented") // TODO: Implement
+}
+
+// GetValidatorSet fetches the validator set of the given block
+func (m *Storage) GetValidatorSet(blockNum uint64) (*indexerTypes.ValidatorSet, error) {
+	if m.GetValidatorSetFn != nil {
+		return m.GetValidatorSetFn(blockNum)
+	}
+
+	return nil, storageErrors.ErrNotFound
+}
+
+// GetBlockSignatures fetches the validator signatures of the given block
+func (m *Storage) GetBlockSignatures(_ uint64) (*indexerTypes.BlockSignatures, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+// BlockSignaturesIterator iterates over the validator signatures of the blocks
+func (m *Storage) BlockSignaturesIterator(_, _ uint64) (storage.Iterator[*indexerTypes.BlockSignatures], error) {
+	panic("not implemented") // TODO: Implement
+}
+
+// GetBlockResults fetches the BeginBlock / EndBlock results of the given block
+func (m *Storage) GetBlockResults(_ uint64) (*indexerTypes.BlockResults, error) {
+	panic("not implemented") // TODO: Implement
+}
+
 // WriteBatch provides a batch intended to do a write action that
 // can be cancelled or committed all at the same time
 func (m *Storage) WriteBatch() storage.Batch {
@@ -94,9 +309,31 @@ func (m *Storage) Close() error {
 }
 
 type WriteBatch struct {
-	SetLatestHeightFn func(uint64) error
-	SetBlockFn        func(*types.Block) error
-	SetTxFn           func(*types.TxResult) error
+	CommitFn             func() error
+	SetLatestHeightFn    func(uint64) error
+	SetGenesisFn         func(*indexerTypes.Genesis) error
+	SetBlockFn           func(*types.Block) error
+	SetTxFn              func(*types.TxResult) error
+	SetAccountFn         func(*indexerTypes.Account) error
+	SetAccountTxFn       func(string, uint64, uint32) error
+	SetPackageFn         func(*indexerTypes.Package) error
+	SetPackageImportFn   func(string, string) error
+	SetSearchDocumentFn  func(*indexerTypes.SearchDocument, map[string][]uint32) error
+	SetBlockFeesFn       func(*indexerTypes.BlockFees) error
+	SetFuncGasStatsFn    func(*indexerTypes.FuncGasStats) error
+	SetRealmFailureFn    func(*indexerTypes.RealmFailure) error
+	SetTransferFn        func(*indexerTypes.Transfer) error
+	SetBalanceDeltaFn    func(*indexerTypes.BalanceDelta) error
+	SetTokenFn           func(*indexerTypes.Token) error
+	SetTokenTransferFn   func(*indexerTypes.TokenTransfer) error
+	SetTokenBalanceFn    func(*indexerTypes.TokenBalance) error
+	SetNFTCollectionFn   func(*indexerTypes.NFTCollection) error
+	SetNFTFn             func(*indexerTypes.NFT) error
+	RemoveNFTOwnerFn     func(string, string, string) error
+	SetNFTEventFn        func(*indexerTypes.NFTEvent) error
+	SetValidatorSetFn    func(*indexerTypes.ValidatorSet) error
+	SetBlockSignaturesFn func(*indexerTypes.BlockSignatures) error
+	SetBlockResultsFn    func(*indexerTypes.BlockResults) error
 }
 
 // SetLatestHeight saves the latest block height to the storage
@@ -108,6 +345,15 @@ func (mb *WriteBatch) SetLatestHeight(h uint64) error {
 	return nil
 }
 
+// SetGenesis saves the genesis state to the storage
+func (mb *WriteBatch) SetGenesis(genesis *indexerTypes.Genesis) error {
+	if mb.SetGenesisFn != nil {
+		return mb.SetGenesisFn(genesis)
+	}
+
+	return nil
+}
+
 // SetBlock saves the block to the permanent storage
 func (mb *WriteBatch) SetBlock(block *types.Block) error {
 	if mb.SetBlockFn != nil {
@@ -126,9 +372,193 @@ func (mb *WriteBatch) SetTx(tx *types.TxResult) error {
 	return nil
 }
 
+// SetAccount saves the account activity summary to the permanent storage
+func (mb *WriteBatch) SetAccount(account *indexerTypes.Account) error {
+	if mb.SetAccountFn != nil {
+		return mb.SetAccountFn(account)
+	}
+
+	return nil
+}
+
+// SetAccountTx links the transaction at the given position to the address
+func (mb *WriteBatch) SetAccountTx(address string, blockNum uint64, txIndex uint32) error {
+	if mb.SetAccountTxFn != nil {
+		return mb.SetAccountTxFn(address, blockNum, txIndex)
+	}
+
+	return nil
+}
+
+// SetPackage saves the package deployment to the permanent storage
+func (mb *WriteBatch) SetPackage(pkg *indexerTypes.Package) error {
+	if mb.SetPackageFn != nil {
+		return mb.SetPackageFn(pkg)
+	}
+
+	return nil
+}
+
+// SetPackageImport links the importer package path to the imported package path
+func (mb *WriteBatch) SetPackageImport(importedPath, importerPath string) error {
+	if mb.SetPackageImportFn != nil {
+		return mb.SetPackageImportFn(importedPath, importerPath)
+	}
+
+	return nil
+}
+
+// SetSearchDocument saves the full-text search document, along with the postings of its terms
+func (mb *WriteBatch) SetSearchDocument(doc *indexerTypes.SearchDocument, terms map[string][]uint32) error {
+	if mb.SetSearchDocumentFn != nil {
+		return mb.SetSearchDocumentFn(doc, terms)
+	}
+
+	return nil
+}
+
+// SetBlockFees saves the block fee summary to the permanent storage
+func (mb *WriteBatch) SetBlockFees(fees *indexerTypes.BlockFees) error {
+	if mb.SetBlockFeesFn != nil {
+		return mb.SetBlockFeesFn(fees)
+	}
+
+	return nil
+}
+
+// SetFuncGasStats saves the realm function gas usage summary to the permanent storage
+func (mb *WriteBatch) SetFuncGasStats(stats *indexerTypes.FuncGasStats) error {
+	if mb.SetFuncGasStatsFn != nil {
+		return mb.SetFuncGasStatsFn(stats)
+	}
+
+	return nil
+}
+
+// SetRealmFailure saves the failed realm call to the permanent storage
+func (mb *WriteBatch) SetRealmFailure(failure *indexerTypes.RealmFailure) error {
+	if mb.SetRealmFailureFn != nil {
+		return mb.SetRealmFailureFn(failure)
+	}
+
+	return nil
+}
+
+// SetTransfer saves the native coin movement to the permanent storage
+func (mb *WriteBatch) SetTransfer(transfer *indexerTypes.Transfer) error {
+	if mb.SetTransferFn != nil {
+		return mb.SetTransferFn(transfer)
+	}
+
+	return nil
+}
+
+// SetBalanceDelta saves the per-block balance change to the permanent storage
+func (mb *WriteBatch) SetBalanceDelta(delta *indexerTypes.BalanceDelta) error {
+	if mb.SetBalanceDeltaFn != nil {
+		return mb.SetBalanceDeltaFn(delta)
+	}
+
+	return nil
+}
+
+// SetToken saves the GRC20 token to the permanent storage
+func (mb *WriteBatch) SetToken(token *indexerTypes.Token) error {
+	if mb.SetTokenFn != nil {
+		return mb.SetTokenFn(token)
+	}
+
+	return nil
+}
+
+// SetTokenTransfer saves the GRC20 token movement to the permanent storage
+func (mb *WriteBatch) SetTokenTransfer(transfer *indexerTypes.TokenTransfer) error {
+	if mb.SetTokenTransferFn != nil {
+		return mb.SetTokenTransferFn(transfer)
+	}
+
+	return nil
+}
+
+// SetTokenBalance saves the GRC20 token balance to the permanent storage
+func (mb *WriteBatch) SetTokenBalance(balance *indexerTypes.TokenBalance) error {
+	if mb.SetTokenBalanceFn != nil {
+		return mb.SetTokenBalanceFn(balance)
+	}
+
+	return nil
+}
+
+// SetNFTCollection saves the GRC721 collection to the permanent storage
+func (mb *WriteBatch) SetNFTCollection(collection *indexerTypes.NFTCollection) error {
+	if mb.SetNFTCollectionFn != nil {
+		return mb.SetNFTCollectionFn(collection)
+	}
+
+	return nil
+}
+
+// SetNFT saves the GRC721 token ownership state, indexed by its current owner
+func (mb *WriteBatch) SetNFT(nft *indexerTypes.NFT) error {
+	if mb.SetNFTFn != nil {
+		return mb.SetNFTFn(nft)
+	}
+
+	return nil
+}
+
+// RemoveNFTOwner removes the GRC721 token from the index of its previous owner
+func (mb *WriteBatch) RemoveNFTOwner(owner, collection, tokenID string) error {
+	if mb.RemoveNFTOwnerFn != nil {
+		return mb.RemoveNFTOwnerFn(owner, collection, tokenID)
+	}
+
+	return nil
+}
+
+// SetNFTEvent saves the GRC721 ownership change to the permanent storage
+func (mb *WriteBatch) SetNFTEvent(event *indexerTypes.NFTEvent) error {
+	if mb.SetNFTEventFn != nil {
+		return mb.SetNFTEventFn(event)
+	}
+
+	return nil
+}
+
+// SetValidatorSet saves the validator set change to the permanent storage
+func (mb *WriteBatch) SetValidatorSet(set *indexerTypes.ValidatorSet) error {
+	if mb.SetValidatorSetFn != nil {
+		return mb.SetValidatorSetFn(set)
+	}
+
+	return nil
+}
+
+// SetBlockSignatures saves the validator signatures of the block to the permanent storage
+func (mb *WriteBatch) SetBlockSignatures(signatures *indexerTypes.BlockSignatures) error {
+	if mb.SetBlockSignaturesFn != nil {
+		return mb.SetBlockSignaturesFn(signatures)
+	}
+
+	return nil
+}
+
+// SetBlockResults saves the BeginBlock / EndBlock results of the block to the permanent storage
+func (mb *WriteBatch) SetBlockResults(results *indexerTypes.BlockResults) error {
+	if mb.SetBlockResultsFn != nil {
+		return mb.SetBlockResultsFn(results)
+	}
+
+	return nil
+}
+
 // Commit stores all the provided info on the storage and make
 // it available for other storage readers
 func (mb *WriteBatch) Commit() error {
+	if mb.CommitFn != nil {
+		return mb.CommitFn()
+	}
+
 	return nil
 }
 
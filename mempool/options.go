@@ -0,0 +1,25 @@
+package mempool
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type Option func(p *Poller)
+
+// WithLogger sets the logger to be used
+// with the poller
+func WithLogger(logger *zap.Logger) Option {
+	return func(p *Poller) {
+		p.logger = logger
+	}
+}
+
+// WithPollInterval sets the mempool
+// query interval for the poller
+func WithPollInterval(interval time.Duration) Option {
+	return func(p *Poller) {
+		p.pollInterval = interval
+	}
+}
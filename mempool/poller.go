@@ -0,0 +1,130 @@
+package mempool
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/gnolang/tx-indexer/events"
+	"github.com/gnolang/tx-indexer/types"
+)
+
+const (
+	// DefaultPollInterval is the default mempool query interval
+	DefaultPollInterval = 1 * time.Second
+
+	// maxUnconfirmedTxs is the maximum number of transactions the node returns per query
+	maxUnconfirmedTxs = 100
+)
+
+// Poller keeps the pool up to date with the node mempool,
+// and with the blocks indexed by the fetcher
+type Poller struct {
+	pool   *Pool
+	client Client
+	events Events
+
+	logger *zap.Logger
+
+	pollInterval time.Duration
+}
+
+// NewPoller creates a new mempool poller
+func NewPoller(
+	pool *Pool,
+	client Client,
+	events Events,
+	opts ...Option,
+) *Poller {
+	p := &Poller{
+		pool:         pool,
+		client:       client,
+		events:       events,
+		logger:       zap.NewNop(),
+		pollInterval: DefaultPollInterval,
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+}
+
+// Run polls the node mempool until the context is canceled,
+// signaling the pending transaction updates
+func (p *Poller) Run(ctx context.Context) error {
+	subscription := p.events.Subscribe([]events.Type{types.NewBlockEvent})
+	defer p.events.CancelSubscription(subscription.ID)
+
+	ticker := time.NewTicker(p.pollInterval)
+	defer ticker.Stop()
+
+	p.pollAndSignal(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			p.logger.Info("mempool poller stopped")
+
+			return nil
+		case <-ticker.C:
+			p.pollAndSignal(ctx)
+		case event, more := <-subscription.SubCh:
+			if !more {
+				return nil
+			}
+
+			newBlock, ok := event.GetData().(*types.NewBlock)
+			if !ok {
+				continue
+			}
+
+			p.signal(p.pool.applyBlock(newBlock.Block))
+		}
+	}
+}
+
+// pollAndSignal polls the node mempool, logging any error
+func (p *Poller) pollAndSignal(ctx context.Context) {
+	updated, err := p.poll(ctx)
+	if err != nil {
+		p.logger.Error("unable to poll mempool", zap.Error(err))
+
+		return
+	}
+
+	p.signal(updated)
+}
+
+// poll applies the current node mempool to the pool
+func (p *Poller) poll(ctx context.Context) ([]*types.PendingTx, error) {
+	// The mempool is queried before the height, so transactions
+	// missing from it are included up to that height, if at all
+	unconfirmed, err := p.client.GetUnconfirmedTxs(ctx, maxUnconfirmedTxs)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get unconfirmed txs, %w", err)
+	}
+
+	height, err := p.client.GetLatestBlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get latest block number, %w", err)
+	}
+
+	// The node only returns up to maxUnconfirmedTxs of the oldest transactions,
+	// without paging, so the snapshot is partial on busy mempools
+	complete := len(unconfirmed.Txs) >= unconfirmed.Total
+
+	return p.pool.observe(unconfirmed.Txs, complete, int64(height), time.Now()), nil
+}
+
+// signal alerts any listeners of the pending transaction updates
+func (p *Poller) signal(updated []*types.PendingTx) {
+	for _, tx := range updated {
+		p.events.SignalEvent(&types.PendingTxUpdate{
+			Tx: tx,
+		})
+	}
+}
@@ -0,0 +1,261 @@
+package mempool
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	core_types "github.com/gnolang/gno/tm2/pkg/bft/rpc/core/types"
+	rpctypes "github.com/gnolang/gno/tm2/pkg/bft/rpc/lib/types"
+	bft_types "github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/tx-indexer/client"
+	"github.com/gnolang/tx-indexer/events"
+	"github.com/gnolang/tx-indexer/types"
+)
+
+// fakeNode is a fake node RPC server, serving the mempool and the latest height
+type fakeNode struct {
+	txs    []bft_types.Tx
+	height int64
+
+	mu sync.Mutex
+}
+
+func (n *fakeNode) setState(txs []bft_types.Tx, height int64) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.txs = txs
+	n.height = height
+}
+
+func (n *fakeNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	var request rpctypes.RPCRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	var result any
+
+	switch request.Method {
+	case "unconfirmed_txs":
+		// Same as the node, at most maxUnconfirmedTxs
+		// of the oldest transactions are returned
+		txs := n.txs[:min(len(n.txs), maxUnconfirmedTxs)]
+
+		result = &core_types.ResultUnconfirmedTxs{
+			Count: len(txs),
+			Total: len(n.txs),
+			Txs:   txs,
+		}
+	case "status":
+		result = &core_types.ResultStatus{
+			SyncInfo: core_types.SyncInfo{
+				LatestBlockHeight: n.height,
+			},
+		}
+	default:
+		http.Error(w, "unknown method", http.StatusBadRequest)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	//nolint:errcheck // The test will fail on invalid responses
+	json.NewEncoder(w).Encode(rpctypes.NewRPCSuccessResponse(request.ID, result))
+}
+
+func TestPoller_Run(t *testing.T) {
+	t.Parallel()
+
+	var (
+		tx = bft_types.Tx("pending tx")
+
+		node = &fakeNode{
+			txs:    []bft_types.Tx{tx},
+			height: 10,
+		}
+
+		manager = events.NewManager()
+		pool    = NewPool(10)
+	)
+
+	server := httptest.NewServer(node)
+	defer server.Close()
+
+	tm2Client, err := client.NewClient(server.URL)
+	require.NoError(t, err)
+
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	subscription := manager.Subscribe([]events.Type{types.PendingTxEvent})
+	defer manager.CancelSubscription(subscription.ID)
+
+	poller := NewPoller(pool, tm2Client, manager, WithPollInterval(10*time.Millisecond))
+
+	done := make(chan error, 1)
+	go func() {
+		done <- poller.Run(ctx)
+	}()
+
+	nextUpdate := func() *types.PendingTx {
+		t.Helper()
+
+		select {
+		case event := <-subscription.SubCh:
+			update, ok := event.GetData().(*types.PendingTxUpdate)
+			require.True(t, ok)
+
+			return update.Tx
+		case <-time.After(5 * time.Second):
+			t.Fatal("pending tx update not received")
+		}
+
+		return nil
+	}
+
+	// Make sure the mempool transaction is signaled as pending
+	pending := nextUpdate()
+
+	assert.Equal(t, tx.Hash(), pending.Hash)
+	assert.Equal(t, types.PendingTxPending, pending.Status)
+
+	// The transaction leaves the mempool, and is included in the next block
+	node.setState(nil, 11)
+
+	require.Eventually(t, func() bool {
+		pool.mu.RLock()
+		defer pool.mu.RUnlock()
+
+		return pool.txs[string(tx.Hash())].missingAt == 11
+	}, 5*time.Second, 10*time.Millisecond)
+
+	manager.SignalEvent(&types.NewBlock{
+		Block: &bft_types.Block{
+			Header: bft_types.Header{Height: 11},
+			Data:   bft_types.Data{Txs: bft_types.Txs{tx}},
+		},
+	})
+
+	// Make sure the transaction is signaled as included
+	included := nextUpdate()
+
+	assert.Equal(t, types.PendingTxIncluded, included.Status)
+	assert.Equal(t, int64(11), included.Height)
+
+	cancelFn()
+
+	assert.NoError(t, <-done)
+}
+
+func TestPoller_Run_BusyMempool(t *testing.T) {
+	t.Parallel()
+
+	// The node mempool holds more transactions than returned per query
+	txs := make([]bft_types.Tx, 0, 2*maxUnconfirmedTxs)
+
+	for index := range 2 * maxUnconfirmedTxs {
+		txs = append(txs, bft_types.Tx(fmt.Sprintf("pending tx %d", index)))
+	}
+
+	var (
+		node = &fakeNode{
+			txs:    txs,
+			height: 10,
+		}
+
+		manager = events.NewManager()
+		pool    = NewPool(len(txs))
+	)
+
+	server := httptest.NewServer(node)
+	defer server.Close()
+
+	tm2Client, err := client.NewClient(server.URL)
+	require.NoError(t, err)
+
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	poller := NewPoller(pool, tm2Client, manager, WithPollInterval(10*time.Millisecond))
+
+	done := make(chan error, 1)
+	go func() {
+		done <- poller.Run(ctx)
+	}()
+
+	missingAt := func(tx bft_types.Tx) int64 {
+		pool.mu.RLock()
+		defer pool.mu.RUnlock()
+
+		e, ok := pool.txs[string(tx.Hash())]
+		if !ok {
+			return -1
+		}
+
+		return e.missingAt
+	}
+
+	// Make sure the oldest transactions are tracked
+	require.Eventually(t, func() bool {
+		return missingAt(txs[0]) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The oldest transaction leaves the mempool, which is still over the query limit
+	node.setState(txs[1:], 11)
+
+	require.Eventually(t, func() bool {
+		return missingAt(txs[0]) == 11
+	}, 5*time.Second, 10*time.Millisecond)
+
+	subscription := manager.Subscribe([]events.Type{types.PendingTxEvent})
+	defer manager.CancelSubscription(subscription.ID)
+
+	manager.SignalEvent(&types.NewBlock{
+		Block: &bft_types.Block{
+			Header: bft_types.Header{Height: 11},
+		},
+	})
+
+	// Make sure the transaction is signaled as dropped,
+	// even though the mempool snapshot is partial
+	for {
+		select {
+		case event := <-subscription.SubCh:
+			update, ok := event.GetData().(*types.PendingTxUpdate)
+			require.True(t, ok)
+
+			if update.Tx.Status != types.PendingTxDropped {
+				continue
+			}
+
+			assert.Equal(t, txs[0].Hash(), update.Tx.Hash)
+
+			// Make sure the transaction that moved into the snapshot is pending
+			assert.Zero(t, missingAt(txs[maxUnconfirmedTxs]))
+
+			cancelFn()
+
+			assert.NoError(t, <-done)
+
+			return
+		case <-time.After(5 * time.Second):
+			t.Fatal("dropped tx update not received")
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package mempool
+
+import (
+	"sync"
+	"time"
+
+	bft_types "github.com/gnolang/gno/tm2/pkg/bft/types"
+
+	"github.com/gnolang/tx-indexer/types"
+)
+
+// DefaultMaxSize is the default maximum number of transactions kept by the pool
+const DefaultMaxSize = 10_000
+
+// entry is a single transaction tracked by the pool
+type entry struct {
+	tx *types.PendingTx
+
+	// missingAt is the node height the pending transaction was
+	// first found missing from the mempool at, 0 if it is in the mempool
+	missingAt int64
+
+	// position is the position of the transaction
+	// in the last mempool snapshot it was seen in
+	position int
+}
+
+// Pool is an in-memory, size-bounded set of the transactions seen in the node mempool,
+// along with their inclusion status. The oldest transactions are evicted first
+type Pool struct {
+	txs   map[string]*entry
+	order []string // hashes by first seen, oldest first
+
+	maxSize int
+
+	mu sync.RWMutex
+}
+
+// NewPool creates a new pool, keeping up to the given number of transactions
+func NewPool(maxSize int) *Pool {
+	if maxSize <= 0 {
+		maxSize = DefaultMaxSize
+	}
+
+	return &Pool{
+		txs:     make(map[string]*entry),
+		order:   make([]string, 0),
+		maxSize: maxSize,
+	}
+}
+
+// List returns the tracked transactions, oldest first
+func (p *Pool) List() []*types.PendingTx {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	txs := make([]*types.PendingTx, 0, len(p.order))
+
+	for _, hash := range p.order {
+		txs = append(txs, copyTx(p.txs[hash].tx))
+	}
+
+	return txs
+}
+
+// observe applies the mempool snapshot, taken when the node was at the given height.
+// The snapshot holds the oldest mempool transactions, in mempool (FIFO) order, and is
+// complete if it holds all of them. Transactions only move towards the front of the mempool,
+// so a transaction missing from a partial snapshot has left the mempool only if it was
+// previously seen within the snapshot length.
+// The transactions seen for the first time, or back in the mempool, are returned
+func (p *Pool) observe(txs []bft_types.Tx, complete bool, height int64, now time.Time) []*types.PendingTx {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var (
+		updated = make([]*types.PendingTx, 0)
+		seen    = make(map[string]struct{}, len(txs))
+	)
+
+	for position, tx := range txs {
+		hash := string(tx.Hash())
+		seen[hash] = struct{}{}
+
+		e, ok := p.txs[hash]
+		if !ok {
+			e = &entry{
+				tx: &types.PendingTx{
+					FirstSeen: now,
+					Status:    types.PendingTxPending,
+					Tx:        tx,
+					Hash:      []byte(hash),
+				},
+				position: position,
+			}
+
+			p.add(hash, e)
+			updated = append(updated, copyTx(e.tx))
+
+			continue
+		}
+
+		e.missingAt = 0
+		e.position = position
+
+		// The transaction was broadcast again
+		if e.tx.Status == types.PendingTxDropped {
+			e.tx.Status = types.PendingTxPending
+			updated = append(updated, copyTx(e.tx))
+		}
+	}
+
+	// Transactions that left the mempool are either included in a block
+	// up to the current node height, or dropped
+	for hash, e := range p.txs {
+		if _, ok := seen[hash]; ok {
+			continue
+		}
+
+		if !complete && e.position >= len(txs) {
+			// The transaction may be beyond the snapshot
+			continue
+		}
+
+		if e.tx.Status == types.PendingTxPending && e.missingAt == 0 {
+			e.missingAt = height
+		}
+	}
+
+	return updated
+}
+
+// applyBlock marks the transactions of the indexed block as included,
+// and the ones that left the mempool before it without being included as dropped.
+// The transactions that changed status are returned
+func (p *Pool) applyBlock(block *bft_types.Block) []*types.PendingTx {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	updated := make([]*types.PendingTx, 0)
+
+	for _, tx := range block.Txs {
+		e, ok := p.txs[string(tx.Hash())]
+		if !ok || e.tx.Status == types.PendingTxIncluded {
+			continue
+		}
+
+		e.tx.Status = types.PendingTxIncluded
+		e.tx.Height = block.Height
+		e.missingAt = 0
+
+		updated = append(updated, copyTx(e.tx))
+	}
+
+	for _, hash := range p.order {
+		e := p.txs[hash]
+
+		if e.tx.Status != types.PendingTxPending || e.missingAt == 0 || block.Height < e.missingAt {
+			continue
+		}
+
+		e.tx.Status = types.PendingTxDropped
+		e.missingAt = 0
+
+		updated = append(updated, copyTx(e.tx))
+	}
+
+	return updated
+}
+
+// add tracks the new transaction, evicting the oldest ones if the pool is full
+func (p *Pool) add(hash string, e *entry) {
+	for len(p.order) >= p.maxSize {
+		delete(p.txs, p.order[0])
+		p.order = p.order[1:]
+	}
+
+	p.txs[hash] = e
+	p.order = append(p.order, hash)
+}
+
+// copyTx copies the transaction state, so it can be shared outside the pool
+func copyTx(tx *types.PendingTx) *types.PendingTx {
+	c := *tx
+
+	return &c
+}
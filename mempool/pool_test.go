@@ -0,0 +1,164 @@
+package mempool
+
+import (
+	"testing"
+	"time"
+
+	bft_types "github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/tx-indexer/types"
+)
+
+// statuses returns the status of the given transactions, by hash
+func statuses(txs []*types.PendingTx) map[string]types.PendingTxStatus {
+	out := make(map[string]types.PendingTxStatus, len(txs))
+
+	for _, tx := range txs {
+		out[string(tx.Hash)] = tx.Status
+	}
+
+	return out
+}
+
+func TestPool_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now = time.Now()
+
+		included = bft_types.Tx("included")
+		dropped  = bft_types.Tx("dropped")
+		late     = bft_types.Tx("late")
+
+		pool = NewPool(10)
+	)
+
+	// All transactions are seen in the mempool
+	updated := pool.observe([]bft_types.Tx{included, dropped, late}, true, 10, now)
+	require.Len(t, updated, 3)
+
+	for _, tx := range updated {
+		assert.Equal(t, types.PendingTxPending, tx.Status)
+		assert.Equal(t, now, tx.FirstSeen)
+	}
+
+	// Already known transactions are not signaled again
+	assert.Empty(t, pool.observe([]bft_types.Tx{included, dropped, late}, true, 10, now))
+
+	// All transactions leave the mempool at height 12
+	assert.Empty(t, pool.observe(nil, true, 12, now))
+
+	// Block 11 includes the first transaction, the others may still be included
+	updated = pool.applyBlock(&bft_types.Block{
+		Header: bft_types.Header{Height: 11},
+		Data:   bft_types.Data{Txs: bft_types.Txs{included}},
+	})
+
+	assert.Equal(t, map[string]types.PendingTxStatus{
+		string(included.Hash()): types.PendingTxIncluded,
+	}, statuses(updated))
+
+	// Block 12 includes the last transaction, so the remaining one was dropped
+	updated = pool.applyBlock(&bft_types.Block{
+		Header: bft_types.Header{Height: 12},
+		Data:   bft_types.Data{Txs: bft_types.Txs{late}},
+	})
+
+	assert.Equal(t, map[string]types.PendingTxStatus{
+		string(late.Hash()):    types.PendingTxIncluded,
+		string(dropped.Hash()): types.PendingTxDropped,
+	}, statuses(updated))
+
+	list := pool.List()
+	require.Len(t, list, 3)
+
+	assert.Equal(t, int64(11), list[0].Height)
+	assert.Equal(t, int64(12), list[2].Height)
+
+	// The dropped transaction is broadcast again
+	updated = pool.observe([]bft_types.Tx{dropped}, true, 13, now)
+
+	assert.Equal(t, map[string]types.PendingTxStatus{
+		string(dropped.Hash()): types.PendingTxPending,
+	}, statuses(updated))
+}
+
+func TestPool_PartialSnapshot(t *testing.T) {
+	t.Parallel()
+
+	var (
+		tx   = bft_types.Tx("tx")
+		pool = NewPool(10)
+	)
+
+	pool.observe([]bft_types.Tx{tx}, true, 10, time.Now())
+
+	// The transaction may be beyond the returned ones
+	pool.observe(nil, false, 11, time.Now())
+
+	assert.Empty(t, pool.applyBlock(&bft_types.Block{
+		Header: bft_types.Header{Height: 20},
+	}))
+
+	assert.Equal(t, types.PendingTxPending, pool.List()[0].Status)
+}
+
+func TestPool_PartialSnapshot_LeftMempool(t *testing.T) {
+	t.Parallel()
+
+	var (
+		first  = bft_types.Tx("first")
+		second = bft_types.Tx("second")
+		third  = bft_types.Tx("third")
+		fourth = bft_types.Tx("fourth")
+
+		pool = NewPool(10)
+	)
+
+	// Only the 2 oldest transactions are returned
+	pool.observe([]bft_types.Tx{first, second}, false, 10, time.Now())
+
+	// The first transaction leaves the mempool, so the following ones move up
+	pool.observe([]bft_types.Tx{second, third}, false, 11, time.Now())
+
+	// Make sure the transaction that left the snapshot is dropped,
+	// since it would have been within the snapshot otherwise
+	updated := pool.applyBlock(&bft_types.Block{
+		Header: bft_types.Header{Height: 11},
+	})
+
+	assert.Equal(t, map[string]types.PendingTxStatus{
+		string(first.Hash()): types.PendingTxDropped,
+	}, statuses(updated))
+
+	// Make sure the transactions seen past a shorter snapshot are kept pending
+	pool.observe([]bft_types.Tx{fourth}, false, 12, time.Now())
+
+	updated = pool.applyBlock(&bft_types.Block{
+		Header: bft_types.Header{Height: 12},
+	})
+
+	assert.Equal(t, map[string]types.PendingTxStatus{
+		string(second.Hash()): types.PendingTxDropped,
+	}, statuses(updated))
+
+	assert.Equal(t, types.PendingTxPending, pool.List()[2].Status)
+}
+
+func TestPool_Eviction(t *testing.T) {
+	t.Parallel()
+
+	pool := NewPool(2)
+
+	pool.observe([]bft_types.Tx{bft_types.Tx("a"), bft_types.Tx("b")}, true, 1, time.Now())
+	pool.observe([]bft_types.Tx{bft_types.Tx("b"), bft_types.Tx("c")}, true, 2, time.Now())
+
+	list := pool.List()
+	require.Len(t, list, 2)
+
+	// The oldest transaction is evicted first
+	assert.Equal(t, bft_types.Tx("b").Hash(), list[0].Hash)
+	assert.Equal(t, bft_types.Tx("c").Hash(), list[1].Hash)
+}
@@ -0,0 +1,31 @@
+package mempool
+
+import (
+	"context"
+
+	core_types "github.com/gnolang/gno/tm2/pkg/bft/rpc/core/types"
+
+	"github.com/gnolang/tx-indexer/events"
+)
+
+// Client is the node client the mempool is polled with
+type Client interface {
+	// GetLatestBlockNumber returns the latest block height from the chain
+	GetLatestBlockNumber(context.Context) (uint64, error)
+
+	// GetUnconfirmedTxs returns up to the given number of transactions in the node mempool
+	GetUnconfirmedTxs(context.Context, int) (*core_types.ResultUnconfirmedTxs, error)
+}
+
+// Events is the events API the indexed blocks are received with,
+// and the pending transaction updates are signaled with
+type Events interface {
+	// Subscribe registers a new listener for events
+	Subscribe([]events.Type) *events.Subscription
+
+	// CancelSubscription stops a subscription for events
+	CancelSubscription(events.SubscriptionID)
+
+	// SignalEvent signals a new event to the event manager
+	SignalEvent(events.Event)
+}
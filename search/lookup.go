@@ -0,0 +1,158 @@
+package search
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	bft_types "github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+
+	"github.com/gnolang/tx-indexer/storage"
+	storageErrors "github.com/gnolang/tx-indexer/storage/errors"
+	"github.com/gnolang/tx-indexer/types"
+)
+
+const (
+	// maxLookupBlockScan is the maximum number of most recent blocks
+	// scanned when looking up a block hash, since blocks are not indexed by hash
+	maxLookupBlockScan = 1_000
+
+	// defaultPackageDomain is the domain prepended to package paths
+	// that are looked up without one (ex. `r/demo/users`)
+	defaultPackageDomain = "gno.land/"
+)
+
+var errEmptyTerm = errors.New("empty lookup term")
+
+// LookupStorage is the storage needed to resolve lookup terms
+type LookupStorage interface {
+	// GetBlock fetches the block by its number
+	GetBlock(uint64) (*bft_types.Block, error)
+
+	// BlockReverseIterator iterates over Blocks in reverse order,
+	// limiting the results to be between the provided block numbers
+	BlockReverseIterator(fromBlockNum, toBlockNum uint64) (storage.Iterator[*bft_types.Block], error)
+
+	// GetTxByHash fetches the tx using the transaction hash
+	GetTxByHash(txHash string) (*bft_types.TxResult, error)
+
+	// GetAccount fetches the activity summary of the given address
+	GetAccount(address string) (*types.Account, error)
+
+	// GetPackage fetches the package deployed at the given path
+	GetPackage(path string) (*types.Package, error)
+}
+
+// Match is a single lookup candidate.
+// Exactly one of its fields is set
+type Match struct {
+	Block   *bft_types.Block
+	Tx      *bft_types.TxResult
+	Account *types.Account
+	Package *types.Package
+}
+
+// Lookup classifies the term (block height, block or transaction hash,
+// bech32 address or package path), and returns all the matching candidates,
+// as the same term can be ambiguous. Hashes can be base64 (standard or URL-safe) or hex encoded.
+// Block hashes are only matched against the most recent blocks
+func Lookup(store LookupStorage, term string) ([]*Match, error) {
+	term = strings.TrimSpace(term)
+	if term == "" {
+		return nil, errEmptyTerm
+	}
+
+	matches := make([]*Match, 0)
+
+	// Block height
+	if height, err := strconv.ParseUint(term, 10, 64); err == nil {
+		block, err := store.GetBlock(height)
+		if err != nil && !errors.Is(err, storageErrors.ErrNotFound) {
+			return nil, fmt.Errorf("unable to fetch block %d, %w", height, err)
+		}
+
+		if block != nil {
+			matches = append(matches, &Match{Block: block})
+		}
+	}
+
+	// Block or transaction hash
+	if hash, err := types.DecodeHash(term); err == nil {
+		tx, err := store.GetTxByHash(base64.StdEncoding.EncodeToString(hash))
+		if err != nil && !errors.Is(err, storageErrors.ErrNotFound) {
+			return nil, fmt.Errorf("unable to fetch transaction, %w", err)
+		}
+
+		if tx != nil {
+			matches = append(matches, &Match{Tx: tx})
+		}
+
+		block, err := findBlockByHash(store, hash)
+		if err != nil {
+			return nil, err
+		}
+
+		if block != nil {
+			matches = append(matches, &Match{Block: block})
+		}
+	}
+
+	// Account address
+	if _, err := crypto.AddressFromBech32(term); err == nil {
+		account, err := store.GetAccount(term)
+		if err != nil && !errors.Is(err, storageErrors.ErrNotFound) {
+			return nil, fmt.Errorf("unable to fetch account %s, %w", term, err)
+		}
+
+		if account != nil {
+			matches = append(matches, &Match{Account: account})
+		}
+	}
+
+	// Package path
+	if strings.Contains(term, "/") {
+		paths := []string{term}
+		if !strings.HasPrefix(term, defaultPackageDomain) {
+			paths = append(paths, defaultPackageDomain+strings.TrimPrefix(term, "/"))
+		}
+
+		for _, path := range paths {
+			pkg, err := store.GetPackage(path)
+			if err != nil && !errors.Is(err, storageErrors.ErrNotFound) {
+				return nil, fmt.Errorf("unable to fetch package %s, %w", path, err)
+			}
+
+			if pkg != nil {
+				matches = append(matches, &Match{Package: pkg})
+			}
+		}
+	}
+
+	return matches, nil
+}
+
+// findBlockByHash scans the most recent blocks for the one with the given hash, if any
+func findBlockByHash(store LookupStorage, hash []byte) (*bft_types.Block, error) {
+	it, err := store.BlockReverseIterator(0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("unable to iterate blocks, %w", err)
+	}
+	defer it.Close()
+
+	for scanned := 0; scanned < maxLookupBlockScan && it.Next(); scanned++ {
+		block, err := it.Value()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read block, %w", err)
+		}
+
+		if block.HashesTo(hash) {
+			return block, nil
+		}
+	}
+
+	//nolint:nilnil // The block is not within the scanned range
+	return nil, it.Error()
+}
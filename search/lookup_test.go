@@ -0,0 +1,159 @@
+package search
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/tx-indexer/storage"
+	indexerTypes "github.com/gnolang/tx-indexer/types"
+)
+
+func TestLookup(t *testing.T) {
+	t.Parallel()
+
+	var (
+		block = &types.Block{
+			Header: types.Header{
+				Height:         5,
+				ChainID:        "dev",
+				ValidatorsHash: []byte("validators"),
+			},
+			LastCommit: &types.Commit{
+				BlockID: types.BlockID{
+					Hash: []byte("previous block"),
+				},
+			},
+		}
+		tx = &types.TxResult{
+			Height: 5,
+			Tx:     []byte("tx"),
+		}
+		account = &indexerTypes.Account{
+			Address: crypto.AddressFromPreimage([]byte("account")).String(),
+		}
+		pkg = &indexerTypes.Package{
+			Path: "gno.land/r/demo/users",
+		}
+	)
+
+	s, err := storage.NewPebble(t.TempDir())
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, s.Close())
+	})
+
+	b := s.WriteBatch()
+
+	require.NoError(t, b.SetBlock(block))
+	require.NoError(t, b.SetTx(tx))
+	require.NoError(t, b.SetAccount(account))
+	require.NoError(t, b.SetPackage(pkg))
+
+	require.NoError(t, b.Commit())
+
+	// Reload the block, so the hash is computed from the stored data
+	storedBlock, err := s.GetBlock(5)
+	require.NoError(t, err)
+
+	var (
+		blockHash = storedBlock.Hash()
+		txHash    = tx.Tx.Hash()
+	)
+
+	require.NotEmpty(t, blockHash)
+
+	testTable := []struct {
+		name     string
+		term     string
+		expected []*Match
+	}{
+		{
+			"block height",
+			"5",
+			[]*Match{{Block: storedBlock}},
+		},
+		{
+			"unknown block height",
+			"42",
+			[]*Match{},
+		},
+		{
+			"tx hash, base64",
+			base64.StdEncoding.EncodeToString(txHash),
+			[]*Match{{Tx: tx}},
+		},
+		{
+			"tx hash, URL-safe base64",
+			base64.URLEncoding.EncodeToString(txHash),
+			[]*Match{{Tx: tx}},
+		},
+		{
+			"tx hash, hex",
+			hex.EncodeToString(txHash),
+			[]*Match{{Tx: tx}},
+		},
+		{
+			"block hash",
+			hex.EncodeToString(blockHash),
+			[]*Match{{Block: storedBlock}},
+		},
+		{
+			"address",
+			account.Address,
+			[]*Match{{Account: account}},
+		},
+		{
+			"package path",
+			pkg.Path,
+			[]*Match{{Package: pkg}},
+		},
+		{
+			"package path without domain",
+			"r/demo/users",
+			[]*Match{{Package: pkg}},
+		},
+		{
+			"unknown term",
+			"unknown",
+			[]*Match{},
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			matches, err := Lookup(s, testCase.term)
+			require.NoError(t, err)
+
+			require.Len(t, matches, len(testCase.expected))
+
+			for i, match := range matches {
+				expected := testCase.expected[i]
+
+				if expected.Block != nil {
+					require.NotNil(t, match.Block)
+					assert.Equal(t, expected.Block.Height, match.Block.Height)
+
+					continue
+				}
+
+				assert.Equal(t, expected, match)
+			}
+		})
+	}
+
+	t.Run("empty term", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Lookup(s, "  ")
+		assert.ErrorIs(t, err, errEmptyTerm)
+	})
+}
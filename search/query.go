@@ -0,0 +1,96 @@
+package search
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// maxQueryClauses is the maximum number of clauses in a single query
+const maxQueryClauses = 10
+
+var (
+	errEmptyQuery         = errors.New("empty search query")
+	errUnterminatedPhrase = errors.New("unterminated phrase in search query")
+)
+
+// Clause is a single query condition, matching
+// documents containing all of its terms in sequence
+type Clause struct {
+	Terms  []string // the consecutive terms to match
+	Prefix bool     // flag indicating if the last term is matched as a prefix
+}
+
+// Query is a parsed search query.
+// Documents need to match all the clauses
+type Query struct {
+	Clauses []Clause
+}
+
+// ParseQuery parses the raw search query. The syntax is:
+//   - `word` matches documents containing the word
+//   - `word*` matches documents containing a word starting with `word`
+//   - `"some phrase"` matches documents containing the words in sequence
+//   - `"some phr*"` same as above, with the last word matched as a prefix
+//
+// Words that are split by the tokenizer (ex. `gno.land`) are matched as phrases
+func ParseQuery(raw string) (*Query, error) {
+	var (
+		query = &Query{}
+		rest  = strings.TrimSpace(raw)
+	)
+
+	for rest != "" {
+		var segment string
+
+		if strings.HasPrefix(rest, `"`) {
+			end := strings.Index(rest[1:], `"`)
+			if end == -1 {
+				return nil, errUnterminatedPhrase
+			}
+
+			segment, rest = rest[1:end+1], rest[end+2:]
+		} else {
+			end := strings.IndexAny(rest, " \t\n\"")
+			if end == -1 {
+				end = len(rest)
+			}
+
+			segment, rest = rest[:end], rest[end:]
+		}
+
+		rest = strings.TrimSpace(rest)
+
+		if clause, ok := parseClause(segment); ok {
+			query.Clauses = append(query.Clauses, clause)
+		}
+	}
+
+	if len(query.Clauses) == 0 {
+		return nil, errEmptyQuery
+	}
+
+	if len(query.Clauses) > maxQueryClauses {
+		return nil, fmt.Errorf("too many search terms, max %d", maxQueryClauses)
+	}
+
+	return query, nil
+}
+
+// parseClause parses a single word or phrase into a clause
+func parseClause(segment string) (Clause, bool) {
+	segment = strings.TrimSpace(segment)
+
+	prefix := strings.HasSuffix(segment, "*")
+	segment = strings.TrimRight(segment, "*")
+
+	terms := Tokenize(segment)
+	if len(terms) == 0 {
+		return Clause{}, false
+	}
+
+	return Clause{
+		Terms:  terms,
+		Prefix: prefix,
+	}, true
+}
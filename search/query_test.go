@@ -0,0 +1,107 @@
+package search
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQuery(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name     string
+		query    string
+		expected []Clause
+	}{
+		{
+			"single word",
+			"Render",
+			[]Clause{
+				{Terms: []string{"render"}},
+			},
+		},
+		{
+			"multiple words",
+			"avl  tree",
+			[]Clause{
+				{Terms: []string{"avl"}},
+				{Terms: []string{"tree"}},
+			},
+		},
+		{
+			"prefix",
+			"rend*",
+			[]Clause{
+				{Terms: []string{"rend"}, Prefix: true},
+			},
+		},
+		{
+			"phrase",
+			`"func Render" avl`,
+			[]Clause{
+				{Terms: []string{"func", "render"}},
+				{Terms: []string{"avl"}},
+			},
+		},
+		{
+			"phrase prefix",
+			`"func Rend*"`,
+			[]Clause{
+				{Terms: []string{"func", "rend"}, Prefix: true},
+			},
+		},
+		{
+			"split word",
+			"gno.land/p/demo",
+			[]Clause{
+				{Terms: []string{"gno", "land", "demo"}},
+			},
+		},
+		{
+			"ignored words",
+			`a "" avl`,
+			[]Clause{
+				{Terms: []string{"avl"}},
+			},
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			query, err := ParseQuery(testCase.query)
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.expected, query.Clauses)
+		})
+	}
+}
+
+func TestParseQuery_Invalid(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty query", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseQuery("  a ")
+		assert.ErrorIs(t, err, errEmptyQuery)
+	})
+
+	t.Run("unterminated phrase", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseQuery(`"func Render`)
+		assert.ErrorIs(t, err, errUnterminatedPhrase)
+	})
+
+	t.Run("too many clauses", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseQuery(strings.Repeat("avl ", maxQueryClauses+1))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,229 @@
+package search
+
+import (
+	"math"
+	"slices"
+	"sort"
+
+	"github.com/gnolang/tx-indexer/storage"
+	"github.com/gnolang/tx-indexer/types"
+)
+
+const (
+	// maxTermPostings is the maximum number of postings read for a single query term.
+	// Results of queries containing terms that exceed it are incomplete
+	maxTermPostings = 10_000
+
+	// saturation controls how fast repeated occurrences
+	// of a clause stop increasing the document score
+	saturation = 1.2
+)
+
+// Result is a single ranked search match
+type Result struct {
+	Document *types.SearchDocument
+	Score    float64
+}
+
+// clauseMatches are the documents matching a single clause,
+// with the number of clause occurrences in each of them
+type clauseMatches map[string]int
+
+// Search runs the query against the full-text index, and returns the best ranked
+// documents of the given kinds (all kinds, if none are given), up until the limit.
+// The returned flag indicates if the results are incomplete, because
+// some query terms are too common to be fully scanned.
+//
+// Documents need to match all the query clauses. Their score grows with the number of
+// clause occurrences (saturating), and rare clauses weigh more than common ones
+func Search(
+	store storage.Reader,
+	query *Query,
+	kinds []types.SearchKind,
+	limit int,
+) ([]*Result, bool, error) {
+	var (
+		scores     map[string]float64
+		incomplete bool
+	)
+
+	for _, clause := range query.Clauses {
+		matches, truncated, err := matchClause(store, clause, kinds)
+		if err != nil {
+			return nil, false, err
+		}
+
+		incomplete = incomplete || truncated
+
+		// Rare clauses weigh more
+		weight := 1 / math.Log(math.E+float64(len(matches)))
+
+		next := make(map[string]float64, len(matches))
+
+		for id, occurrences := range matches {
+			score := weight * float64(occurrences) / (float64(occurrences) + saturation)
+
+			if scores == nil {
+				next[id] = score
+
+				continue
+			}
+
+			if prev, ok := scores[id]; ok {
+				next[id] = prev + score
+			}
+		}
+
+		scores = next
+
+		if len(scores) == 0 {
+			break
+		}
+	}
+
+	ids := make([]string, 0, len(scores))
+	for id := range scores {
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		if scores[ids[i]] != scores[ids[j]] {
+			return scores[ids[i]] > scores[ids[j]]
+		}
+
+		return ids[i] < ids[j]
+	})
+
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+
+	results := make([]*Result, 0, len(ids))
+
+	for _, id := range ids {
+		doc, err := store.GetSearchDocument(id)
+		if err != nil {
+			return nil, false, err
+		}
+
+		results = append(results, &Result{
+			Document: doc,
+			Score:    scores[id],
+		})
+	}
+
+	return results, incomplete, nil
+}
+
+// matchClause finds the documents containing all the clause terms in sequence
+func matchClause(
+	store storage.Reader,
+	clause Clause,
+	kinds []types.SearchKind,
+) (clauseMatches, bool, error) {
+	var (
+		lists      = make([]map[string][]uint32, 0, len(clause.Terms))
+		incomplete bool
+	)
+
+	for i, term := range clause.Terms {
+		prefix := clause.Prefix && i == len(clause.Terms)-1
+
+		postings, truncated, err := termPostings(store, term, prefix, kinds)
+		if err != nil {
+			return nil, false, err
+		}
+
+		incomplete = incomplete || truncated
+
+		lists = append(lists, postings)
+	}
+
+	matches := make(clauseMatches)
+
+	for id, positions := range lists[0] {
+		occurrences := 0
+
+		for _, position := range positions {
+			if inSequence(lists, id, position) {
+				occurrences++
+			}
+		}
+
+		if occurrences > 0 {
+			matches[id] = occurrences
+		}
+	}
+
+	return matches, incomplete, nil
+}
+
+// inSequence checks if all the terms appear in sequence
+// within the document, starting from the given position
+func inSequence(lists []map[string][]uint32, id string, position uint32) bool {
+	for offset, list := range lists[1:] {
+		positions, ok := list[id]
+		if !ok {
+			return false
+		}
+
+		if _, found := slices.BinarySearch(positions, position+uint32(offset)+1); !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// termPostings reads the sorted term positions within each document of the given kinds.
+// If prefix is set, the positions of all the terms starting with the given term are merged
+func termPostings(
+	store storage.Reader,
+	term string,
+	prefix bool,
+	kinds []types.SearchKind,
+) (map[string][]uint32, bool, error) {
+	it, err := store.SearchPostingIterator(term, prefix)
+	if err != nil {
+		return nil, false, err
+	}
+	defer it.Close()
+
+	var (
+		postings = make(map[string][]uint32)
+		read     = 0
+	)
+
+	for it.Next() {
+		if read == maxTermPostings {
+			return postings, true, nil
+		}
+
+		read++
+
+		posting, err := it.Value()
+		if err != nil {
+			return nil, false, err
+		}
+
+		if len(kinds) > 0 && !slices.Contains(kinds, posting.Kind) {
+			continue
+		}
+
+		postings[posting.DocumentID] = append(postings[posting.DocumentID], posting.Positions...)
+	}
+
+	if err := it.Error(); err != nil {
+		return nil, false, err
+	}
+
+	if prefix {
+		for id, positions := range postings {
+			slices.Sort(positions)
+
+			postings[id] = positions
+		}
+	}
+
+	return postings, false, nil
+}
@@ -0,0 +1,150 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/tx-indexer/storage"
+	"github.com/gnolang/tx-indexer/types"
+)
+
+// newTestStorage creates a new storage containing the given documents
+func newTestStorage(t *testing.T, docs map[*types.SearchDocument]string) storage.Storage {
+	t.Helper()
+
+	s, err := storage.NewPebble(t.TempDir())
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, s.Close())
+	})
+
+	b := s.WriteBatch()
+
+	for doc, text := range docs {
+		terms, length := Terms(text)
+		doc.Length = length
+
+		require.NoError(t, b.SetSearchDocument(doc, terms))
+	}
+
+	require.NoError(t, b.Commit())
+
+	return s
+}
+
+func TestSearch(t *testing.T) {
+	t.Parallel()
+
+	var (
+		avlFile = &types.SearchDocument{
+			Kind:        types.SearchKindPackageFile,
+			PackagePath: "gno.land/p/demo/avl",
+			FileName:    "tree.gno",
+			Height:      1,
+		}
+		usersFile = &types.SearchDocument{
+			Kind:        types.SearchKindPackageFile,
+			PackagePath: "gno.land/r/demo/users",
+			FileName:    "users.gno",
+			Height:      2,
+		}
+		memo = &types.SearchDocument{
+			Kind:   types.SearchKindMemo,
+			Height: 3,
+		}
+
+		s = newTestStorage(t, map[*types.SearchDocument]string{
+			avlFile:   "package avl\n\nfunc NewTree() *Tree { return &Tree{} }",
+			usersFile: "package users\n\nimport \"gno.land/p/demo/avl\"\n\nfunc Render(path string) string {}",
+			memo:      "registering users on the avl tree, avl rocks",
+		})
+	)
+
+	search := func(raw string, kinds ...types.SearchKind) []*types.SearchDocument {
+		t.Helper()
+
+		query, err := ParseQuery(raw)
+		require.NoError(t, err)
+
+		results, incomplete, err := Search(s, query, kinds, 10)
+		require.NoError(t, err)
+		require.False(t, incomplete)
+
+		docs := make([]*types.SearchDocument, 0, len(results))
+		for _, result := range results {
+			docs = append(docs, result.Document)
+		}
+
+		return docs
+	}
+
+	t.Run("single term, ranked", func(t *testing.T) {
+		t.Parallel()
+
+		// The memo contains the term twice, so it ranks first
+		docs := search("avl")
+		require.Len(t, docs, 3)
+
+		assert.Equal(t, memo.ID(), docs[0].ID())
+	})
+
+	t.Run("all clauses match", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, []*types.SearchDocument{usersFile}, search("avl render"))
+	})
+
+	t.Run("phrase", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, []*types.SearchDocument{memo}, search(`"avl tree"`))
+		assert.Empty(t, search(`"rocks avl"`))
+	})
+
+	t.Run("prefix", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, []*types.SearchDocument{avlFile}, search("newtr*"))
+		assert.Len(t, search("regist* user*"), 1)
+	})
+
+	t.Run("kinds", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, []*types.SearchDocument{memo}, search("avl", types.SearchKindMemo))
+		assert.Len(t, search("avl", types.SearchKindPackageFile), 2)
+		assert.Empty(t, search("avl", types.SearchKindEvent))
+	})
+
+	t.Run("no matches", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, search("unknown"))
+	})
+}
+
+func TestSearch_Limit(t *testing.T) {
+	t.Parallel()
+
+	docs := make(map[*types.SearchDocument]string)
+
+	for i := range 5 {
+		docs[&types.SearchDocument{
+			Kind:   types.SearchKindMemo,
+			Height: int64(i),
+		}] = "hello world"
+	}
+
+	s := newTestStorage(t, docs)
+
+	query, err := ParseQuery("hello")
+	require.NoError(t, err)
+
+	results, _, err := Search(s, query, nil, 3)
+	require.NoError(t, err)
+
+	assert.Len(t, results, 3)
+}
@@ -0,0 +1,51 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// minTermLength is the minimum length (in runes) of an indexed term
+	minTermLength = 2
+
+	// maxTermLength is the maximum length (in bytes) of an indexed term.
+	// Longer terms (ex. encoded blobs) are not indexed
+	maxTermLength = 64
+)
+
+// Tokenize splits the text into lowercase terms, on any character
+// that is not a letter or a digit. Terms that are too short or too long are dropped
+func Tokenize(text string) []string {
+	terms := make([]string, 0)
+
+	for _, field := range strings.FieldsFunc(text, isSeparator) {
+		if utf8.RuneCountInString(field) < minTermLength || len(field) > maxTermLength {
+			continue
+		}
+
+		terms = append(terms, strings.ToLower(field))
+	}
+
+	return terms
+}
+
+// Terms tokenizes the text, and returns the positions of each term within it,
+// along with the total number of terms
+func Terms(text string) (map[string][]uint32, uint32) {
+	var (
+		tokens = Tokenize(text)
+		terms  = make(map[string][]uint32)
+	)
+
+	for position, term := range tokens {
+		terms[term] = append(terms[term], uint32(position))
+	}
+
+	return terms, uint32(len(tokens))
+}
+
+func isSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
@@ -0,0 +1,66 @@
+package search
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenize(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name     string
+		text     string
+		expected []string
+	}{
+		{
+			"empty text",
+			"",
+			[]string{},
+		},
+		{
+			"source code",
+			`import "gno.land/p/demo/avl"`,
+			[]string{"import", "gno", "land", "demo", "avl"},
+		},
+		{
+			"mixed case",
+			"Hello World",
+			[]string{"hello", "world"},
+		},
+		{
+			"unicode",
+			"Ünïcode tëxt 42",
+			[]string{"ünïcode", "tëxt", "42"},
+		},
+		{
+			"long terms",
+			"short " + strings.Repeat("a", maxTermLength+1),
+			[]string{"short"},
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testCase.expected, Tokenize(testCase.text))
+		})
+	}
+}
+
+func TestTerms(t *testing.T) {
+	t.Parallel()
+
+	terms, length := Terms("the avl tree, the AVL way")
+
+	assert.Equal(t, uint32(6), length)
+	assert.Equal(t, map[string][]uint32{
+		"the":  {0, 3},
+		"avl":  {1, 4},
+		"tree": {2},
+		"way":  {5},
+	}, terms)
+}
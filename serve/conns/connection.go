@@ -23,3 +23,13 @@ type WSConnection interface {
 	// Returns an error if the write failed (ex. connection closed)
 	WriteData(data any) error
 }
+
+// EventConnection is a connection that tags the pushed data
+// with the ID of the chain event it carries, so clients can resume from it
+type EventConnection interface {
+	WSConnection
+
+	// WriteEvent pushes out data tagged with the given event ID.
+	// Returns an error if the write failed (ex. connection closed)
+	WriteEvent(eventID string, data any) error
+}
@@ -8,17 +8,26 @@ import (
 // baseFilter defines the common properties
 // for all filter types
 type baseFilter struct {
-	lastUsed   time.Time
+	lastUsed time.Time
+	storage  Storage
+
 	filterType Type
+	maxChanges int
 
 	sync.RWMutex
 }
 
-func newBaseFilter(filterType Type) *baseFilter {
-	return &baseFilter{
+func newBaseFilter(filterType Type, opts ...Option) *baseFilter {
+	f := &baseFilter{
 		filterType: filterType,
 		lastUsed:   time.Now(),
 	}
+
+	for _, opt := range opts {
+		opt(f)
+	}
+
+	return f
 }
 
 func (b *baseFilter) GetType() Type {
@@ -39,6 +48,6 @@ func (b *baseFilter) UpdateLastUsed() {
 	b.lastUsed = time.Now()
 }
 
-func (b *baseFilter) GetChanges() []any {
-	return nil
+func (b *baseFilter) GetChanges() ([]any, error) {
+	return nil, nil
 }
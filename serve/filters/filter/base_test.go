@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestBaseFilter_GetType(t *testing.T) {
@@ -26,7 +27,10 @@ func TestBaseFilter_GetType(t *testing.T) {
 			f := newBaseFilter(testCase.filterType)
 
 			assert.Equal(t, testCase.filterType, f.GetType())
-			assert.Nil(t, f.GetChanges())
+			changes, err := f.GetChanges()
+			require.NoError(t, err)
+
+			assert.Nil(t, changes)
 		})
 	}
 }
@@ -8,19 +8,21 @@ import (
 type BlockFilter struct {
 	*baseFilter
 
-	blockHeaders []types.Header
+	blockHeaders *changeBuffer[types.Header]
 }
 
 // NewBlockFilter creates new block filter object
-func NewBlockFilter() *BlockFilter {
+func NewBlockFilter(opts ...Option) *BlockFilter {
+	base := newBaseFilter(BlockFilterType, opts...)
+
 	return &BlockFilter{
-		baseFilter:   newBaseFilter(BlockFilterType),
-		blockHeaders: make([]types.Header, 0),
+		baseFilter:   base,
+		blockHeaders: newChangeBuffer[types.Header](base.maxChanges),
 	}
 }
 
 // GetChanges returns all new block headers from the last query
-func (b *BlockFilter) GetChanges() []any {
+func (b *BlockFilter) GetChanges() ([]any, error) {
 	return b.getBlockChanges()
 }
 
@@ -31,29 +33,57 @@ func (b *BlockFilter) UpdateWith(data any) {
 }
 
 // getBlockChanges returns all new block headers from the last query
-func (b *BlockFilter) getBlockChanges() []any {
+func (b *BlockFilter) getBlockChanges() ([]any, error) {
 	b.Lock()
 	defer b.Unlock()
 
+	blockHeaders, err := b.blockHeaders.drain(b.recoverBlockHeaders)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get hashes
-	hashes := make([]any, len(b.blockHeaders))
-	for index, blockHeader := range b.blockHeaders {
+	hashes := make([]any, len(blockHeaders))
+	for index, blockHeader := range blockHeaders {
 		hashes[index] = blockHeader
 	}
 
-	// Empty headers
-	b.blockHeaders = b.blockHeaders[:0]
-
-	return hashes
+	return hashes, nil
 }
 
 func (b *BlockFilter) updateWithBlock(block *types.Block) {
 	b.Lock()
 	defer b.Unlock()
 
-	// Add header into block header array
-	b.blockHeaders = append(
-		b.blockHeaders,
+	// Add header into block header buffer
+	b.blockHeaders.add(
 		block.Header,
+		position{height: uint64(block.Height)},
 	)
 }
+
+// recoverBlockHeaders recovers the dropped block headers from storage
+func (b *BlockFilter) recoverBlockHeaders(
+	from,
+	to position,
+	collect func(types.Header, position) bool,
+) error {
+	it, err := b.storage.BlockIterator(from.height+1, to.height)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for it.Next() {
+		block, err := it.Value()
+		if err != nil {
+			return err
+		}
+
+		if !collect(block.Header, position{height: uint64(block.Height)}) {
+			break
+		}
+	}
+
+	return it.Error()
+}
@@ -42,7 +42,8 @@ func TestBlockFilter_GetChanges(t *testing.T) {
 	}
 
 	// Get changes
-	changes := f.GetChanges()
+	changes, err := f.GetChanges()
+	require.NoError(t, err)
 
 	// Make sure the headers match
 	require.Len(t, changes, len(blocks))
@@ -0,0 +1,157 @@
+package filter
+
+import (
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+)
+
+// position is the chain position of a single filter change
+type position struct {
+	height     uint64
+	index      uint32
+	eventIndex uint32
+}
+
+// txPosition returns the position of the transaction
+func txPosition(tx *types.TxResult) position {
+	return position{
+		height: uint64(tx.Height),
+		index:  tx.Index,
+	}
+}
+
+// after returns a flag indicating if the position is past the given one
+func (p position) after(other position) bool {
+	if p.height != other.height {
+		return p.height > other.height
+	}
+
+	if p.index != other.index {
+		return p.index > other.index
+	}
+
+	return p.eventIndex > other.eventIndex
+}
+
+// recoverFn recovers the changes past the from position,
+// up to the to position (inclusive), from storage.
+// The recovery stops once the collect callback returns false
+type recoverFn[T any] func(from, to position, collect func(T, position) bool) error
+
+// changeBuffer buffers the filter changes between polls.
+// Once the buffer is full, the following changes are dropped,
+// keeping only the position of the last one, so the dropped
+// changes are recovered from storage when the buffer is drained
+type changeBuffer[T any] struct {
+	// gapEnd is the position of the last dropped change, if any
+	gapEnd *position
+
+	items []T
+
+	// last is the position of the last buffered or recovered change
+	last position
+
+	// maxItems is the maximum amount of buffered changes,
+	// unbounded if 0
+	maxItems int
+}
+
+// newChangeBuffer creates a new change buffer
+func newChangeBuffer[T any](maxItems int) *changeBuffer[T] {
+	return &changeBuffer[T]{
+		items:    make([]T, 0),
+		maxItems: maxItems,
+	}
+}
+
+// add buffers the change at the given position,
+// or drops it if the buffer is full
+func (b *changeBuffer[T]) add(item T, pos position) {
+	if b.gapEnd != nil || (b.maxItems > 0 && len(b.items) >= b.maxItems) {
+		b.gapEnd = &pos
+
+		return
+	}
+
+	b.items = append(b.items, item)
+	b.last = pos
+}
+
+// drain returns the buffered changes, followed by the dropped ones recovered from storage.
+// At most maxItems changes are returned, so any remaining dropped changes are recovered
+// on the next drain. The dropped changes that are not in storage yet are recovered later as well
+func (b *changeBuffer[T]) drain(recoverChanges recoverFn[T]) ([]T, error) {
+	if b.gapEnd == nil {
+		changes := b.items
+		b.items = make([]T, 0)
+
+		return changes, nil
+	}
+
+	var (
+		recovered = make([]T, 0)
+		last      = b.last
+	)
+
+	err := recoverChanges(b.last, *b.gapEnd, func(item T, pos position) bool {
+		if b.maxItems > 0 && len(b.items)+len(recovered) >= b.maxItems {
+			return false
+		}
+
+		recovered = append(recovered, item)
+		last = pos
+
+		return true
+	})
+	if err != nil {
+		// The buffer is kept as is, so the changes are not lost
+		return nil, err
+	}
+
+	changes := append(b.items, recovered...)
+
+	b.items = make([]T, 0)
+	b.last = last
+
+	// Check if the gap is closed
+	if !b.gapEnd.after(last) {
+		b.gapEnd = nil
+	}
+
+	return changes, nil
+}
+
+// iterateTxs iterates over the stored transactions between the from and to positions
+// (inclusive), ignoring the event indexes. The iteration stops once the callback returns false
+func iterateTxs(s Storage, from, to position, fn func(*types.TxResult) bool) error {
+	var (
+		fromTx = position{height: from.height, index: from.index}
+		toTx   = position{height: to.height, index: to.index}
+	)
+
+	// The index bounds of the iterator apply to every block,
+	// so the transactions outside the range are skipped manually
+	it, err := s.TxIterator(from.height, to.height, 0, 0)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for it.Next() {
+		tx, err := it.Value()
+		if err != nil {
+			return err
+		}
+
+		pos := txPosition(tx)
+
+		if fromTx.after(pos) {
+			continue
+		}
+
+		if pos.after(toTx) || !fn(tx) {
+			break
+		}
+	}
+
+	return it.Error()
+}
@@ -0,0 +1,220 @@
+package filter
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recoverHeights recovers the heights past the from position from the stored ones
+func recoverHeights(stored []uint64) recoverFn[uint64] {
+	return func(from, to position, collect func(uint64, position) bool) error {
+		for _, height := range stored {
+			pos := position{height: height}
+
+			if !pos.after(from) {
+				continue
+			}
+
+			if pos.after(to) || !collect(height, pos) {
+				break
+			}
+		}
+
+		return nil
+	}
+}
+
+func TestChangeBuffer_Drain(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unbounded buffer", func(t *testing.T) {
+		t.Parallel()
+
+		b := newChangeBuffer[uint64](0)
+
+		for height := uint64(1); height <= 5; height++ {
+			b.add(height, position{height: height})
+		}
+
+		changes, err := b.drain(nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, []uint64{1, 2, 3, 4, 5}, changes)
+	})
+
+	t.Run("overflow recovered from storage", func(t *testing.T) {
+		t.Parallel()
+
+		stored := []uint64{1, 2, 3, 4, 5, 6, 7}
+
+		b := newChangeBuffer[uint64](3)
+
+		for _, height := range stored {
+			b.add(height, position{height: height})
+		}
+
+		// Make sure at most 3 changes are returned per drain
+		changes, err := b.drain(recoverHeights(stored))
+		require.NoError(t, err)
+
+		assert.Equal(t, []uint64{1, 2, 3}, changes)
+
+		changes, err = b.drain(recoverHeights(stored))
+		require.NoError(t, err)
+
+		assert.Equal(t, []uint64{4, 5, 6}, changes)
+
+		// Make sure new changes extend the open gap
+		stored = append(stored, 8)
+		b.add(8, position{height: 8})
+
+		changes, err = b.drain(recoverHeights(stored))
+		require.NoError(t, err)
+
+		assert.Equal(t, []uint64{7, 8}, changes)
+
+		// Make sure the changes are buffered again once the gap is closed
+		b.add(9, position{height: 9})
+
+		changes, err = b.drain(nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, []uint64{9}, changes)
+	})
+
+	t.Run("overflow not in storage yet", func(t *testing.T) {
+		t.Parallel()
+
+		b := newChangeBuffer[uint64](1)
+
+		for height := uint64(1); height <= 3; height++ {
+			b.add(height, position{height: height})
+		}
+
+		// Drain the buffer, while the last change is not stored yet
+		changes, err := b.drain(recoverHeights([]uint64{1, 2}))
+		require.NoError(t, err)
+
+		assert.Equal(t, []uint64{1}, changes)
+
+		changes, err = b.drain(recoverHeights([]uint64{1, 2}))
+		require.NoError(t, err)
+
+		assert.Equal(t, []uint64{2}, changes)
+
+		// Make sure the missing change is recovered once stored
+		changes, err = b.drain(recoverHeights([]uint64{1, 2, 3}))
+		require.NoError(t, err)
+
+		assert.Equal(t, []uint64{3}, changes)
+	})
+
+	t.Run("recovery error", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			stored     = []uint64{1, 2, 3}
+			recoverErr = errors.New("storage error")
+		)
+
+		b := newChangeBuffer[uint64](2)
+
+		for _, height := range stored {
+			b.add(height, position{height: height})
+		}
+
+		_, err := b.drain(func(_, _ position, _ func(uint64, position) bool) error {
+			return recoverErr
+		})
+		require.ErrorIs(t, err, recoverErr)
+
+		// Make sure no changes are lost
+		changes, err := b.drain(recoverHeights(stored))
+		require.NoError(t, err)
+
+		assert.Equal(t, []uint64{1, 2}, changes)
+
+		changes, err = b.drain(recoverHeights(stored))
+		require.NoError(t, err)
+
+		assert.Equal(t, []uint64{3}, changes)
+	})
+}
+
+func TestFilters_OverflowRecovery(t *testing.T) {
+	t.Parallel()
+
+	var (
+		blocks = make([]*types.Block, 0, 4)
+		txs    = make([]*types.TxResult, 0, 8)
+	)
+
+	for height := int64(1); height <= 4; height++ {
+		blocks = append(blocks, &types.Block{
+			Header: types.Header{Height: height},
+		})
+
+		for index := uint32(0); index < 2; index++ {
+			txs = append(txs, &types.TxResult{
+				Height: height,
+				Index:  index,
+				Tx:     []byte("tx"),
+			})
+		}
+	}
+
+	s := &mockStorage{
+		blocks: blocks,
+		txs:    txs,
+	}
+
+	t.Run("block filter", func(t *testing.T) {
+		t.Parallel()
+
+		f := NewBlockFilter(WithBufferLimit(3, s))
+
+		for _, block := range blocks {
+			f.UpdateWith(block)
+		}
+
+		first, err := f.GetChanges()
+		require.NoError(t, err)
+		require.Len(t, first, 3)
+
+		second, err := f.GetChanges()
+		require.NoError(t, err)
+		require.Len(t, second, 1)
+
+		assert.Equal(t, blocks[3].Header, second[0])
+	})
+
+	t.Run("transaction filter", func(t *testing.T) {
+		t.Parallel()
+
+		f := NewTxFilter(TxFilterOption{}, WithBufferLimit(3, s))
+
+		for _, tx := range txs {
+			f.UpdateWith(tx)
+		}
+
+		changes := make([]any, 0, len(txs))
+
+		for range 3 {
+			drained, err := f.GetChanges()
+			require.NoError(t, err)
+
+			changes = append(changes, drained...)
+		}
+
+		// Make sure no transaction is lost or duplicated
+		require.Len(t, changes, len(txs))
+
+		for index, tx := range txs {
+			assert.Equal(t, *tx, changes[index])
+		}
+	})
+}
@@ -0,0 +1,198 @@
+package filter
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+
+	"github.com/gnolang/tx-indexer/serve/methods"
+)
+
+var errInvalidBlockRange = errors.New("fromBlock is greater than toBlock")
+
+// EventFilterOption narrows down the Gno events collected by an event filter.
+// All the set options need to match
+type EventFilterOption struct {
+	// FromBlock and ToBlock are the inclusive block range of the filter.
+	// The range is open-ended if ToBlock is not set
+	FromBlock *uint64 `json:"fromBlock,omitempty"`
+	ToBlock   *uint64 `json:"toBlock,omitempty"`
+
+	// PkgPath is the path of the package emitting the event
+	PkgPath string `json:"pkgPath,omitempty"`
+
+	// Type is the event type
+	Type string `json:"type,omitempty"`
+
+	// Attrs are the attributes the event needs to have, with the given values
+	Attrs []*methods.EventAttribute `json:"attrs,omitempty"`
+}
+
+// Validate validates the filter options
+func (o EventFilterOption) Validate() error {
+	if o.FromBlock != nil && o.ToBlock != nil && *o.FromBlock > *o.ToBlock {
+		return fmt.Errorf("%w (%d > %d)", errInvalidBlockRange, *o.FromBlock, *o.ToBlock)
+	}
+
+	for i, attr := range o.Attrs {
+		if attr == nil || attr.Key == "" {
+			return fmt.Errorf("missing key for attrs[%d]", i)
+		}
+	}
+
+	return nil
+}
+
+// EventFilter type of filter for querying Gno events
+type EventFilter struct {
+	*baseFilter
+
+	events *changeBuffer[*methods.Event]
+	opts   EventFilterOption
+}
+
+// NewEventFilter creates a new event filter object
+func NewEventFilter(eventOpts EventFilterOption, opts ...Option) *EventFilter {
+	base := newBaseFilter(EventFilterType, opts...)
+
+	return &EventFilter{
+		baseFilter: base,
+		opts:       eventOpts,
+		events:     newChangeBuffer[*methods.Event](base.maxChanges),
+	}
+}
+
+// GetChanges returns all new events from the last query
+func (f *EventFilter) GetChanges() ([]any, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	events, err := f.events.drain(f.recoverEvents)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]any, len(events))
+	for index, event := range events {
+		changes[index] = event
+	}
+
+	return changes, nil
+}
+
+func (f *EventFilter) UpdateWith(data any) {
+	tx, ok := data.(*types.TxResult)
+	if !ok {
+		return
+	}
+
+	events := f.MatchingEvents(tx)
+	if len(events) == 0 {
+		return
+	}
+
+	f.Lock()
+	defer f.Unlock()
+
+	for _, event := range events {
+		f.events.add(event, eventPosition(event))
+	}
+}
+
+// BlockRange returns the inclusive block range of the filter.
+// The upper bound is nil if the range is open-ended
+func (f *EventFilter) BlockRange() (uint64, *uint64) {
+	var from uint64
+
+	if f.opts.FromBlock != nil {
+		from = *f.opts.FromBlock
+	}
+
+	return from, f.opts.ToBlock
+}
+
+// MatchingEvents returns the Gno events emitted by the transaction matching the filter
+func (f *EventFilter) MatchingEvents(tx *types.TxResult) []*methods.Event {
+	height := uint64(tx.Height)
+
+	if f.opts.FromBlock != nil && height < *f.opts.FromBlock {
+		return nil
+	}
+
+	if f.opts.ToBlock != nil && height > *f.opts.ToBlock {
+		return nil
+	}
+
+	matching := make([]*methods.Event, 0)
+
+	for _, event := range methods.GetEventsByTx(tx) {
+		if f.matchEvent(event) {
+			matching = append(matching, event)
+		}
+	}
+
+	return matching
+}
+
+// matchEvent returns a flag indicating if the event matches the filter
+func (f *EventFilter) matchEvent(event *methods.Event) bool {
+	if f.opts.PkgPath != "" && event.PkgPath != f.opts.PkgPath {
+		return false
+	}
+
+	if f.opts.Type != "" && event.Type != f.opts.Type {
+		return false
+	}
+
+	for _, attr := range f.opts.Attrs {
+		if !hasAttribute(event, attr) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// hasAttribute returns a flag indicating if the event has the attribute
+func hasAttribute(event *methods.Event, attr *methods.EventAttribute) bool {
+	for _, eventAttr := range event.Attrs {
+		if eventAttr.Key == attr.Key && eventAttr.Value == attr.Value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// recoverEvents recovers the dropped events from storage
+func (f *EventFilter) recoverEvents(
+	from,
+	to position,
+	collect func(*methods.Event, position) bool,
+) error {
+	return iterateTxs(f.storage, from, to, func(tx *types.TxResult) bool {
+		for _, event := range f.MatchingEvents(tx) {
+			pos := eventPosition(event)
+
+			if !pos.after(from) {
+				continue
+			}
+
+			if pos.after(to) || !collect(event, pos) {
+				return false
+			}
+		}
+
+		return true
+	})
+}
+
+// eventPosition returns the position of the event
+func eventPosition(event *methods.Event) position {
+	return position{
+		height:     uint64(event.Height),
+		index:      event.Index,
+		eventIndex: event.EventIndex,
+	}
+}
@@ -0,0 +1,157 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/gnolang/gno/gnovm/stdlibs/chain"
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/tx-indexer/serve/methods"
+)
+
+// newEventTx creates a transaction result emitting the given Gno events
+func newEventTx(height int64, events ...chain.Event) *types.TxResult {
+	abciEvents := make([]abci.Event, 0, len(events))
+	for _, event := range events {
+		abciEvents = append(abciEvents, event)
+	}
+
+	return &types.TxResult{
+		Height: height,
+		Tx:     []byte("tx"),
+		Response: abci.ResponseDeliverTx{
+			ResponseBase: abci.ResponseBase{
+				Events: abciEvents,
+			},
+		},
+	}
+}
+
+func TestEventFilter_GetChanges(t *testing.T) {
+	t.Parallel()
+
+	var (
+		transfer = func(to string) chain.Event {
+			return chain.Event{
+				Type:    "Transfer",
+				PkgPath: "gno.land/r/demo/foo20",
+				Attributes: []chain.EventAttribute{
+					{Key: "to", Value: to},
+				},
+			}
+		}
+
+		approval = chain.Event{
+			Type:    "Approval",
+			PkgPath: "gno.land/r/demo/foo20",
+		}
+
+		txs = []*types.TxResult{
+			newEventTx(1, transfer("alice")),
+			newEventTx(2, approval, transfer("bob")),
+			newEventTx(3, transfer("alice")),
+			newEventTx(4, transfer("alice")),
+		}
+
+		fromBlock uint64 = 2
+		toBlock   uint64 = 3
+	)
+
+	f := NewEventFilter(EventFilterOption{
+		FromBlock: &fromBlock,
+		ToBlock:   &toBlock,
+		PkgPath:   "gno.land/r/demo/foo20",
+		Type:      "Transfer",
+		Attrs: []*methods.EventAttribute{
+			{Key: "to", Value: "alice"},
+		},
+	})
+
+	// Make sure the filter is of a correct type
+	assert.Equal(t, EventFilterType, f.GetType())
+
+	for _, tx := range txs {
+		f.UpdateWith(tx)
+	}
+
+	// Make sure only the matching events within the range are collected
+	changes, err := f.GetChanges()
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+
+	event, ok := changes[0].(*methods.Event)
+	require.True(t, ok)
+
+	assert.Equal(t, int64(3), event.Height)
+	assert.Equal(t, "Transfer", event.Type)
+
+	// Make sure the changes are cleared
+	changes, err = f.GetChanges()
+	require.NoError(t, err)
+
+	assert.Empty(t, changes)
+}
+
+func TestEventFilterOption_Validate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		fromBlock uint64 = 10
+		toBlock   uint64 = 5
+	)
+
+	testTable := []struct {
+		name    string
+		errMsg  string
+		options EventFilterOption
+	}{
+		{
+			"valid options",
+			"",
+			EventFilterOption{
+				FromBlock: &toBlock,
+				ToBlock:   &fromBlock,
+				Attrs: []*methods.EventAttribute{
+					{Key: "to"},
+				},
+			},
+		},
+		{
+			"invalid block range",
+			"fromBlock is greater than toBlock (10 > 5)",
+			EventFilterOption{
+				FromBlock: &fromBlock,
+				ToBlock:   &toBlock,
+			},
+		},
+		{
+			"missing attribute key",
+			"missing key for attrs[1]",
+			EventFilterOption{
+				Attrs: []*methods.EventAttribute{
+					{Key: "to"},
+					{Value: "alice"},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.options.Validate()
+			if testCase.errMsg == "" {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			require.Error(t, err)
+			assert.Equal(t, testCase.errMsg, err.Error())
+		})
+	}
+}
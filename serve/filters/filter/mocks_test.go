@@ -0,0 +1,74 @@
+package filter
+
+import (
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+
+	"github.com/gnolang/tx-indexer/storage"
+)
+
+type mockStorage struct {
+	blocks []*types.Block
+	txs    []*types.TxResult
+}
+
+func (m *mockStorage) BlockIterator(fromBlockNum, toBlockNum uint64) (storage.Iterator[*types.Block], error) {
+	values := make([]*types.Block, 0, len(m.blocks))
+
+	for _, block := range m.blocks {
+		height := uint64(block.Height)
+
+		if height >= fromBlockNum && (toBlockNum == 0 || height <= toBlockNum) {
+			values = append(values, block)
+		}
+	}
+
+	return &mockIterator[*types.Block]{values: values}, nil
+}
+
+func (m *mockStorage) TxIterator(
+	fromBlockNum,
+	toBlockNum uint64,
+	fromTxIndex,
+	_ uint32,
+) (storage.Iterator[*types.TxResult], error) {
+	values := make([]*types.TxResult, 0, len(m.txs))
+
+	// The index bounds apply to every block, as in the storage
+	for _, tx := range m.txs {
+		height := uint64(tx.Height)
+
+		if height >= fromBlockNum && (toBlockNum == 0 || height <= toBlockNum) && tx.Index >= fromTxIndex {
+			values = append(values, tx)
+		}
+	}
+
+	return &mockIterator[*types.TxResult]{values: values}, nil
+}
+
+// mockIterator iterates over the given values
+type mockIterator[T any] struct {
+	values []T
+	index  int
+}
+
+func (m *mockIterator[T]) Next() bool {
+	if m.index >= len(m.values) {
+		return false
+	}
+
+	m.index++
+
+	return true
+}
+
+func (m *mockIterator[T]) Error() error {
+	return nil
+}
+
+func (m *mockIterator[T]) Value() (T, error) {
+	return m.values[m.index-1], nil
+}
+
+func (m *mockIterator[T]) Close() error {
+	return nil
+}
@@ -0,0 +1,187 @@
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+
+	"github.com/gnolang/tx-indexer/serve/graph/model"
+)
+
+var errInvalidRange = errors.New("range min is greater than max")
+
+type Option func(*baseFilter)
+
+// WithBufferLimit caps the changes a filter buffers between polls.
+// Once the buffer is full, the following changes are recovered
+// from the storage when the filter is polled
+func WithBufferLimit(maxChanges int, storage Storage) Option {
+	return func(f *baseFilter) {
+		f.maxChanges = maxChanges
+		f.storage = storage
+	}
+}
+
+// filterStringType is the type of the string filters,
+// whose `like` patterns are validated
+var filterStringType = reflect.TypeOf(model.FilterString{})
+
+type RangeFilterOption struct {
+	Min *int64 `json:"min,omitempty"`
+	Max *int64 `json:"max,omitempty"`
+}
+
+// validateRange validates the named range option, if set
+func validateRange(name string, rangeOpt *RangeFilterOption) error {
+	if rangeOpt == nil || rangeOpt.Min == nil || rangeOpt.Max == nil {
+		return nil
+	}
+
+	if *rangeOpt.Min > *rangeOpt.Max {
+		return fmt.Errorf("%w for %s (%d > %d)", errInvalidRange, name, *rangeOpt.Min, *rangeOpt.Max)
+	}
+
+	return nil
+}
+
+// validateAddress validates the named bech32 address
+func validateAddress(name, address string) error {
+	if _, err := crypto.AddressFromBech32(address); err != nil {
+		return fmt.Errorf("invalid %s %q, %w", name, address, err)
+	}
+
+	return nil
+}
+
+// validatePatterns validates the `like` patterns of the named
+// GraphQL filter, and all of its nested filters
+func validatePatterns(name string, filter any) error {
+	return validatePatternsValue(name, reflect.ValueOf(filter))
+}
+
+func validatePatternsValue(path string, value reflect.Value) error {
+	switch value.Kind() {
+	case reflect.Pointer:
+		if value.IsNil() {
+			return nil
+		}
+
+		return validatePatternsValue(path, value.Elem())
+	case reflect.Slice:
+		for i := 0; i < value.Len(); i++ {
+			if err := validatePatternsValue(fmt.Sprintf("%s[%d]", path, i), value.Index(i)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	case reflect.Struct:
+		if value.Type() == filterStringType {
+			like, _ := value.Interface().(model.FilterString)
+
+			if like.Like == nil {
+				return nil
+			}
+
+			if _, err := regexp.Compile(*like.Like); err != nil {
+				return fmt.Errorf("invalid pattern for %s.like, %w", path, err)
+			}
+
+			return nil
+		}
+
+		for i := 0; i < value.NumField(); i++ {
+			name, _, _ := strings.Cut(value.Type().Field(i).Tag.Get("json"), ",")
+
+			if err := validatePatternsValue(path+"."+name, value.Field(i)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	default:
+		return nil
+	}
+}
+
+// addressFilters returns the filters matching the transactions
+// any of the addresses takes part in
+func addressFilters(addresses ...string) []*model.FilterTransaction {
+	valueFilter := func(value *model.NestedFilterMessageValue) *model.FilterTransaction {
+		return &model.FilterTransaction{
+			Messages: &model.NestedFilterTransactionMessage{
+				Value: value,
+			},
+		}
+	}
+
+	filters := make([]*model.FilterTransaction, 0, len(addresses)*6)
+
+	for _, address := range addresses {
+		filters = append(
+			filters,
+			&model.FilterTransaction{Signers: stringFilter(address)},
+			valueFilter(&model.NestedFilterMessageValue{
+				MsgCall: &model.NestedFilterMsgCall{Caller: stringFilter(address)},
+			}),
+			valueFilter(&model.NestedFilterMessageValue{
+				MsgAddPackage: &model.NestedFilterMsgAddPackage{Creator: stringFilter(address)},
+			}),
+			valueFilter(&model.NestedFilterMessageValue{
+				MsgRun: &model.NestedFilterMsgRun{Caller: stringFilter(address)},
+			}),
+			valueFilter(&model.NestedFilterMessageValue{
+				BankMsgSend: &model.NestedFilterBankMsgSend{FromAddress: stringFilter(address)},
+			}),
+			valueFilter(&model.NestedFilterMessageValue{
+				BankMsgSend: &model.NestedFilterBankMsgSend{ToAddress: stringFilter(address)},
+			}),
+		)
+	}
+
+	return filters
+}
+
+// stringFilter returns the equality filter for the value, if set
+func stringFilter(value string) *model.FilterString {
+	if value == "" {
+		return nil
+	}
+
+	return &model.FilterString{Eq: &value}
+}
+
+// intRangeFilter converts the inclusive range to the equivalent
+// (exclusive) number filter, if set.
+// A missing min defaults to 0, and a missing max is unbounded
+func intRangeFilter(rangeOpt *RangeFilterOption) *model.FilterInt {
+	if rangeOpt == nil {
+		return nil
+	}
+
+	var (
+		intFilter = &model.FilterInt{}
+		minValue  int64
+	)
+
+	if rangeOpt.Min != nil {
+		minValue = *rangeOpt.Min
+	}
+
+	if minValue > math.MinInt64 {
+		gt := int(minValue - 1)
+		intFilter.Gt = &gt
+	}
+
+	if rangeOpt.Max != nil && *rangeOpt.Max < math.MaxInt64 {
+		lt := int(*rangeOpt.Max + 1)
+		intFilter.Lt = &lt
+	}
+
+	return intFilter
+}
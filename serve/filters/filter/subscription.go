@@ -0,0 +1,156 @@
+package filter
+
+import (
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+
+	"github.com/gnolang/tx-indexer/serve/graph/model"
+	"github.com/gnolang/tx-indexer/serve/methods"
+)
+
+// SubscriptionFilterOption narrows down the transactions and events
+// pushed to a subscription. All the set options need to match
+type SubscriptionFilterOption struct {
+	GasUsed   *RangeFilterOption `json:"gasUsed,omitempty"`
+	GasWanted *RangeFilterOption `json:"gasWanted,omitempty"`
+	Success   *bool              `json:"success,omitempty"`
+
+	// Address is the bech32 address taking part in the transaction,
+	// as a signer, caller, package creator, sender or recipient
+	Address string `json:"address,omitempty"`
+
+	// PkgPath is the path of the called or deployed package
+	PkgPath string `json:"pkgPath,omitempty"`
+
+	// Func is the called realm function
+	Func string `json:"func,omitempty"`
+
+	// MessageType is the message type (ex. `exec`, `add_package`, `run`, `send`)
+	MessageType string `json:"messageType,omitempty"`
+
+	// EventType and EventPkgPath match the emitted Gno events
+	EventType    string `json:"eventType,omitempty"`
+	EventPkgPath string `json:"eventPkgPath,omitempty"`
+}
+
+// SubscriptionFilter is the compiled subscription filter,
+// evaluated with the same engine as the GraphQL transaction filters
+type SubscriptionFilter struct {
+	txFilter *model.FilterTransaction
+
+	eventType    string
+	eventPkgPath string
+}
+
+// NewSubscriptionFilter validates and compiles the subscription filter options
+func NewSubscriptionFilter(opts SubscriptionFilterOption) (*SubscriptionFilter, error) {
+	if err := validateRange("gasUsed", opts.GasUsed); err != nil {
+		return nil, err
+	}
+
+	if err := validateRange("gasWanted", opts.GasWanted); err != nil {
+		return nil, err
+	}
+
+	if opts.Address != "" {
+		if err := validateAddress("address", opts.Address); err != nil {
+			return nil, err
+		}
+	}
+
+	return &SubscriptionFilter{
+		txFilter:     opts.transactionFilter(),
+		eventType:    opts.EventType,
+		eventPkgPath: opts.EventPkgPath,
+	}, nil
+}
+
+// MatchTx returns a flag indicating if the transaction matches the filter
+func (f *SubscriptionFilter) MatchTx(tx *types.TxResult) bool {
+	return f.txFilter.Eval(model.NewTransaction(tx))
+}
+
+// MatchEvent returns a flag indicating if the event,
+// emitted by the given transaction, matches the filter
+func (f *SubscriptionFilter) MatchEvent(tx *types.TxResult, event *methods.Event) bool {
+	if f.eventType != "" && event.Type != f.eventType {
+		return false
+	}
+
+	if f.eventPkgPath != "" && event.PkgPath != f.eventPkgPath {
+		return false
+	}
+
+	return f.MatchTx(tx)
+}
+
+// transactionFilter converts the options to the equivalent transaction filter
+func (o SubscriptionFilterOption) transactionFilter() *model.FilterTransaction {
+	txFilter := &model.FilterTransaction{
+		GasUsed:   intRangeFilter(o.GasUsed),
+		GasWanted: intRangeFilter(o.GasWanted),
+		Messages:  o.messageFilter(),
+	}
+
+	if o.Success != nil {
+		txFilter.Success = &model.FilterBoolean{Eq: o.Success}
+	}
+
+	if o.Address != "" {
+		txFilter.Or = addressFilters(o.Address)
+	}
+
+	if o.EventType != "" || o.EventPkgPath != "" {
+		txFilter.Response = &model.NestedFilterTransactionResponse{
+			Events: &model.NestedFilterEvent{
+				GnoEvent: &model.NestedFilterGnoEvent{
+					Type:    stringFilter(o.EventType),
+					PkgPath: stringFilter(o.EventPkgPath),
+				},
+			},
+		}
+	}
+
+	return txFilter
+}
+
+// messageFilter returns the filter for the single message
+// matching the type, package path and function options, if any
+func (o SubscriptionFilterOption) messageFilter() *model.NestedFilterTransactionMessage {
+	if o.MessageType == "" && o.PkgPath == "" && o.Func == "" {
+		return nil
+	}
+
+	messageFilter := &model.NestedFilterTransactionMessage{
+		TypeURL: stringFilter(o.MessageType),
+	}
+
+	switch {
+	case o.Func != "":
+		// Only calls have a function
+		messageFilter.Value = &model.NestedFilterMessageValue{
+			MsgCall: &model.NestedFilterMsgCall{
+				PkgPath: stringFilter(o.PkgPath),
+				Func:    stringFilter(o.Func),
+			},
+		}
+	case o.PkgPath != "":
+		messageFilter.Value = &model.NestedFilterMessageValue{
+			Or: []*model.NestedFilterMessageValue{
+				{
+					MsgCall: &model.NestedFilterMsgCall{
+						PkgPath: stringFilter(o.PkgPath),
+					},
+				},
+				{
+					MsgAddPackage: &model.NestedFilterMsgAddPackage{
+						Package: &model.NestedFilterMemPackage{
+							Path: stringFilter(o.PkgPath),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	return messageFilter
+}
@@ -0,0 +1,176 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/gnolang/gno/gno.land/pkg/sdk/vm"
+	"github.com/gnolang/gno/gnovm/stdlibs/chain"
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	"github.com/gnolang/gno/tm2/pkg/std"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/tx-indexer/serve/methods"
+)
+
+func TestNewSubscriptionFilter_InvalidRange(t *testing.T) {
+	t.Parallel()
+
+	var (
+		minGas int64 = 200
+		maxGas int64 = 100
+	)
+
+	_, err := NewSubscriptionFilter(SubscriptionFilterOption{
+		GasUsed: &RangeFilterOption{
+			Min: &minGas,
+			Max: &maxGas,
+		},
+	})
+
+	assert.ErrorIs(t, err, errInvalidRange)
+}
+
+func TestSubscriptionFilter_MatchTx(t *testing.T) {
+	t.Parallel()
+
+	var (
+		caller = crypto.AddressFromPreimage([]byte("caller"))
+		other  = crypto.AddressFromPreimage([]byte("other"))
+
+		minGas int64 = 100
+		maxGas int64 = 1000
+		failed       = false
+	)
+
+	encodedTx, err := amino.Marshal(&std.Tx{
+		Msgs: []std.Msg{
+			vm.MsgCall{
+				Caller:  caller,
+				PkgPath: "gno.land/r/demo/foo20",
+				Func:    "Transfer",
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	tx := &types.TxResult{
+		Height: 10,
+		Tx:     encodedTx,
+		Response: abci.ResponseDeliverTx{
+			GasWanted: 2000,
+			GasUsed:   1000,
+		},
+	}
+
+	testTable := []struct {
+		name    string
+		opts    SubscriptionFilterOption
+		matches bool
+	}{
+		{
+			"no options",
+			SubscriptionFilterOption{},
+			true,
+		},
+		{
+			"matching caller",
+			SubscriptionFilterOption{Address: caller.String()},
+			true,
+		},
+		{
+			"other address",
+			SubscriptionFilterOption{Address: other.String()},
+			false,
+		},
+		{
+			"matching call",
+			SubscriptionFilterOption{
+				PkgPath:     "gno.land/r/demo/foo20",
+				Func:        "Transfer",
+				MessageType: "exec",
+			},
+			true,
+		},
+		{
+			"matching package path",
+			SubscriptionFilterOption{PkgPath: "gno.land/r/demo/foo20"},
+			true,
+		},
+		{
+			"other function",
+			SubscriptionFilterOption{Func: "Approve"},
+			false,
+		},
+		{
+			"other message type",
+			SubscriptionFilterOption{MessageType: "send"},
+			false,
+		},
+		{
+			"inclusive gas range",
+			SubscriptionFilterOption{
+				GasUsed: &RangeFilterOption{Min: &minGas, Max: &maxGas},
+			},
+			true,
+		},
+		{
+			"gas wanted out of range",
+			SubscriptionFilterOption{
+				GasWanted: &RangeFilterOption{Max: &maxGas},
+			},
+			false,
+		},
+		{
+			"failed transactions",
+			SubscriptionFilterOption{Success: &failed},
+			false,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			f, err := NewSubscriptionFilter(testCase.opts)
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.matches, f.MatchTx(tx))
+		})
+	}
+}
+
+func TestSubscriptionFilter_MatchEvent(t *testing.T) {
+	t.Parallel()
+
+	encodedTx, err := amino.Marshal(&std.Tx{})
+	require.NoError(t, err)
+
+	tx := &types.TxResult{
+		Tx: encodedTx,
+		Response: abci.ResponseDeliverTx{
+			ResponseBase: abci.ResponseBase{
+				Events: []abci.Event{
+					chain.Event{Type: "Transfer", PkgPath: "gno.land/r/demo/foo20"},
+					chain.Event{Type: "Approval", PkgPath: "gno.land/r/demo/foo20"},
+				},
+			},
+		},
+	}
+
+	f, err := NewSubscriptionFilter(SubscriptionFilterOption{
+		EventType:    "Transfer",
+		EventPkgPath: "gno.land/r/demo/foo20",
+	})
+	require.NoError(t, err)
+
+	txEvents := methods.GetEventsByTx(tx)
+	require.Len(t, txEvents, 2)
+
+	assert.True(t, f.MatchTx(tx))
+	assert.True(t, f.MatchEvent(tx, txEvents[0]))
+	assert.False(t, f.MatchEvent(tx, txEvents[1]))
+}
@@ -1,41 +1,127 @@
 package filter
 
 import (
-	"math"
+	"fmt"
 
 	"github.com/gnolang/gno/tm2/pkg/bft/types"
-)
 
-type RangeFilterOption struct {
-	Min *int64 `json:"min,omitempty"`
-	Max *int64 `json:"max,omitempty"`
-}
+	"github.com/gnolang/tx-indexer/serve/graph/model"
+)
 
+// TxFilterOption narrows down the transactions collected by a transaction filter.
+// All the set options need to match. The messages, memo and events
+// use the same vocabulary as the GraphQL transaction filter
 type TxFilterOption struct {
 	GasUsed   *RangeFilterOption `json:"gasUsed,omitempty"`
 	GasWanted *RangeFilterOption `json:"gasWanted,omitempty"`
-	GasLimit  *RangeFilterOption `json:"gasLimit,omitempty"`
+
+	// GasLimit is the gas limit set by the transaction,
+	// reported in the transaction result as the gas wanted
+	GasLimit *RangeFilterOption `json:"gasLimit,omitempty"`
+
+	Success *bool `json:"success,omitempty"`
+
+	// Memo filters the transaction memo
+	Memo *model.FilterString `json:"memo,omitempty"`
+
+	// Messages filters the transaction messages
+	Messages *model.NestedFilterTransactionMessage `json:"messages,omitempty"`
+
+	// Events filters the events emitted by the transaction
+	Events *model.NestedFilterEvent `json:"events,omitempty"`
+
+	// Addresses are the bech32 addresses, any of which needs to take part in the
+	// transaction as a signer, caller, package creator, sender or recipient
+	Addresses []string `json:"addresses,omitempty"`
+}
+
+// Validate validates the filter options
+func (o TxFilterOption) Validate() error {
+	ranges := []struct {
+		rangeOpt *RangeFilterOption
+		name     string
+	}{
+		{o.GasUsed, "gasUsed"},
+		{o.GasWanted, "gasWanted"},
+		{o.GasLimit, "gasLimit"},
+	}
+
+	for _, r := range ranges {
+		if err := validateRange(r.name, r.rangeOpt); err != nil {
+			return err
+		}
+	}
+
+	for i, address := range o.Addresses {
+		if err := validateAddress(fmt.Sprintf("addresses[%d]", i), address); err != nil {
+			return err
+		}
+	}
+
+	if err := validatePatterns("memo", o.Memo); err != nil {
+		return err
+	}
+
+	if err := validatePatterns("messages", o.Messages); err != nil {
+		return err
+	}
+
+	return validatePatterns("events", o.Events)
+}
+
+// transactionFilter converts the options to the equivalent transaction filter
+func (o TxFilterOption) transactionFilter() *model.FilterTransaction {
+	txFilter := &model.FilterTransaction{
+		GasUsed:   intRangeFilter(o.GasUsed),
+		GasWanted: intRangeFilter(o.GasWanted),
+		Memo:      o.Memo,
+		Messages:  o.Messages,
+	}
+
+	if o.GasLimit != nil {
+		txFilter.And = []*model.FilterTransaction{
+			{GasWanted: intRangeFilter(o.GasLimit)},
+		}
+	}
+
+	if o.Success != nil {
+		txFilter.Success = &model.FilterBoolean{Eq: o.Success}
+	}
+
+	if o.Events != nil {
+		txFilter.Response = &model.NestedFilterTransactionResponse{
+			Events: o.Events,
+		}
+	}
+
+	if len(o.Addresses) > 0 {
+		txFilter.Or = addressFilters(o.Addresses...)
+	}
+
+	return txFilter
 }
 
 // TxFilter holds a slice of transaction results.
 // It provides methods to manipulate and query the transactions.
 type TxFilter struct {
-	opts TxFilterOption
 	*baseFilter
-	txs []types.TxResult
+	txFilter *model.FilterTransaction
+	txs      *changeBuffer[types.TxResult]
 }
 
 // NewTxFilter creates a new TxFilter object.
-func NewTxFilter(opts TxFilterOption) *TxFilter {
+func NewTxFilter(txOpts TxFilterOption, opts ...Option) *TxFilter {
+	base := newBaseFilter(TxFilterType, opts...)
+
 	return &TxFilter{
-		baseFilter: newBaseFilter(TxFilterType),
-		txs:        make([]types.TxResult, 0),
-		opts:       opts,
+		baseFilter: base,
+		txFilter:   txOpts.transactionFilter(),
+		txs:        newChangeBuffer[types.TxResult](base.maxChanges),
 	}
 }
 
 // GetChanges returns all new transactions from the last query
-func (tf *TxFilter) GetChanges() []any {
+func (tf *TxFilter) GetChanges() ([]any, error) {
 	return tf.getTxChanges()
 }
 
@@ -50,14 +136,14 @@ func (tf *TxFilter) UpdateWith(data any) {
 	}
 }
 
-// GetHashes iterates over all transactions in the filter and returns their hashes.
+// GetHashes iterates over all buffered transactions in the filter and returns their hashes.
 func (tf *TxFilter) GetHashes() [][]byte {
 	tf.Lock()
 	defer tf.Unlock()
 
-	hashes := make([][]byte, 0, len(tf.txs))
+	hashes := make([][]byte, 0, len(tf.txs.items))
 
-	for _, txr := range tf.txs {
+	for _, txr := range tf.txs.items {
 		var hash []byte
 
 		if txr.Tx != nil {
@@ -72,64 +158,48 @@ func (tf *TxFilter) GetHashes() [][]byte {
 
 // checkFilterOptions checks the conditions of the options in the filter.
 func (tf *TxFilter) checkFilterOptions(tx *types.TxResult) bool {
-	if !filteredByRangeFilterOption(tx.Response.GasUsed, tf.opts.GasUsed) {
-		return false
-	}
-
-	if !filteredByRangeFilterOption(tx.Response.GasWanted, tf.opts.GasWanted) {
-		return false
-	}
-
-	// GasLimit compares GasUsed.
-	if !filteredByRangeFilterOption(tx.Response.GasUsed, tf.opts.GasLimit) {
-		return false
-	}
-
-	return true
-}
-
-// filteredByRangeFilterOption checks if the number is in a range.
-func filteredByRangeFilterOption(value int64, rangeFilterOption *RangeFilterOption) bool {
-	if rangeFilterOption == nil {
-		return true
-	}
-
-	var (
-		leftBound  int64
-		rightBound int64 = math.MaxInt64
-	)
-
-	if rangeFilterOption.Min != nil {
-		leftBound = *rangeFilterOption.Min
-	}
-
-	if rangeFilterOption.Max != nil {
-		rightBound = *rangeFilterOption.Max
-	}
-
-	return value >= leftBound && value <= rightBound
+	return tf.txFilter.Eval(model.NewTransaction(tx))
 }
 
 // getTxChanges returns all new transactions from the last query
-func (tf *TxFilter) getTxChanges() []any {
+func (tf *TxFilter) getTxChanges() ([]any, error) {
 	tf.Lock()
 	defer tf.Unlock()
 
+	txs, err := tf.txs.drain(tf.recoverTxs)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get newTxs
-	newTxs := make([]any, len(tf.txs))
-	for index, tx := range tf.txs {
+	newTxs := make([]any, len(txs))
+	for index, tx := range txs {
 		newTxs[index] = tx
 	}
 
-	// Empty headers
-	tf.txs = tf.txs[:0]
-
-	return newTxs
+	return newTxs, nil
 }
 
 func (tf *TxFilter) updateWithTx(tx *types.TxResult) {
 	tf.Lock()
 	defer tf.Unlock()
 
-	tf.txs = append(tf.txs, *tx)
+	tf.txs.add(*tx, txPosition(tx))
+}
+
+// recoverTxs recovers the dropped transactions from storage
+func (tf *TxFilter) recoverTxs(
+	from,
+	to position,
+	collect func(types.TxResult, position) bool,
+) error {
+	return iterateTxs(tf.storage, from, to, func(tx *types.TxResult) bool {
+		pos := txPosition(tx)
+
+		if !pos.after(from) || !tf.checkFilterOptions(tx) {
+			return true
+		}
+
+		return collect(*tx, pos)
+	})
 }
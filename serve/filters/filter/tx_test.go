@@ -4,10 +4,18 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/gnolang/gno/gno.land/pkg/sdk/vm"
+	"github.com/gnolang/gno/gnovm/stdlibs/chain"
+	"github.com/gnolang/gno/tm2/pkg/amino"
 	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
 	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	"github.com/gnolang/gno/tm2/pkg/sdk/bank"
+	"github.com/gnolang/gno/tm2/pkg/std"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/tx-indexer/serve/graph/model"
 )
 
 func TestGetHashes(t *testing.T) {
@@ -181,21 +189,21 @@ func TestApplyFilters(t *testing.T) {
 			options: TxFilterOption{
 				GasLimit: &RangeFilterOption{Min: nil, Max: int64Ptr(1000)},
 			},
-			expected: []*types.TxResult{txs[0], txs[3], txs[4]},
+			expected: []*types.TxResult{txs[0], txs[2]},
 		},
 		{
 			name: "gas limit max value is nil",
 			options: TxFilterOption{
 				GasLimit: &RangeFilterOption{Min: int64Ptr(1100), Max: nil},
 			},
-			expected: []*types.TxResult{txs[1], txs[2]},
+			expected: []*types.TxResult{txs[1], txs[3], txs[4]},
 		},
 		{
 			name: "gas limit range is valid",
 			options: TxFilterOption{
 				GasLimit: &RangeFilterOption{Min: int64Ptr(900), Max: int64Ptr(1000)},
 			},
-			expected: []*types.TxResult{txs[0], txs[3], txs[4]},
+			expected: []*types.TxResult{txs[0], txs[2]},
 		},
 		{
 			name: "gas limit both min and max are nil",
@@ -230,7 +238,8 @@ func TestApplyFilters(t *testing.T) {
 				f.UpdateWith(tx)
 			}
 
-			changes := f.GetChanges()
+			changes, err := f.GetChanges()
+			require.NoError(t, err)
 			require.Len(
 				t, changes, len(tt.expected),
 				fmt.Sprintf(
@@ -252,6 +261,70 @@ func TestApplyFilters(t *testing.T) {
 	}
 }
 
+func TestApplyFilters_RangeBounds(t *testing.T) {
+	t.Parallel()
+
+	txs := make([]*types.TxResult, 0, 3)
+
+	for index, gasUsed := range []int64{-1, 0, 10} {
+		txs = append(txs, &types.TxResult{
+			Height: 100,
+			Index:  uint32(index),
+			Response: abci.ResponseDeliverTx{
+				GasUsed: gasUsed,
+			},
+		})
+	}
+
+	tests := []struct {
+		options  TxFilterOption
+		name     string
+		expected []*types.TxResult
+	}{
+		{
+			name: "missing min defaults to 0",
+			options: TxFilterOption{
+				GasUsed: &RangeFilterOption{Max: int64Ptr(10)},
+			},
+			expected: []*types.TxResult{txs[1], txs[2]},
+		},
+		{
+			name: "negative min",
+			options: TxFilterOption{
+				GasUsed: &RangeFilterOption{Min: int64Ptr(-1), Max: int64Ptr(10)},
+			},
+			expected: txs,
+		},
+		{
+			name: "missing max is unbounded",
+			options: TxFilterOption{
+				GasUsed: &RangeFilterOption{Min: int64Ptr(10)},
+			},
+			expected: []*types.TxResult{txs[2]},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			f := NewTxFilter(tt.options)
+
+			for _, tx := range txs {
+				f.UpdateWith(tx)
+			}
+
+			changes, err := f.GetChanges()
+			require.NoError(t, err)
+			require.Len(t, changes, len(tt.expected))
+
+			for i, tx := range changes {
+				assert.Equal(t, *tt.expected[i], tx)
+			}
+		})
+	}
+}
+
 func TestApplyFiltersWithLargeData(t *testing.T) {
 	t.Parallel()
 
@@ -275,8 +348,8 @@ func TestApplyFiltersWithLargeData(t *testing.T) {
 	}
 
 	tests := []struct {
-		options  TxFilterOption
 		name     string
+		options  TxFilterOption
 		expected int
 	}{
 		{
@@ -303,7 +376,8 @@ func TestApplyFiltersWithLargeData(t *testing.T) {
 				f.UpdateWith(tx)
 			}
 
-			changes := f.GetChanges()
+			changes, err := f.GetChanges()
+			require.NoError(t, err)
 			require.Len(
 				t, changes, tt.expected,
 				fmt.Sprintf(
@@ -315,4 +389,221 @@ func TestApplyFiltersWithLargeData(t *testing.T) {
 	}
 }
 
+func TestApplyRichFilters(t *testing.T) {
+	t.Parallel()
+
+	var (
+		caller    = crypto.AddressFromPreimage([]byte("caller"))
+		recipient = crypto.AddressFromPreimage([]byte("recipient"))
+		other     = crypto.AddressFromPreimage([]byte("other"))
+	)
+
+	newTx := func(index uint32, memo string, msg std.Msg, response abci.ResponseDeliverTx) *types.TxResult {
+		encodedTx, err := amino.Marshal(&std.Tx{
+			Msgs: []std.Msg{msg},
+			Memo: memo,
+		})
+		require.NoError(t, err)
+
+		return &types.TxResult{
+			Height:   10,
+			Index:    index,
+			Tx:       encodedTx,
+			Response: response,
+		}
+	}
+
+	txs := []*types.TxResult{
+		newTx(
+			0,
+			"transfer foo",
+			vm.MsgCall{
+				Caller:  caller,
+				PkgPath: "gno.land/r/demo/foo20",
+				Func:    "Transfer",
+			},
+			abci.ResponseDeliverTx{
+				ResponseBase: abci.ResponseBase{
+					Events: []abci.Event{
+						chain.Event{Type: "Transfer", PkgPath: "gno.land/r/demo/foo20"},
+					},
+				},
+			},
+		),
+		newTx(
+			1,
+			"",
+			bank.MsgSend{
+				FromAddress: caller,
+				ToAddress:   recipient,
+			},
+			abci.ResponseDeliverTx{
+				ResponseBase: abci.ResponseBase{
+					Error: std.InsufficientFundsError{},
+				},
+			},
+		),
+	}
+
+	var (
+		success  = true
+		callType = "exec"
+		memo     = "^transfer"
+		funcName = "Transfer"
+		event    = "Transfer"
+	)
+
+	tests := []struct {
+		options  TxFilterOption
+		name     string
+		expected []*types.TxResult
+	}{
+		{
+			name: "successful transactions",
+			options: TxFilterOption{
+				Success: &success,
+			},
+			expected: []*types.TxResult{txs[0]},
+		},
+		{
+			name: "memo pattern",
+			options: TxFilterOption{
+				Memo: &model.FilterString{Like: &memo},
+			},
+			expected: []*types.TxResult{txs[0]},
+		},
+		{
+			name: "message type and function",
+			options: TxFilterOption{
+				Messages: &model.NestedFilterTransactionMessage{
+					TypeURL: &model.FilterString{Eq: &callType},
+					Value: &model.NestedFilterMessageValue{
+						MsgCall: &model.NestedFilterMsgCall{
+							Func: &model.FilterString{Eq: &funcName},
+						},
+					},
+				},
+			},
+			expected: []*types.TxResult{txs[0]},
+		},
+		{
+			name: "emitted events",
+			options: TxFilterOption{
+				Events: &model.NestedFilterEvent{
+					GnoEvent: &model.NestedFilterGnoEvent{
+						Type: &model.FilterString{Eq: &event},
+					},
+				},
+			},
+			expected: []*types.TxResult{txs[0]},
+		},
+		{
+			name: "any of the addresses",
+			options: TxFilterOption{
+				Addresses: []string{other.String(), recipient.String()},
+			},
+			expected: []*types.TxResult{txs[1]},
+		},
+		{
+			name: "other address",
+			options: TxFilterOption{
+				Addresses: []string{other.String()},
+			},
+			expected: []*types.TxResult{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			f := NewTxFilter(tt.options)
+
+			for _, tx := range txs {
+				f.UpdateWith(tx)
+			}
+
+			changes, err := f.GetChanges()
+			require.NoError(t, err)
+			require.Len(t, changes, len(tt.expected))
+
+			for i, tx := range changes {
+				assert.Equal(t, *tt.expected[i], tx)
+			}
+		})
+	}
+}
+
+func TestTxFilterOption_Validate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		invalidPattern = "gno.land/r/("
+		validPattern   = "gno.land/r/.*"
+	)
+
+	tests := []struct {
+		name    string
+		errMsg  string
+		options TxFilterOption
+	}{
+		{
+			name: "valid options",
+			options: TxFilterOption{
+				GasUsed:   &RangeFilterOption{Min: int64Ptr(10), Max: int64Ptr(10)},
+				Addresses: []string{crypto.AddressFromPreimage([]byte("caller")).String()},
+				Memo:      &model.FilterString{Like: &validPattern},
+			},
+		},
+		{
+			name: "invalid gas limit range",
+			options: TxFilterOption{
+				GasLimit: &RangeFilterOption{Min: int64Ptr(10), Max: int64Ptr(1)},
+			},
+			errMsg: "range min is greater than max for gasLimit (10 > 1)",
+		},
+		{
+			name: "invalid address",
+			options: TxFilterOption{
+				Addresses: []string{crypto.AddressFromPreimage([]byte("caller")).String(), "g1invalid"},
+			},
+			errMsg: `invalid addresses[1] "g1invalid"`,
+		},
+		{
+			name: "invalid nested pattern",
+			options: TxFilterOption{
+				Messages: &model.NestedFilterTransactionMessage{
+					Or: []*model.NestedFilterTransactionMessage{
+						{},
+						{
+							Value: &model.NestedFilterMessageValue{
+								MsgCall: &model.NestedFilterMsgCall{
+									PkgPath: &model.FilterString{Like: &invalidPattern},
+								},
+							},
+						},
+					},
+				},
+			},
+			errMsg: "invalid pattern for messages._or[1].value.MsgCall.pkg_path.like",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.options.Validate()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
 func int64Ptr(i int64) *int64 { return &i }
@@ -1,8 +1,24 @@
 package filter
 
+import (
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+
+	"github.com/gnolang/tx-indexer/storage"
+)
+
 type Type string
 
 const (
 	BlockFilterType Type = "BlockFilter"
 	TxFilterType    Type = "TxFilter"
+	EventFilterType Type = "EventFilter"
 )
+
+// Storage is the storage the dropped filter changes are recovered from
+type Storage interface {
+	// BlockIterator iterates over the blocks within the given range (inclusive)
+	BlockIterator(fromBlockNum, toBlockNum uint64) (storage.Iterator[*types.Block], error)
+
+	// TxIterator iterates over the transactions within the given range (inclusive)
+	TxIterator(fromBlockNum, toBlockNum uint64, fromTxIndex, toTxIndex uint32) (storage.Iterator[*types.TxResult], error)
+}
@@ -1,6 +1,7 @@
 package filters
 
 import (
+	"fmt"
 	"sync"
 	"time"
 
@@ -12,29 +13,58 @@ import (
 type filterMap struct {
 	items map[string]Filter
 
+	// owners are the clients owning the filters
+	owners map[string]string
+
+	// clientFilters are the amounts of filters owned by each client
+	clientFilters map[string]int
+
 	sync.Mutex
 }
 
 // newFilterMap creates a new filter map
 func newFilterMap() *filterMap {
 	return &filterMap{
-		items: make(map[string]Filter),
+		items:         make(map[string]Filter),
+		owners:        make(map[string]string),
+		clientFilters: make(map[string]int),
 	}
 }
 
-// newFilter adds a new filter to the filter map,
-// and returns the corresponding ID
-func (f *filterMap) newFilter(filter Filter) string {
+// newFilter adds a new filter owned by the client to the filter map,
+// and returns the corresponding ID. Clients can own at most
+// maxClientFilters filters, unless the limit is 0
+func (f *filterMap) newFilter(clientID string, filter Filter, maxClientFilters int) (string, error) {
 	f.Lock()
 	defer f.Unlock()
 
+	if maxClientFilters > 0 && f.clientFilters[clientID] >= maxClientFilters {
+		return "", fmt.Errorf("%w (%d)", ErrTooManyFilters, maxClientFilters)
+	}
+
 	// Crete new id
 	id := uuid.New().String()
 
 	// Add filter to the map
 	f.items[id] = filter
+	f.owners[id] = clientID
+	f.clientFilters[clientID]++
 
-	return id
+	return id, nil
+}
+
+// deleteFilter removes the filter with the specified ID.
+// Must be called with the lock held
+func (f *filterMap) deleteFilter(id string) {
+	clientID := f.owners[id]
+
+	delete(f.items, id)
+	delete(f.owners, id)
+
+	f.clientFilters[clientID]--
+	if f.clientFilters[clientID] <= 0 {
+		delete(f.clientFilters, clientID)
+	}
 }
 
 // uninstallFilter removes the filter with the specified ID.
@@ -44,8 +74,9 @@ func (f *filterMap) uninstallFilter(id string) bool {
 	defer f.Unlock()
 
 	_, exists := f.items[id]
-
-	delete(f.items, id)
+	if exists {
+		f.deleteFilter(id)
+	}
 
 	return exists
 }
@@ -76,7 +107,7 @@ func (f *filterMap) cleanup(cutoff time.Time) {
 	// Iterate over filters and remove which haven't been used in a while
 	for key, filterItem := range f.items {
 		if filterItem.GetLastUsed().Before(cutoff) {
-			delete(f.items, key)
+			f.deleteFilter(key)
 		}
 	}
 }
@@ -11,18 +11,29 @@ import (
 	"github.com/gnolang/tx-indexer/serve/conns"
 	"github.com/gnolang/tx-indexer/serve/filters/filter"
 	filterSubscription "github.com/gnolang/tx-indexer/serve/filters/subscription"
+	"github.com/gnolang/tx-indexer/serve/methods"
 	"github.com/gnolang/tx-indexer/storage"
 	commonTypes "github.com/gnolang/tx-indexer/types"
 )
 
+const (
+	// MaxFilterLogsRange is the maximum number of blocks the event filter logs can span
+	MaxFilterLogsRange = 10_000
+
+	// MaxFilterLogs is the maximum number of events the event filter logs can contain
+	MaxFilterLogs = 10_000
+)
+
 // Manager manages all running filters
 type Manager struct {
-	ctx             context.Context
-	storage         storage.Storage // temporarily unused
-	events          Events
-	filters         *filterMap
-	subscriptions   *subscriptionMap
-	cleanupInterval time.Duration
+	ctx              context.Context
+	storage          storage.Storage
+	events           Events
+	filters          *filterMap
+	subscriptions    *subscriptionMap
+	cleanupInterval  time.Duration
+	maxFilterChanges int
+	maxClientFilters int
 }
 
 // NewFilterManager creates new filter manager object
@@ -33,12 +44,14 @@ func NewFilterManager(
 	opts ...Option,
 ) *Manager {
 	filterManager := &Manager{
-		ctx:             ctx,
-		storage:         storage,
-		events:          events,
-		filters:         newFilterMap(),
-		subscriptions:   newSubMap(),
-		cleanupInterval: 5 * time.Minute,
+		ctx:              ctx,
+		storage:          storage,
+		events:           events,
+		filters:          newFilterMap(),
+		subscriptions:    newSubMap(),
+		cleanupInterval:  DefaultCleanupInterval,
+		maxFilterChanges: DefaultMaxFilterChanges,
+		maxClientFilters: DefaultMaxClientFilters,
 	}
 
 	// Apply the options
@@ -55,18 +68,123 @@ func NewFilterManager(
 	return filterManager
 }
 
-// NewBlockFilter creates a new block filter, and returns the corresponding ID
-func (f *Manager) NewBlockFilter() string {
-	blockFilter := filter.NewBlockFilter()
+// NewBlockFilter creates a new block filter owned by the client, and returns the corresponding ID
+func (f *Manager) NewBlockFilter(clientID string) (string, error) {
+	blockFilter := filter.NewBlockFilter(f.filterOptions()...)
+
+	return f.filters.newFilter(clientID, blockFilter, f.maxClientFilters)
+}
+
+// NewTxFilter creates a new transaction filter owned by the client, and returns the corresponding ID
+func (f *Manager) NewTxFilter(clientID string, options filter.TxFilterOption) (string, error) {
+	txFilter := filter.NewTxFilter(options, f.filterOptions()...)
+
+	return f.filters.newFilter(clientID, txFilter, f.maxClientFilters)
+}
+
+// NewEventFilter creates a new Gno event filter owned by the client, and returns the corresponding ID.
+// Filters without a starting block start from the latest indexed block
+func (f *Manager) NewEventFilter(clientID string, options filter.EventFilterOption) (string, error) {
+	if options.FromBlock == nil {
+		latest, err := f.storage.GetLatestHeight()
+		if err != nil {
+			return "", fmt.Errorf("unable to fetch latest height, %w", err)
+		}
+
+		options.FromBlock = &latest
+	}
+
+	eventFilter := filter.NewEventFilter(options, f.filterOptions()...)
 
-	return f.filters.newFilter(blockFilter)
+	return f.filters.newFilter(clientID, eventFilter, f.maxClientFilters)
 }
 
-// NewTransactionFilter creates a new Transaction filter, and returns the corresponding ID
-func (f *Manager) NewTxFilter(options filter.TxFilterOption) string {
-	txFilter := filter.NewTxFilter(options)
+// filterOptions returns the options the filters are created with
+func (f *Manager) filterOptions() []filter.Option {
+	if f.maxFilterChanges <= 0 {
+		return nil
+	}
 
-	return f.filters.newFilter(txFilter)
+	return []filter.Option{
+		filter.WithBufferLimit(f.maxFilterChanges, f.storage),
+	}
+}
+
+// GetFilterLogs returns all the events matching the event filter
+// within its block range (up to the latest block if open-ended), from storage.
+// The range can span at most MaxFilterLogsRange blocks, and contain at most MaxFilterLogs events
+func (f *Manager) GetFilterLogs(id string) ([]*methods.Event, error) {
+	filterItem, err := f.GetFilter(id)
+	if err != nil {
+		return nil, err
+	}
+
+	eventFilter, ok := filterItem.(*filter.EventFilter)
+	if !ok {
+		return nil, fmt.Errorf("%w, id: %s", ErrInvalidFilterType, id)
+	}
+
+	fromBlock, rangeEnd := eventFilter.BlockRange()
+
+	var toBlock uint64
+
+	if rangeEnd != nil {
+		toBlock = *rangeEnd
+	} else {
+		latest, err := f.storage.GetLatestHeight()
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch latest height, %w", err)
+		}
+
+		toBlock = latest
+	}
+
+	events := make([]*methods.Event, 0)
+
+	if toBlock < fromBlock {
+		// The filter starts past the latest block
+		return events, nil
+	}
+
+	if toBlock-fromBlock >= MaxFilterLogsRange {
+		return nil, fmt.Errorf(
+			"%w (%d - %d), maximum is %d blocks",
+			ErrRangeTooLarge,
+			fromBlock,
+			toBlock,
+			MaxFilterLogsRange,
+		)
+	}
+
+	it, err := f.storage.TxIterator(fromBlock, toBlock, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("unable to iterate transactions, %w", err)
+	}
+	defer it.Close()
+
+	for it.Next() {
+		tx, err := it.Value()
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch transaction, %w", err)
+		}
+
+		// A 0 upper bound leaves the iterator unbounded
+		if uint64(tx.Height) > toBlock {
+			break
+		}
+
+		events = append(events, eventFilter.MatchingEvents(tx)...)
+
+		if len(events) > MaxFilterLogs {
+			return nil, fmt.Errorf("%w, maximum is %d events", ErrTooManyLogs, MaxFilterLogs)
+		}
+	}
+
+	if err := it.Error(); err != nil {
+		return nil, fmt.Errorf("unable to iterate transactions, %w", err)
+	}
+
+	return events, nil
 }
 
 // UninstallFilter removes a filter from the filter map using its ID.
@@ -80,9 +198,16 @@ func (f *Manager) NewBlockSubscription(conn conns.WSConnection) string {
 	return f.newSubscription(filterSubscription.NewBlockSubscription(conn))
 }
 
-// NewTransactionSubscription creates a new transaction (new transactions) subscription (over WS)
-func (f *Manager) NewTransactionSubscription(conn conns.WSConnection) string {
-	return f.newSubscription(filterSubscription.NewTransactionSubscription(conn))
+// NewTransactionSubscription creates a new transaction (new transactions) subscription (over WS).
+// The pushed transactions are narrowed down by the filter, if set
+func (f *Manager) NewTransactionSubscription(conn conns.WSConnection, txFilter *filter.SubscriptionFilter) string {
+	return f.newSubscription(filterSubscription.NewTransactionSubscription(conn, txFilter))
+}
+
+// NewEventSubscription creates a new Gno event (new events) subscription (over WS).
+// The pushed events are narrowed down by the filter, if set
+func (f *Manager) NewEventSubscription(conn conns.WSConnection, eventFilter *filter.SubscriptionFilter) string {
+	return f.newSubscription(filterSubscription.NewEventSubscription(conn, eventFilter))
 }
 
 // NewGasPriceSubscription creates gas fee subscriptions for blocks with transactions (over WS)
@@ -128,17 +253,34 @@ func (f *Manager) subscribeToEvents() {
 					// Send events to all `newHeads` subscriptions
 					f.subscriptions.sendEvent(filterSubscription.NewHeadsEvent, newBlock.Block)
 
-					// Send an event to the `newGasPrice` subscription when creating a block with transactions
-					if len(newBlock.Block.Txs) > 0 {
-						f.subscriptions.sendEvent(filterSubscription.NewGasPriceEvent, newBlock.Block)
+					// Send an event to the `newGasPrice` subscription when creating a block with transactions.
+					// The gas prices are computed once from the indexed fee summary,
+					// and shared by all the subscriptions
+					if newBlock.Fees != nil {
+						fees := []*commonTypes.BlockFees{newBlock.Fees}
+
+						if gasPrices, err := methods.GetGasPricesByFees(fees); err == nil {
+							f.subscriptions.sendEvent(filterSubscription.NewGasPriceEvent, &filterSubscription.BlockGasPrices{
+								Height:    newBlock.Block.Height,
+								GasPrices: gasPrices,
+							})
+						}
 					}
 
 					for _, txResult := range newBlock.Results {
 						// Apply transaction to filters
 						f.updateFiltersWithTxResult(txResult)
 
-						// Send events to all `newHeads` subscriptions
+						// Send events to all `newTransactions` subscriptions
 						f.subscriptions.sendEvent(filterSubscription.NewTransactionsEvent, txResult)
+
+						// Send the emitted Gno events to all `newEvents` subscriptions
+						for _, event := range methods.GetEventsByTx(txResult) {
+							f.subscriptions.sendEvent(filterSubscription.NewEventsEvent, &filterSubscription.TxEvent{
+								Tx:    txResult,
+								Event: event,
+							})
+						}
 					}
 				}
 			}
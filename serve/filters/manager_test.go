@@ -2,10 +2,13 @@ package filters
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/gnolang/gno/gnovm/stdlibs/chain"
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
 	tm2Types "github.com/gnolang/gno/tm2/pkg/bft/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -13,6 +16,7 @@ import (
 	"github.com/gnolang/tx-indexer/events"
 	"github.com/gnolang/tx-indexer/internal/mock"
 	"github.com/gnolang/tx-indexer/serve/filters/filter"
+	"github.com/gnolang/tx-indexer/storage"
 	"github.com/gnolang/tx-indexer/types"
 )
 
@@ -45,7 +49,8 @@ func Test_BlockFilters(t *testing.T) {
 	)
 
 	// Create block filter
-	blockFilterID := filterManager.NewBlockFilter()
+	blockFilterID, err := filterManager.NewBlockFilter("")
+	require.NoError(t, err)
 
 	// Fetch the filter
 	blockFilter, err := filterManager.GetFilter(blockFilterID)
@@ -95,7 +100,9 @@ func Test_NewBlockEvents(t *testing.T) {
 	)
 
 	// Create block filter
-	id := filterManager.NewBlockFilter()
+	id, err := filterManager.NewBlockFilter("")
+	require.NoError(t, err)
+
 	defer filterManager.UninstallFilter(id)
 
 	for _, block := range blocks {
@@ -130,7 +137,8 @@ func Test_NewBlockEvents(t *testing.T) {
 				require.Nil(t, err)
 
 				// Get changes
-				changes := blockFilter.GetChanges()
+				changes, err := blockFilter.GetChanges()
+				require.NoError(t, err)
 
 				if len(changes) == 0 {
 					continue
@@ -156,6 +164,41 @@ func Test_NewBlockEvents(t *testing.T) {
 	}
 }
 
+func Test_MaxClientFilters(t *testing.T) {
+	t.Parallel()
+
+	// Create filter manager
+	filterManager := NewFilterManager(
+		context.Background(),
+		&mock.Storage{},
+		events.NewManager(),
+		WithMaxClientFilters(2),
+	)
+
+	ids := make([]string, 0, 2)
+
+	for range 2 {
+		id, err := filterManager.NewBlockFilter("client")
+		require.NoError(t, err)
+
+		ids = append(ids, id)
+	}
+
+	// Make sure the client can't go over the limit
+	_, err := filterManager.NewTxFilter("client", filter.TxFilterOption{})
+	require.ErrorIs(t, err, ErrTooManyFilters)
+
+	// Make sure other clients are not affected
+	_, err = filterManager.NewBlockFilter("other client")
+	require.NoError(t, err)
+
+	// Make sure uninstalled filters free up the limit
+	require.True(t, filterManager.UninstallFilter(ids[0]))
+
+	_, err = filterManager.NewBlockFilter("client")
+	require.NoError(t, err)
+}
+
 func Test_FilterCleanup(t *testing.T) {
 	t.Parallel()
 
@@ -168,7 +211,8 @@ func Test_FilterCleanup(t *testing.T) {
 	)
 
 	// Create block filter
-	id := filterManager.NewBlockFilter()
+	id, err := filterManager.NewBlockFilter("")
+	require.NoError(t, err)
 
 	var (
 		capturedErr error
@@ -204,3 +248,236 @@ func Test_FilterCleanup(t *testing.T) {
 
 	require.Error(t, capturedErr)
 }
+
+// txIterator iterates over the given transactions
+type txIterator struct {
+	txs   []*tm2Types.TxResult
+	index int
+}
+
+func (i *txIterator) Next() bool {
+	if i.index >= len(i.txs) {
+		return false
+	}
+
+	i.index++
+
+	return true
+}
+
+func (i *txIterator) Error() error {
+	return nil
+}
+
+func (i *txIterator) Value() (*tm2Types.TxResult, error) {
+	return i.txs[i.index-1], nil
+}
+
+func (i *txIterator) Close() error {
+	return nil
+}
+
+func Test_EventFilterLogs(t *testing.T) {
+	t.Parallel()
+
+	var (
+		latestHeight uint64 = 5
+
+		txs = []*tm2Types.TxResult{
+			{
+				Height: 5,
+				Tx:     []byte("tx"),
+				Response: abci.ResponseDeliverTx{
+					ResponseBase: abci.ResponseBase{
+						Events: []abci.Event{
+							chain.Event{Type: "Approval", PkgPath: "gno.land/r/demo/foo20"},
+							chain.Event{Type: "Transfer", PkgPath: "gno.land/r/demo/foo20"},
+						},
+					},
+				},
+			},
+		}
+
+		mockStorage = &mock.Storage{
+			GetLatestSavedHeightFn: func() (uint64, error) {
+				return latestHeight, nil
+			},
+			TxIteratorFn: func(
+				fromBlockNum,
+				toBlockNum uint64,
+				_,
+				_ uint32,
+			) (storage.Iterator[*tm2Types.TxResult], error) {
+				// Make sure the filter starts at the latest height,
+				// and the open-ended range ends at the latest height
+				assert.Equal(t, latestHeight, fromBlockNum)
+				assert.Equal(t, latestHeight, toBlockNum)
+
+				return &txIterator{txs: txs}, nil
+			},
+		}
+	)
+
+	filterManager := NewFilterManager(
+		context.Background(),
+		mockStorage,
+		events.NewManager(),
+	)
+
+	id, err := filterManager.NewEventFilter("", filter.EventFilterOption{
+		Type: "Transfer",
+	})
+	require.NoError(t, err)
+
+	logs, err := filterManager.GetFilterLogs(id)
+	require.NoError(t, err)
+
+	require.Len(t, logs, 1)
+	assert.Equal(t, "Transfer", logs[0].Type)
+	assert.Equal(t, uint32(1), logs[0].EventIndex)
+
+	// Make sure only event filters have logs
+	blockFilterID, err := filterManager.NewBlockFilter("")
+	require.NoError(t, err)
+
+	_, err = filterManager.GetFilterLogs(blockFilterID)
+	assert.ErrorIs(t, err, ErrInvalidFilterType)
+
+	_, err = filterManager.GetFilterLogs("unknown")
+	assert.ErrorIs(t, err, ErrFilterNotFound)
+}
+
+func Test_EventFilterLogs_Limits(t *testing.T) {
+	t.Parallel()
+
+	var (
+		latestHeight uint64 = 2 * MaxFilterLogsRange
+
+		genesisBlock uint64
+		rangeStart   = latestHeight - MaxFilterLogsRange + 1
+		futureBlock  = latestHeight + 1
+
+		// Every transaction emits a matching event
+		tx = &tm2Types.TxResult{
+			Height: int64(latestHeight),
+			Tx:     []byte("tx"),
+			Response: abci.ResponseDeliverTx{
+				ResponseBase: abci.ResponseBase{
+					Events: []abci.Event{
+						chain.Event{Type: "Transfer", PkgPath: "gno.land/r/demo/foo20"},
+					},
+				},
+			},
+		}
+
+		txs = make([]*tm2Types.TxResult, MaxFilterLogs+1)
+	)
+
+	for index := range txs {
+		txs[index] = tx
+	}
+
+	filterManager := NewFilterManager(
+		context.Background(),
+		&mock.Storage{
+			GetLatestSavedHeightFn: func() (uint64, error) {
+				return latestHeight, nil
+			},
+			TxIteratorFn: func(_, _ uint64, _, _ uint32) (storage.Iterator[*tm2Types.TxResult], error) {
+				return &txIterator{txs: txs}, nil
+			},
+		},
+		events.NewManager(),
+	)
+
+	// Make sure open-ended ranges are capped at the latest height
+	id, err := filterManager.NewEventFilter("", filter.EventFilterOption{
+		FromBlock: &genesisBlock,
+	})
+	require.NoError(t, err)
+
+	_, err = filterManager.GetFilterLogs(id)
+	require.ErrorIs(t, err, ErrRangeTooLarge)
+
+	assert.Contains(t, err.Error(), fmt.Sprintf("(0 - %d)", latestHeight))
+
+	// Make sure the number of events is capped
+	id, err = filterManager.NewEventFilter("", filter.EventFilterOption{
+		FromBlock: &rangeStart,
+		ToBlock:   &latestHeight,
+	})
+	require.NoError(t, err)
+
+	_, err = filterManager.GetFilterLogs(id)
+	require.ErrorIs(t, err, ErrTooManyLogs)
+
+	// Make sure filters starting past the latest height have no events
+	id, err = filterManager.NewEventFilter("", filter.EventFilterOption{
+		FromBlock: &futureBlock,
+	})
+	require.NoError(t, err)
+
+	logs, err := filterManager.GetFilterLogs(id)
+	require.NoError(t, err)
+
+	assert.Empty(t, logs)
+}
+
+func Test_EventFilterLogs_GenesisRange(t *testing.T) {
+	t.Parallel()
+
+	var (
+		latestHeight uint64 = 2 * MaxFilterLogsRange
+
+		genesisBlock uint64
+
+		newTx = func(height int64) *tm2Types.TxResult {
+			return &tm2Types.TxResult{
+				Height: height,
+				Tx:     []byte("tx"),
+				Response: abci.ResponseDeliverTx{
+					ResponseBase: abci.ResponseBase{
+						Events: []abci.Event{
+							chain.Event{Type: "Transfer", PkgPath: "gno.land/r/demo/foo20"},
+						},
+					},
+				},
+			}
+		}
+
+		// The storage iterator is unbounded for a 0 upper bound
+		txs = []*tm2Types.TxResult{
+			newTx(0),
+			newTx(1),
+			newTx(int64(latestHeight)),
+		}
+	)
+
+	filterManager := NewFilterManager(
+		context.Background(),
+		&mock.Storage{
+			GetLatestSavedHeightFn: func() (uint64, error) {
+				return latestHeight, nil
+			},
+			TxIteratorFn: func(fromBlockNum, _ uint64, _, _ uint32) (storage.Iterator[*tm2Types.TxResult], error) {
+				assert.Equal(t, genesisBlock, fromBlockNum)
+
+				return &txIterator{txs: txs}, nil
+			},
+		},
+		events.NewManager(),
+	)
+
+	// Make sure an explicit 0 upper bound covers only the genesis block
+	id, err := filterManager.NewEventFilter("", filter.EventFilterOption{
+		FromBlock: &genesisBlock,
+		ToBlock:   &genesisBlock,
+	})
+	require.NoError(t, err)
+
+	logs, err := filterManager.GetFilterLogs(id)
+	require.NoError(t, err)
+
+	require.Len(t, logs, 1)
+	assert.Equal(t, int64(0), logs[0].Height)
+}
@@ -4,6 +4,20 @@ import (
 	"time"
 )
 
+const (
+	// DefaultCleanupInterval is the default interval after which
+	// unused (not polled) filters are cleaned up
+	DefaultCleanupInterval = 5 * time.Minute
+
+	// DefaultMaxFilterChanges is the default maximum amount
+	// of changes a filter buffers between polls
+	DefaultMaxFilterChanges = 10_000
+
+	// DefaultMaxClientFilters is the default maximum
+	// amount of filters a single client can own
+	DefaultMaxClientFilters = 100
+)
+
 type Option func(*Manager)
 
 // WithCleanupInterval creates a filter manager with the specified
@@ -13,3 +27,20 @@ func WithCleanupInterval(interval time.Duration) Option {
 		manager.cleanupInterval = interval
 	}
 }
+
+// WithMaxFilterChanges sets the maximum amount of changes a filter buffers
+// between polls. Once the buffer is full, the following changes are recovered
+// from storage when the filter is polled. The buffers are unbounded if 0
+func WithMaxFilterChanges(maxChanges int) Option {
+	return func(manager *Manager) {
+		manager.maxFilterChanges = maxChanges
+	}
+}
+
+// WithMaxClientFilters sets the maximum amount of filters
+// a single client can own. The filters are unlimited if 0
+func WithMaxClientFilters(maxFilters int) Option {
+	return func(manager *Manager) {
+		manager.maxClientFilters = maxFilters
+	}
+}
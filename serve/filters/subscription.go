@@ -9,6 +9,7 @@ import (
 
 type subscription interface {
 	GetType() events.Type
+	Matches(data any) bool
 	WriteResponse(id string, data any) error
 }
 
@@ -70,6 +71,11 @@ func (sm *subscriptionMap) sendEvent(eventType events.Type, data any) {
 		go func(id string) {
 			defer wg.Done()
 
+			// Filtered subscriptions are evaluated server-side
+			if !sub.Matches(data) {
+				return
+			}
+
 			if err := sub.WriteResponse(id, data); err != nil {
 				markInvalid(id)
 			}
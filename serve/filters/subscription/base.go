@@ -1,7 +1,11 @@
 package subscription
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/gnolang/gno/tm2/pkg/bft/types"
+
 	"github.com/gnolang/tx-indexer/serve/conns"
 )
 
@@ -18,3 +22,35 @@ func newBaseSubscription(conn conns.WSConnection) *baseSubscription {
 }
 
 func (b *baseSubscription) WriteResponse(_ *types.Block) error { return nil }
+
+// Matches returns a flag indicating if the data should be pushed to
+// the subscription. Unfiltered subscriptions receive all the data
+func (b *baseSubscription) Matches(_ any) bool { return true }
+
+// writeEvent pushes out the data to the connection,
+// tagged with the event ID if the connection supports it
+func (b *baseSubscription) writeEvent(eventID string, data any) error {
+	if conn, ok := b.conn.(conns.EventConnection); ok {
+		return conn.WriteEvent(eventID, data)
+	}
+
+	return b.conn.WriteData(data)
+}
+
+// BlockEventID returns the ID of the event carrying
+// the data of the block at the given height
+func BlockEventID(height int64) string {
+	return strconv.FormatInt(height, 10)
+}
+
+// TxEventID returns the ID of the event carrying
+// the data of the transaction at the given height and index
+func TxEventID(height int64, index uint32) string {
+	return fmt.Sprintf("%d-%d", height, index)
+}
+
+// GnoEventID returns the ID of the event carrying the Gno event
+// at the given index, emitted by the transaction at the given height and index
+func GnoEventID(height int64, index, eventIndex uint32) string {
+	return fmt.Sprintf("%d-%d-%d", height, index, eventIndex)
+}
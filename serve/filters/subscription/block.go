@@ -41,5 +41,8 @@ func (b *BlockSubscription) WriteResponse(id string, data any) error {
 		return err
 	}
 
-	return b.conn.WriteData(spec.NewJSONSubscribeResponse(id, encodedBlock))
+	return b.writeEvent(
+		BlockEventID(block.Height),
+		spec.NewJSONSubscribeResponse(id, encodedBlock),
+	)
 }
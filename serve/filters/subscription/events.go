@@ -0,0 +1,70 @@
+package subscription
+
+import (
+	"fmt"
+
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+
+	"github.com/gnolang/tx-indexer/events"
+	"github.com/gnolang/tx-indexer/serve/conns"
+	"github.com/gnolang/tx-indexer/serve/filters/filter"
+	"github.com/gnolang/tx-indexer/serve/methods"
+	"github.com/gnolang/tx-indexer/serve/spec"
+)
+
+const (
+	NewEventsEvent = "newEvents"
+)
+
+// TxEvent is a Gno event, along with the transaction that emitted it
+type TxEvent struct {
+	Tx    *types.TxResult
+	Event *methods.Event
+}
+
+// EventSubscription is the new-events type
+// subscription, for Gno events
+type EventSubscription struct {
+	*baseSubscription
+
+	// filter narrows down the pushed events, if set
+	filter *filter.SubscriptionFilter
+}
+
+func NewEventSubscription(
+	conn conns.WSConnection,
+	filter *filter.SubscriptionFilter,
+) *EventSubscription {
+	return &EventSubscription{
+		baseSubscription: newBaseSubscription(conn),
+		filter:           filter,
+	}
+}
+
+func (b *EventSubscription) GetType() events.Type {
+	return NewEventsEvent
+}
+
+func (b *EventSubscription) Matches(data any) bool {
+	if b.filter == nil {
+		return true
+	}
+
+	txEvent, ok := data.(*TxEvent)
+
+	return ok && b.filter.MatchEvent(txEvent.Tx, txEvent.Event)
+}
+
+func (b *EventSubscription) WriteResponse(id string, data any) error {
+	txEvent, ok := data.(*TxEvent)
+	if !ok {
+		return fmt.Errorf("unable to cast event, %s", data)
+	}
+
+	event := txEvent.Event
+
+	return b.writeEvent(
+		GnoEventID(event.Height, event.Index, event.EventIndex),
+		spec.NewJSONSubscribeResponse(id, event),
+	)
+}
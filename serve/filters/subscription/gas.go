@@ -3,7 +3,6 @@ package subscription
 import (
 	"fmt"
 
-	"github.com/gnolang/gno/tm2/pkg/bft/types"
 	"github.com/gnolang/tx-indexer/events"
 	"github.com/gnolang/tx-indexer/serve/conns"
 	"github.com/gnolang/tx-indexer/serve/methods"
@@ -14,6 +13,12 @@ const (
 	NewGasPriceEvent = "newGasPrice"
 )
 
+// BlockGasPrices are the gas prices paid in the block at the given height
+type BlockGasPrices struct {
+	GasPrices []*methods.GasPrice
+	Height    int64
+}
+
 // GasPriceSubscription is the new-transactions type
 // subscription
 type GasPriceSubscription struct {
@@ -31,15 +36,13 @@ func (b *GasPriceSubscription) GetType() events.Type {
 }
 
 func (b *GasPriceSubscription) WriteResponse(id string, data any) error {
-	block, ok := data.(*types.Block)
+	gasPrices, ok := data.(*BlockGasPrices)
 	if !ok {
-		return fmt.Errorf("unable to cast txResult, %s", data)
-	}
-
-	gasPrices, err := methods.GetGasPricesByBlock(block)
-	if err != nil {
-		return err
+		return fmt.Errorf("unable to cast gas prices, %s", data)
 	}
 
-	return b.conn.WriteData(spec.NewJSONSubscribeResponse(id, gasPrices))
+	return b.writeEvent(
+		BlockEventID(gasPrices.Height),
+		spec.NewJSONSubscribeResponse(id, gasPrices.GasPrices),
+	)
 }
@@ -3,7 +3,6 @@ package subscription
 import (
 	"testing"
 
-	"github.com/gnolang/gno/tm2/pkg/bft/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -18,8 +17,14 @@ func TestGasPriceSubscription_WriteResponse(t *testing.T) {
 	var (
 		capturedWrite any
 
-		mockBlock     = &types.Block{}
-		mockGasPrices = []*methods.GasPrice{}
+		mockGasPrices = []*methods.GasPrice{
+			{
+				Denom:   "ugnot",
+				Low:     1,
+				Average: 2,
+				High:    3,
+			},
+		}
 	)
 
 	expectedGasPricesResponse := spec.NewJSONSubscribeResponse("", mockGasPrices)
@@ -36,7 +41,10 @@ func TestGasPriceSubscription_WriteResponse(t *testing.T) {
 	gasPriceSubscription := NewGasPriceSubscription(mockConn)
 
 	// Write the response
-	require.NoError(t, gasPriceSubscription.WriteResponse("", mockBlock))
+	require.NoError(t, gasPriceSubscription.WriteResponse("", &BlockGasPrices{
+		Height:    10,
+		GasPrices: mockGasPrices,
+	}))
 
 	// Make sure the captured data matches
 	require.NotNil(t, capturedWrite)
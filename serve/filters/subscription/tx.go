@@ -7,6 +7,7 @@ import (
 	"github.com/gnolang/tx-indexer/events"
 	"github.com/gnolang/tx-indexer/serve/conns"
 	"github.com/gnolang/tx-indexer/serve/encode"
+	"github.com/gnolang/tx-indexer/serve/filters/filter"
 	"github.com/gnolang/tx-indexer/serve/spec"
 )
 
@@ -18,11 +19,18 @@ const (
 // subscription
 type TransactionSubscription struct {
 	*baseSubscription
+
+	// filter narrows down the pushed transactions, if set
+	filter *filter.SubscriptionFilter
 }
 
-func NewTransactionSubscription(conn conns.WSConnection) *TransactionSubscription {
+func NewTransactionSubscription(
+	conn conns.WSConnection,
+	filter *filter.SubscriptionFilter,
+) *TransactionSubscription {
 	return &TransactionSubscription{
 		baseSubscription: newBaseSubscription(conn),
+		filter:           filter,
 	}
 }
 
@@ -30,6 +38,16 @@ func (b *TransactionSubscription) GetType() events.Type {
 	return NewTransactionsEvent
 }
 
+func (b *TransactionSubscription) Matches(data any) bool {
+	if b.filter == nil {
+		return true
+	}
+
+	tx, ok := data.(*types.TxResult)
+
+	return ok && b.filter.MatchTx(tx)
+}
+
 func (b *TransactionSubscription) WriteResponse(id string, data any) error {
 	tx, ok := data.(*types.TxResult)
 	if !ok {
@@ -41,5 +59,8 @@ func (b *TransactionSubscription) WriteResponse(id string, data any) error {
 		return err
 	}
 
-	return b.conn.WriteData(spec.NewJSONSubscribeResponse(id, encodedTx))
+	return b.writeEvent(
+		TxEventID(tx.Height, tx.Index),
+		spec.NewJSONSubscribeResponse(id, encodedTx),
+	)
 }
@@ -8,7 +8,13 @@ import (
 	"github.com/gnolang/tx-indexer/serve/filters/filter"
 )
 
-var ErrFilterNotFound = errors.New("filter not found")
+var (
+	ErrFilterNotFound    = errors.New("filter not found")
+	ErrInvalidFilterType = errors.New("invalid filter type")
+	ErrTooManyFilters    = errors.New("too many filters for the client")
+	ErrRangeTooLarge     = errors.New("block range too large")
+	ErrTooManyLogs       = errors.New("too many matching events, narrow down the filter")
+)
 
 // Events is the interface for event passing
 type Events interface {
@@ -31,7 +37,7 @@ type Filter interface {
 	UpdateLastUsed()
 
 	// GetChanges returns any filter changes (specific to the filter type)
-	GetChanges() []any
+	GetChanges() ([]any, error)
 
 	// UpdateWith updates the specific filter type with a event's data
 	UpdateWith(data any)
@@ -6,15 +6,134 @@ package graph
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql"
 	bfttypes "github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/tx-indexer/search"
 	"github.com/gnolang/tx-indexer/serve/graph/model"
+	"github.com/gnolang/tx-indexer/serve/methods"
 	"github.com/gnolang/tx-indexer/storage"
+	storageErrors "github.com/gnolang/tx-indexer/storage/errors"
 	"github.com/gnolang/tx-indexer/types"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
+// Transactions is the resolver for the transactions field.
+func (r *accountResolver) Transactions(ctx context.Context, obj *model.Account, first *int, after *string) (*model.TransactionConnection, error) {
+	size, err := pageSize(first)
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+
+	fromh, fromi, err := afterTxCursor(after)
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+
+	it, err := r.store.AccountTxIterator(obj.Address(), fromh, fromi)
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+
+	return txConnection(ctx, it, size)
+}
+
+// BeginBlockEvents is the resolver for the begin_block_events field.
+func (r *blockResolver) BeginBlockEvents(ctx context.Context, obj *model.Block) ([]model.Event, error) {
+	results, err := blockResults(r.store, obj)
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+
+	return model.NewEvents(results.BeginBlockEvents), nil
+}
+
+// EndBlockEvents is the resolver for the end_block_events field.
+func (r *blockResolver) EndBlockEvents(ctx context.Context, obj *model.Block) ([]model.Event, error) {
+	results, err := blockResults(r.store, obj)
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+
+	return model.NewEvents(results.EndBlockEvents), nil
+}
+
+// ValidatorUpdates is the resolver for the validator_updates field.
+func (r *blockResolver) ValidatorUpdates(ctx context.Context, obj *model.Block) ([]*model.ValidatorUpdate, error) {
+	results, err := blockResults(r.store, obj)
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+
+	return model.NewValidatorUpdates(results.ValidatorUpdates), nil
+}
+
+// ImportedBy is the resolver for the imported_by field.
+func (r *packageResolver) ImportedBy(ctx context.Context, obj *model.Package) ([]string, error) {
+	importers, err := packageImporters(r.store, obj.Path())
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+
+	return importers, nil
+}
+
+// TransitiveDependencies is the resolver for the transitive_dependencies field.
+func (r *packageResolver) TransitiveDependencies(ctx context.Context, obj *model.Package, maxDepth *int) ([]string, error) {
+	depth, err := importDepth(maxDepth)
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+
+	dependencies, err := walkImports(ctx, obj.Path(), depth, func(path string) ([]string, error) {
+		return packageImports(r.store, path)
+	})
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+
+	return dependencies, nil
+}
+
+// TransitiveDependents is the resolver for the transitive_dependents field.
+func (r *packageResolver) TransitiveDependents(ctx context.Context, obj *model.Package, maxDepth *int) ([]string, error) {
+	depth, err := importDepth(maxDepth)
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+
+	dependents, err := walkImports(ctx, obj.Path(), depth, func(path string) ([]string, error) {
+		return packageImporters(r.store, path)
+	})
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+
+	return dependents, nil
+}
+
+// Files is the resolver for the files field.
+func (r *packageResolver) Files(ctx context.Context, obj *model.Package) ([]*model.MemFile, error) {
+	tx, err := r.store.GetTx(uint64(obj.DeployHeight()), uint32(obj.DeployIndex()))
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+
+	return obj.LoadFiles(model.NewTransaction(tx)), nil
+}
+
+// DeployTransaction is the resolver for the deploy_transaction field.
+func (r *packageResolver) DeployTransaction(ctx context.Context, obj *model.Package) (*model.Transaction, error) {
+	tx, err := r.store.GetTx(uint64(obj.DeployHeight()), uint32(obj.DeployIndex()))
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+
+	return model.NewTransaction(tx), nil
+}
+
 // Transactions is the resolver for the transactions field.
 func (r *queryResolver) Transactions(ctx context.Context, filter model.TransactionFilter) ([]*model.Transaction, error) {
 	if filter.Hash != nil {
@@ -22,23 +141,434 @@ func (r *queryResolver) Transactions(ctx context.Context, filter model.Transacti
 		if err != nil {
 			return nil, gqlerror.Wrap(err)
 		}
-		return []*model.Transaction{model.NewTransaction(tx)}, nil
+		return []*model.Transaction{model.NewTransaction(tx)}, nil
+	}
+
+	it, err := r.
+		store.
+		TxIterator(
+			uint64(deref(filter.FromBlockHeight)),
+			uint64(deref(filter.ToBlockHeight)),
+			uint32(deref(filter.FromIndex)),
+			uint32(deref(filter.ToIndex)),
+		)
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+	defer it.Close()
+
+	var out []*model.Transaction
+	i := 0
+	for {
+		if i == maxElementsPerQuery {
+			graphql.AddErrorf(ctx, "max elements per query reached (%d)", maxElementsPerQuery)
+			return out, nil
+		}
+
+		if !it.Next() {
+			return out, it.Error()
+		}
+
+		select {
+		case <-ctx.Done():
+			graphql.AddError(ctx, ctx.Err())
+			return out, nil
+		default:
+			t, err := it.Value()
+			if err != nil {
+				graphql.AddError(ctx, err)
+				return out, nil
+			}
+
+			transaction := model.NewTransaction(t)
+			if !FilteredTransactionBy(transaction, filter) {
+				continue
+			}
+			out = append(out, transaction)
+			i++
+		}
+	}
+}
+
+// Blocks is the resolver for the blocks field.
+func (r *queryResolver) Blocks(ctx context.Context, filter model.BlockFilter) ([]*model.Block, error) {
+	it, err := r.
+		store.
+		BlockIterator(
+			uint64(deref(filter.FromHeight)),
+			uint64(deref(filter.ToHeight)),
+		)
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+	defer it.Close()
+
+	var out []*model.Block
+
+	i := 0
+	for {
+		if i == maxElementsPerQuery {
+			graphql.AddErrorf(ctx, "max elements per query reached (%d)", maxElementsPerQuery)
+			return out, nil
+		}
+
+		if !it.Next() {
+			return out, it.Error()
+		}
+
+		select {
+		case <-ctx.Done():
+			graphql.AddError(ctx, ctx.Err())
+			return out, nil
+		default:
+			b, err := it.Value()
+			if err != nil {
+				graphql.AddError(ctx, err)
+				return out, nil
+			}
+
+			block := model.NewBlock(b)
+			if !FilteredBlockBy(block, filter) {
+				continue
+			}
+
+			out = append(out, block)
+			i++
+		}
+	}
+}
+
+// LatestBlockHeight is the resolver for the latestBlockHeight field.
+func (r *queryResolver) LatestBlockHeight(ctx context.Context) (int, error) {
+	h, err := r.store.GetLatestHeight()
+	return int(h), err
+}
+
+// Account is the resolver for the account field.
+func (r *queryResolver) Account(ctx context.Context, address string) (*model.Account, error) {
+	account, err := r.store.GetAccount(address)
+	if errors.Is(err, storageErrors.ErrNotFound) {
+		//nolint:nilnil // Unknown accounts are not an error
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+
+	return model.NewAccount(account), nil
+}
+
+// Package is the resolver for the package field.
+func (r *queryResolver) Package(ctx context.Context, path string) (*model.Package, error) {
+	pkg, err := r.store.GetPackage(path)
+	if errors.Is(err, storageErrors.ErrNotFound) {
+		//nolint:nilnil // Unknown packages are not an error
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+
+	return model.NewPackage(pkg), nil
+}
+
+// Genesis is the resolver for the genesis field.
+func (r *queryResolver) Genesis(ctx context.Context) (*model.Genesis, error) {
+	genesis, err := r.store.GetGenesis()
+	if errors.Is(err, storageErrors.ErrNotFound) {
+		//nolint:nilnil // The genesis may not be indexed
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+
+	return model.NewGenesis(genesis), nil
+}
+
+// Search is the resolver for the search field.
+func (r *queryResolver) Search(ctx context.Context, query string, kinds []model.SearchKind, limit *int) ([]*model.SearchResult, error) {
+	size, err := searchLimit(limit)
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+
+	parsed, err := search.ParseQuery(query)
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+
+	indexerKinds := make([]types.SearchKind, 0, len(kinds))
+	for _, kind := range kinds {
+		indexerKinds = append(indexerKinds, kind.IndexerKind())
+	}
+
+	results, incomplete, err := search.Search(r.store, parsed, indexerKinds, size)
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+
+	if incomplete {
+		graphql.AddErrorf(ctx, "search terms too common, results may be incomplete")
+	}
+
+	out := make([]*model.SearchResult, 0, len(results))
+	for _, result := range results {
+		out = append(out, model.NewSearchResult(result))
+	}
+
+	return out, nil
+}
+
+// Lookup is the resolver for the lookup field.
+func (r *queryResolver) Lookup(ctx context.Context, term string) ([]model.LookupResult, error) {
+	matches, err := search.Lookup(r.store, term)
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+
+	out := make([]model.LookupResult, 0, len(matches))
+
+	for _, match := range matches {
+		switch {
+		case match.Block != nil:
+			out = append(out, model.NewBlock(match.Block))
+		case match.Tx != nil:
+			out = append(out, model.NewTransaction(match.Tx))
+		case match.Account != nil:
+			out = append(out, model.NewAccount(match.Account))
+		default:
+			out = append(out, model.NewPackage(match.Package))
+		}
+	}
+
+	return out, nil
+}
+
+// SuggestGasPrice is the resolver for the suggestGasPrice field.
+func (r *queryResolver) SuggestGasPrice(ctx context.Context, window *int, speed *model.InclusionSpeed, gasWanted *int) ([]*model.GasPriceSuggestion, error) {
+	if deref(window) < 0 {
+		return nil, gqlerror.Errorf("invalid window %d", deref(window))
+	}
+
+	if deref(gasWanted) < 0 {
+		return nil, gqlerror.Errorf("invalid gas wanted %d", deref(gasWanted))
+	}
+
+	inclusionSpeed := methods.SpeedStandard
+	if speed != nil {
+		inclusionSpeed = speed.MethodsSpeed()
+	}
+
+	suggestions, err := methods.SuggestGasPrice(
+		r.store,
+		uint64(deref(window)),
+		inclusionSpeed,
+		int64(deref(gasWanted)),
+	)
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+
+	out := make([]*model.GasPriceSuggestion, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		out = append(out, model.NewGasPriceSuggestion(suggestion))
+	}
+
+	return out, nil
+}
+
+// GetGasStats is the resolver for the getGasStats field.
+func (r *queryResolver) GetGasStats(ctx context.Context, pkgPath string, funcArg string, window *int) (*model.GasStats, error) {
+	if deref(window) < 0 {
+		return nil, gqlerror.Errorf("invalid window %d", deref(window))
+	}
+
+	stats, err := methods.GetGasStats(r.store, pkgPath, funcArg, uint64(deref(window)))
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+
+	return model.NewGasStats(stats), nil
+}
+
+// GetFeeHistory is the resolver for the getFeeHistory field.
+func (r *queryResolver) GetFeeHistory(ctx context.Context, fromHeight int, toHeight *int, resolution int) ([]*model.FeeHistoryBucket, error) {
+	if fromHeight < 0 || deref(toHeight) < 0 {
+		return nil, gqlerror.Errorf("invalid block range %d - %d", fromHeight, deref(toHeight))
+	}
+
+	if resolution < 1 {
+		return nil, gqlerror.Errorf("invalid resolution %d", resolution)
+	}
+
+	buckets, err := methods.GetFeeHistory(
+		r.store,
+		uint64(fromHeight),
+		uint64(deref(toHeight)),
+		time.Duration(resolution)*time.Second,
+	)
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+
+	out := make([]*model.FeeHistoryBucket, 0, len(buckets))
+	for _, bucket := range buckets {
+		out = append(out, model.NewFeeHistoryBucket(bucket))
+	}
+
+	return out, nil
+}
+
+// GetFailureReasons is the resolver for the getFailureReasons field.
+func (r *queryResolver) GetFailureReasons(ctx context.Context, pkgPath string, window *int, limit *int) (*model.RealmFailures, error) {
+	if deref(window) < 0 {
+		return nil, gqlerror.Errorf("invalid window %d", deref(window))
+	}
+
+	if deref(limit) < 0 {
+		return nil, gqlerror.Errorf("invalid limit %d", deref(limit))
+	}
+
+	failures, err := methods.GetFailureReasons(r.store, pkgPath, uint64(deref(window)), uint64(deref(limit)))
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+
+	return model.NewRealmFailures(failures), nil
+}
+
+// BalanceHistory is the resolver for the balanceHistory field.
+func (r *queryResolver) BalanceHistory(ctx context.Context, address string, denom string, fromHeight *int, toHeight *int) ([]*model.BalanceChange, error) {
+	if deref(fromHeight) < 0 || deref(toHeight) < 0 {
+		return nil, gqlerror.Errorf("invalid block range %d - %d", deref(fromHeight), deref(toHeight))
+	}
+
+	it, err := r.store.BalanceHistoryIterator(address, denom, uint64(deref(fromHeight)), uint64(deref(toHeight)))
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+	defer it.Close()
+
+	var out []*model.BalanceChange
+
+	i := 0
+	for {
+		if i == maxElementsPerQuery {
+			graphql.AddErrorf(ctx, "max elements per query reached (%d)", maxElementsPerQuery)
+			return out, nil
+		}
+
+		if !it.Next() {
+			return out, it.Error()
+		}
+
+		select {
+		case <-ctx.Done():
+			graphql.AddError(ctx, ctx.Err())
+			return out, nil
+		default:
+			delta, err := it.Value()
+			if err != nil {
+				graphql.AddError(ctx, err)
+				return out, nil
+			}
+
+			out = append(out, model.NewBalanceChange(delta))
+			i++
+		}
+	}
+}
+
+// Tokens is the resolver for the tokens field.
+func (r *queryResolver) Tokens(ctx context.Context) ([]*model.Token, error) {
+	it, err := r.store.TokenIterator()
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+	defer it.Close()
+
+	var out []*model.Token
+
+	i := 0
+	for {
+		if i == maxElementsPerQuery {
+			graphql.AddErrorf(ctx, "max elements per query reached (%d)", maxElementsPerQuery)
+			return out, nil
+		}
+
+		if !it.Next() {
+			return out, it.Error()
+		}
+
+		select {
+		case <-ctx.Done():
+			graphql.AddError(ctx, ctx.Err())
+			return out, nil
+		default:
+			token, err := it.Value()
+			if err != nil {
+				graphql.AddError(ctx, err)
+				return out, nil
+			}
+
+			out = append(out, model.NewToken(token))
+			i++
+		}
+	}
+}
+
+// TokenBalances is the resolver for the tokenBalances field.
+func (r *queryResolver) TokenBalances(ctx context.Context, address string) ([]*model.TokenBalance, error) {
+	it, err := r.store.TokenBalanceIterator(address)
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+	defer it.Close()
+
+	var out []*model.TokenBalance
+
+	i := 0
+	for {
+		if i == maxElementsPerQuery {
+			graphql.AddErrorf(ctx, "max elements per query reached (%d)", maxElementsPerQuery)
+			return out, nil
+		}
+
+		if !it.Next() {
+			return out, it.Error()
+		}
+
+		select {
+		case <-ctx.Done():
+			graphql.AddError(ctx, ctx.Err())
+			return out, nil
+		default:
+			balance, err := it.Value()
+			if err != nil {
+				graphql.AddError(ctx, err)
+				return out, nil
+			}
+
+			out = append(out, model.NewTokenBalance(balance))
+			i++
+		}
 	}
+}
 
-	it, err := r.
-		store.
-		TxIterator(
-			uint64(deref(filter.FromBlockHeight)),
-			uint64(deref(filter.ToBlockHeight)),
-			uint32(deref(filter.FromIndex)),
-			uint32(deref(filter.ToIndex)),
-		)
+// Collections is the resolver for the collections field.
+func (r *queryResolver) Collections(ctx context.Context) ([]*model.NFTCollection, error) {
+	it, err := r.store.NFTCollectionIterator()
 	if err != nil {
 		return nil, gqlerror.Wrap(err)
 	}
 	defer it.Close()
 
-	var out []*model.Transaction
+	var out []*model.NFTCollection
+
 	i := 0
 	for {
 		if i == maxElementsPerQuery {
@@ -55,36 +585,27 @@ func (r *queryResolver) Transactions(ctx context.Context, filter model.Transacti
 			graphql.AddError(ctx, ctx.Err())
 			return out, nil
 		default:
-			t, err := it.Value()
+			collection, err := it.Value()
 			if err != nil {
 				graphql.AddError(ctx, err)
 				return out, nil
 			}
 
-			transaction := model.NewTransaction(t)
-			if !FilteredTransactionBy(transaction, filter) {
-				continue
-			}
-			out = append(out, transaction)
+			out = append(out, model.NewNFTCollection(collection))
 			i++
 		}
 	}
 }
 
-// Blocks is the resolver for the blocks field.
-func (r *queryResolver) Blocks(ctx context.Context, filter model.BlockFilter) ([]*model.Block, error) {
-	it, err := r.
-		store.
-		BlockIterator(
-			uint64(deref(filter.FromHeight)),
-			uint64(deref(filter.ToHeight)),
-		)
+// NftsOwnedBy is the resolver for the nftsOwnedBy field.
+func (r *queryResolver) NftsOwnedBy(ctx context.Context, address string) ([]*model.NFT, error) {
+	it, err := r.store.NFTOwnerIterator(address)
 	if err != nil {
 		return nil, gqlerror.Wrap(err)
 	}
 	defer it.Close()
 
-	var out []*model.Block
+	var out []*model.NFT
 
 	i := 0
 	for {
@@ -102,31 +623,142 @@ func (r *queryResolver) Blocks(ctx context.Context, filter model.BlockFilter) ([
 			graphql.AddError(ctx, ctx.Err())
 			return out, nil
 		default:
-			b, err := it.Value()
+			nft, err := it.Value()
 			if err != nil {
 				graphql.AddError(ctx, err)
 				return out, nil
 			}
 
-			block := model.NewBlock(b)
-			if !FilteredBlockBy(block, filter) {
-				continue
+			out = append(out, model.NewNFT(nft))
+			i++
+		}
+	}
+}
+
+// NftHistory is the resolver for the nftHistory field.
+func (r *queryResolver) NftHistory(ctx context.Context, collection string, tokenID string) ([]*model.NFTEvent, error) {
+	it, err := r.store.NFTHistoryIterator(collection, tokenID)
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+	defer it.Close()
+
+	var out []*model.NFTEvent
+
+	i := 0
+	for {
+		if i == maxElementsPerQuery {
+			graphql.AddErrorf(ctx, "max elements per query reached (%d)", maxElementsPerQuery)
+			return out, nil
+		}
+
+		if !it.Next() {
+			return out, it.Error()
+		}
+
+		select {
+		case <-ctx.Done():
+			graphql.AddError(ctx, ctx.Err())
+			return out, nil
+		default:
+			event, err := it.Value()
+			if err != nil {
+				graphql.AddError(ctx, err)
+				return out, nil
 			}
 
-			out = append(out, block)
+			out = append(out, model.NewNFTEvent(event))
 			i++
 		}
 	}
 }
 
-// LatestBlockHeight is the resolver for the latestBlockHeight field.
-func (r *queryResolver) LatestBlockHeight(ctx context.Context) (int, error) {
-	h, err := r.store.GetLatestHeight()
-	return int(h), err
+// Validators is the resolver for the validators field.
+func (r *queryResolver) Validators(ctx context.Context, height *int) (*model.ValidatorSet, error) {
+	if deref(height) < 0 {
+		return nil, gqlerror.Errorf("invalid height %d", deref(height))
+	}
+
+	blockNum := uint64(deref(height))
+
+	if height == nil {
+		latestHeight, err := r.store.GetLatestHeight()
+		if err != nil {
+			return nil, gqlerror.Wrap(err)
+		}
+
+		blockNum = latestHeight
+	}
+
+	set, err := r.store.GetValidatorSet(blockNum)
+	if errors.Is(err, storageErrors.ErrNotFound) {
+		//nolint:nilnil // The validator set may not be indexed
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+
+	return model.NewValidatorSet(set), nil
+}
+
+// BlockSignatures is the resolver for the blockSignatures field.
+func (r *queryResolver) BlockSignatures(ctx context.Context, height int) (*model.BlockSignatures, error) {
+	if height < 0 {
+		return nil, gqlerror.Errorf("invalid height %d", height)
+	}
+
+	signatures, err := r.store.GetBlockSignatures(uint64(height))
+	if errors.Is(err, storageErrors.ErrNotFound) {
+		//nolint:nilnil // The block signatures may not be indexed
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+
+	return model.NewBlockSignatures(signatures), nil
+}
+
+// ValidatorUptime is the resolver for the validatorUptime field.
+func (r *queryResolver) ValidatorUptime(ctx context.Context, address string, window *int) (*model.ValidatorUptime, error) {
+	if deref(window) < 0 {
+		return nil, gqlerror.Errorf("invalid window %d", deref(window))
+	}
+
+	uptime, err := methods.GetValidatorUptime(r.store, address, uint64(deref(window)))
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+
+	return model.NewValidatorUptime(uptime), nil
+}
+
+// ProposerStats is the resolver for the proposerStats field.
+func (r *queryResolver) ProposerStats(ctx context.Context, window *int) ([]*model.ProposerStats, error) {
+	if deref(window) < 0 {
+		return nil, gqlerror.Errorf("invalid window %d", deref(window))
+	}
+
+	stats, err := methods.GetProposerStats(r.store, uint64(deref(window)))
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+
+	out := make([]*model.ProposerStats, 0, len(stats))
+	for _, proposer := range stats {
+		out = append(out, model.NewProposerStats(proposer))
+	}
+
+	return out, nil
 }
 
 // GetBlocks is the resolver for the getBlocks field.
 func (r *queryResolver) GetBlocks(ctx context.Context, where model.FilterBlock, order *model.BlockOrder) ([]*model.Block, error) {
+	normalizeBlockHashFilter(&where)
+
 	fromh, toh := where.MinMaxHeight()
 	dfromh := uint64(deref(fromh))
 	dtoh := uint64(deref(toh))
@@ -197,6 +829,8 @@ func (r *queryResolver) GetBlocks(ctx context.Context, where model.FilterBlock,
 
 // GetTransactions is the resolver for the getTransactions field.
 func (r *queryResolver) GetTransactions(ctx context.Context, where model.FilterTransaction, order *model.TransactionOrder) ([]*model.Transaction, error) {
+	normalizeTransactionHashFilter(&where)
+
 	// corner case
 	if where.Hash != nil &&
 		where.Hash.Eq != nil &&
@@ -295,6 +929,168 @@ func (r *queryResolver) GetTransactions(ctx context.Context, where model.FilterT
 	}
 }
 
+// Packages is the resolver for the packages field.
+func (r *queryResolver) Packages(ctx context.Context, where model.FilterPackage) ([]*model.Package, error) {
+	it, err := r.store.PackageIterator()
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+	defer it.Close()
+
+	var out []*model.Package
+
+	i := 0
+	for {
+		if i == maxElementsPerQuery {
+			graphql.AddErrorf(ctx, "max elements per query reached (%d)", maxElementsPerQuery)
+			return out, nil
+		}
+
+		if !it.Next() {
+			return out, it.Error()
+		}
+
+		select {
+		case <-ctx.Done():
+			graphql.AddError(ctx, ctx.Err())
+			return out, nil
+		default:
+			p, err := it.Value()
+			if err != nil {
+				graphql.AddError(ctx, err)
+				return out, nil
+			}
+
+			pkg := model.NewPackage(p)
+
+			if !where.Eval(pkg) {
+				continue
+			}
+
+			out = append(out, pkg)
+			i++
+		}
+	}
+}
+
+// Transfers is the resolver for the transfers field.
+func (r *queryResolver) Transfers(ctx context.Context, where model.FilterTransfer) ([]*model.Transfer, error) {
+	it, err := transferIterator(r.store, &where)
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+	defer it.Close()
+
+	var out []*model.Transfer
+
+	i := 0
+	for {
+		if i == maxElementsPerQuery {
+			graphql.AddErrorf(ctx, "max elements per query reached (%d)", maxElementsPerQuery)
+			return out, nil
+		}
+
+		if !it.Next() {
+			return out, it.Error()
+		}
+
+		select {
+		case <-ctx.Done():
+			graphql.AddError(ctx, ctx.Err())
+			return out, nil
+		default:
+			t, err := it.Value()
+			if err != nil {
+				graphql.AddError(ctx, err)
+				return out, nil
+			}
+
+			transfer := model.NewTransfer(t)
+
+			if !where.Eval(transfer) {
+				continue
+			}
+
+			out = append(out, transfer)
+			i++
+		}
+	}
+}
+
+// TokenTransfers is the resolver for the tokenTransfers field.
+func (r *queryResolver) TokenTransfers(ctx context.Context, where model.FilterTokenTransfer) ([]*model.TokenTransfer, error) {
+	it, err := tokenTransferIterator(r.store, &where)
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+	defer it.Close()
+
+	var out []*model.TokenTransfer
+
+	i := 0
+	for {
+		if i == maxElementsPerQuery {
+			graphql.AddErrorf(ctx, "max elements per query reached (%d)", maxElementsPerQuery)
+			return out, nil
+		}
+
+		if !it.Next() {
+			return out, it.Error()
+		}
+
+		select {
+		case <-ctx.Done():
+			graphql.AddError(ctx, ctx.Err())
+			return out, nil
+		default:
+			t, err := it.Value()
+			if err != nil {
+				graphql.AddError(ctx, err)
+				return out, nil
+			}
+
+			transfer := model.NewTokenTransfer(t)
+
+			if !where.Eval(transfer) {
+				continue
+			}
+
+			out = append(out, transfer)
+			i++
+		}
+	}
+}
+
+// PendingTransactions is the resolver for the pendingTransactions field.
+func (r *queryResolver) PendingTransactions(ctx context.Context, where model.FilterPendingTransaction) ([]*model.PendingTransaction, error) {
+	if r.pool == nil {
+		return nil, gqlerror.Wrap(errMempoolDisabled)
+	}
+
+	normalizePendingTransactionHashFilter(&where)
+
+	var out []*model.PendingTransaction
+
+	for _, pending := range r.pool.List() {
+		transaction := model.NewPendingTransaction(pending)
+		if where.Eval(transaction) {
+			out = append(out, transaction)
+		}
+	}
+
+	return out, nil
+}
+
+// Transaction is the resolver for the transaction field.
+func (r *searchResultResolver) Transaction(ctx context.Context, obj *model.SearchResult) (*model.Transaction, error) {
+	tx, err := r.store.GetTx(uint64(obj.BlockHeight()), uint32(obj.Index()))
+	if err != nil {
+		return nil, gqlerror.Wrap(err)
+	}
+
+	return model.NewTransaction(tx), nil
+}
+
 // Transactions is the resolver for the transactions field.
 func (r *subscriptionResolver) Transactions(ctx context.Context, filter model.TransactionFilter) (<-chan *model.Transaction, error) {
 	return handleChannel(ctx, r.manager, func(nb *types.NewBlock, c chan<- *model.Transaction) {
@@ -310,7 +1106,7 @@ func (r *subscriptionResolver) Transactions(ctx context.Context, filter model.Tr
 // Blocks is the resolver for the blocks field.
 func (r *subscriptionResolver) Blocks(ctx context.Context, filter model.BlockFilter) (<-chan *model.Block, error) {
 	return handleChannel(ctx, r.manager, func(nb *types.NewBlock, c chan<- *model.Block) {
-		block := model.NewBlock(nb.Block)
+		block := model.NewBlock(nb.Block).WithResults(nb.BlockResults)
 		if FilteredBlockBy(block, filter) {
 			c <- block
 		}
@@ -319,6 +1115,8 @@ func (r *subscriptionResolver) Blocks(ctx context.Context, filter model.BlockFil
 
 // GetTransactions is the resolver for the getTransactions field.
 func (r *subscriptionResolver) GetTransactions(ctx context.Context, where model.FilterTransaction) (<-chan *model.Transaction, error) {
+	normalizeTransactionHashFilter(&where)
+
 	return handleChannel(ctx, r.manager, func(nb *types.NewBlock, c chan<- *model.Transaction) {
 		for _, tx := range nb.Results {
 			transaction := model.NewTransaction(tx)
@@ -331,19 +1129,58 @@ func (r *subscriptionResolver) GetTransactions(ctx context.Context, where model.
 
 // GetBlocks is the resolver for the getBlocks field.
 func (r *subscriptionResolver) GetBlocks(ctx context.Context, where model.FilterBlock) (<-chan *model.Block, error) {
+	normalizeBlockHashFilter(&where)
+
 	return handleChannel(ctx, r.manager, func(nb *types.NewBlock, c chan<- *model.Block) {
-		block := model.NewBlock(nb.Block)
+		block := model.NewBlock(nb.Block).WithResults(nb.BlockResults)
 		if where.Eval(block) {
 			c <- block
 		}
 	}), nil
 }
 
+// PendingTransactions is the resolver for the pendingTransactions field.
+func (r *subscriptionResolver) PendingTransactions(ctx context.Context, where model.FilterPendingTransaction) (<-chan *model.PendingTransaction, error) {
+	if r.pool == nil {
+		return nil, gqlerror.Wrap(errMempoolDisabled)
+	}
+
+	normalizePendingTransactionHashFilter(&where)
+
+	return handleEventChannel(
+		ctx,
+		r.manager,
+		types.PendingTxEvent,
+		func(update *types.PendingTxUpdate, c chan<- *model.PendingTransaction) {
+			transaction := model.NewPendingTransaction(update.Tx)
+			if where.Eval(transaction) {
+				c <- transaction
+			}
+		},
+	), nil
+}
+
+// Account returns AccountResolver implementation.
+func (r *Resolver) Account() AccountResolver { return &accountResolver{r} }
+
+// Block returns BlockResolver implementation.
+func (r *Resolver) Block() BlockResolver { return &blockResolver{r} }
+
+// Package returns PackageResolver implementation.
+func (r *Resolver) Package() PackageResolver { return &packageResolver{r} }
+
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// SearchResult returns SearchResultResolver implementation.
+func (r *Resolver) SearchResult() SearchResultResolver { return &searchResultResolver{r} }
+
 // Subscription returns SubscriptionResolver implementation.
 func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
 
+type accountResolver struct{ *Resolver }
+type blockResolver struct{ *Resolver }
+type packageResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type searchResultResolver struct{ *Resolver }
 type subscriptionResolver struct{ *Resolver }
@@ -0,0 +1,32 @@
+package graph
+
+import (
+	"errors"
+
+	"github.com/gnolang/tx-indexer/serve/graph/model"
+	"github.com/gnolang/tx-indexer/storage"
+	storageErrors "github.com/gnolang/tx-indexer/storage/errors"
+	"github.com/gnolang/tx-indexer/types"
+)
+
+// blockResults returns the BeginBlock / EndBlock results of the given block,
+// either attached to it, or fetched from the storage.
+// Blocks without stored results have empty ones
+func blockResults(store storage.Storage, block *model.Block) (*types.BlockResults, error) {
+	if results := block.Results(); results != nil {
+		return results, nil
+	}
+
+	results, err := store.GetBlockResults(uint64(block.Height()))
+	if errors.Is(err, storageErrors.ErrNotFound) {
+		return &types.BlockResults{
+			Height: block.Height(),
+		}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
@@ -26,6 +26,36 @@ type Query {
    results and errors are returned.
    """
    getTransactions(where: FilterTransaction!, order: TransactionOrder): [Transaction!]
+
+   """
+   Retrieves a list of deployed Packages that match the given
+   where criteria, ordered by path. If the result is incomplete due to
+   errors, both partial results and errors are returned.
+   """
+   packages(where: FilterPackage!): [Package!]
+
+   """
+   Retrieves the native coin movements of successful Transactions
+   that match the given where criteria, ordered by height, Transaction index
+   and position. If the result is incomplete due to errors, both partial
+   results and errors are returned.
+   """
+   transfers(where: FilterTransfer!): [Transfer!]
+
+   """
+   Retrieves the GRC20 token movements of successful Transactions
+   that match the given where criteria, ordered by height, Transaction index
+   and event index. If the result is incomplete due to errors, both partial
+   results and errors are returned.
+   """
+   tokenTransfers(where: FilterTokenTransfer!): [TokenTransfer!]
+
+   """
+   Retrieves the Transactions tracked from the node mempool that match
+   the given where criteria, oldest first. Only available when mempool
+   tracking is enabled.
+   """
+   pendingTransactions(where: FilterPendingTransaction!): [PendingTransaction!]
 }
 
 type Subscription {
@@ -60,6 +90,14 @@ type Subscription {
   allowing subscribers to process or analyze new Blocks in real time.
   """
   getBlocks(where: FilterBlock!): Block!
+
+  """
+  Subscribes to status updates of the Transactions tracked from the node
+  mempool that match the provided filter criteria. An update is sent when a
+  Transaction is first seen, included in a Block, or dropped from the mempool.
+  Only available when mempool tracking is enabled.
+  """
+  pendingTransactions(where: FilterPendingTransaction!): PendingTransaction!
 }
 `
 
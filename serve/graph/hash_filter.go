@@ -0,0 +1,79 @@
+package graph
+
+import (
+	"github.com/gnolang/tx-indexer/serve/graph/model"
+	"github.com/gnolang/tx-indexer/types"
+)
+
+// normalizeTransactionHashFilter converts the hash equality conditions
+// of the transaction filter to standard base64, the format hashes are served in,
+// so hashes can also be filtered using URL-safe base64 or hex
+func normalizeTransactionHashFilter(where *model.FilterTransaction) {
+	if where == nil {
+		return
+	}
+
+	normalizeHashFilter(where.Hash)
+
+	for _, and := range where.And {
+		normalizeTransactionHashFilter(and)
+	}
+
+	for _, or := range where.Or {
+		normalizeTransactionHashFilter(or)
+	}
+
+	normalizeTransactionHashFilter(where.Not)
+}
+
+// normalizeBlockHashFilter converts the hash equality conditions
+// of the block filter to standard base64, the format hashes are served in,
+// so hashes can also be filtered using URL-safe base64 or hex
+func normalizeBlockHashFilter(where *model.FilterBlock) {
+	if where == nil {
+		return
+	}
+
+	normalizeHashFilter(where.Hash)
+
+	for _, and := range where.And {
+		normalizeBlockHashFilter(and)
+	}
+
+	for _, or := range where.Or {
+		normalizeBlockHashFilter(or)
+	}
+
+	normalizeBlockHashFilter(where.Not)
+}
+
+// normalizePendingTransactionHashFilter converts the hash equality conditions
+// of the pending transaction filter to standard base64, the format hashes are served in,
+// so hashes can also be filtered using URL-safe base64 or hex
+func normalizePendingTransactionHashFilter(where *model.FilterPendingTransaction) {
+	if where == nil {
+		return
+	}
+
+	normalizeHashFilter(where.Hash)
+
+	for _, and := range where.And {
+		normalizePendingTransactionHashFilter(and)
+	}
+
+	for _, or := range where.Or {
+		normalizePendingTransactionHashFilter(or)
+	}
+
+	normalizePendingTransactionHashFilter(where.Not)
+}
+
+// normalizeHashFilter converts the hash equality condition to standard base64
+func normalizeHashFilter(filter *model.FilterString) {
+	if filter == nil || filter.Eq == nil {
+		return
+	}
+
+	normalized := types.NormalizeHash(*filter.Eq)
+	filter.Eq = &normalized
+}
@@ -0,0 +1,123 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+
+	"github.com/gnolang/tx-indexer/storage"
+	storageErrors "github.com/gnolang/tx-indexer/storage/errors"
+)
+
+const (
+	defaultImportDepth = 10
+	maxImportDepth     = 50
+)
+
+// importDepth returns the sanitized import graph traversal depth
+func importDepth(maxDepth *int) (int, error) {
+	if maxDepth == nil {
+		return defaultImportDepth, nil
+	}
+
+	if *maxDepth < 1 {
+		return 0, fmt.Errorf("invalid max depth %d", *maxDepth)
+	}
+
+	return min(*maxDepth, maxImportDepth), nil
+}
+
+// packageImports returns the import paths of the given package.
+// Packages that are not indexed (ex. stdlibs) have no imports
+func packageImports(store storage.Storage, path string) ([]string, error) {
+	pkg, err := store.GetPackage(path)
+	if errors.Is(err, storageErrors.ErrNotFound) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return pkg.Imports, nil
+}
+
+// packageImporters returns the paths of the packages directly importing the given package
+func packageImporters(store storage.Storage, path string) ([]string, error) {
+	it, err := store.PackageImporterIterator(path)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	importers := make([]string, 0)
+
+	for it.Next() {
+		importer, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		importers = append(importers, importer)
+	}
+
+	return importers, it.Error()
+}
+
+// walkImports traverses the import graph breadth-first, starting from the given path
+// and following the edges returned by next, up until the given depth.
+// Every package is visited only once, so import cycles are handled gracefully.
+// The starting path is not part of the result
+func walkImports(
+	ctx context.Context,
+	root string,
+	depth int,
+	next func(path string) ([]string, error),
+) ([]string, error) {
+	var (
+		out     = make([]string, 0)
+		visited = map[string]struct{}{root: {}}
+		current = []string{root}
+	)
+
+	for level := 0; level < depth && len(current) > 0; level++ {
+		var upcoming []string
+
+		for _, path := range current {
+			select {
+			case <-ctx.Done():
+				graphql.AddError(ctx, ctx.Err())
+
+				return out, nil
+			default:
+			}
+
+			edges, err := next(path)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, edge := range edges {
+				if _, ok := visited[edge]; ok {
+					continue
+				}
+
+				if len(out) == maxElementsPerQuery {
+					graphql.AddErrorf(ctx, "max elements per query reached (%d)", maxElementsPerQuery)
+
+					return out, nil
+				}
+
+				visited[edge] = struct{}{}
+				out = append(out, edge)
+				upcoming = append(upcoming, edge)
+			}
+		}
+
+		current = upcoming
+	}
+
+	return out, nil
+}
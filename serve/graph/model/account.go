@@ -0,0 +1,86 @@
+package model
+
+import (
+	"time"
+
+	"github.com/gnolang/gno/tm2/pkg/std"
+
+	"github.com/gnolang/tx-indexer/types"
+)
+
+type Account struct {
+	account *types.Account
+}
+
+func NewAccount(account *types.Account) *Account {
+	return &Account{
+		account: account,
+	}
+}
+
+func (a *Account) Address() string {
+	return a.account.Address
+}
+
+func (a *Account) FirstSeenHeight() int {
+	return int(a.account.FirstSeenHeight)
+}
+
+func (a *Account) FirstSeenTime() time.Time {
+	return a.account.FirstSeenTime
+}
+
+func (a *Account) LastSeenHeight() int {
+	return int(a.account.LastSeenHeight)
+}
+
+func (a *Account) LastSeenTime() time.Time {
+	return a.account.LastSeenTime
+}
+
+func (a *Account) TxCount() int {
+	return int(a.account.TxCount)
+}
+
+func (a *Account) Sent() []*Coin {
+	return makeCoins(a.account.Sent)
+}
+
+func (a *Account) Received() []*Coin {
+	return makeCoins(a.account.Received)
+}
+
+func (a *Account) GenesisBalance() []*Coin {
+	return makeCoins(a.account.GenesisBalance)
+}
+
+func (a *Account) RealmsCalled() []string {
+	return nonNil(a.account.RealmsCalled)
+}
+
+func (a *Account) PackagesDeployed() []string {
+	return nonNil(a.account.PackagesDeployed)
+}
+
+func makeCoins(coins std.Coins) []*Coin {
+	out := make([]*Coin, 0, len(coins))
+
+	for _, coin := range coins {
+		out = append(out, &Coin{
+			Amount: int(coin.Amount),
+			Denom:  coin.Denom,
+		})
+	}
+
+	return out
+}
+
+func nonNil[T any](list []T) []T {
+	if list == nil {
+		return make([]T, 0)
+	}
+
+	return list
+}
+
+func (Account) IsLookupResult() {}
@@ -2,6 +2,7 @@ package model
 
 import (
 	"encoding/base64"
+	"encoding/hex"
 	"strconv"
 	"sync"
 	"time"
@@ -9,19 +10,42 @@ import (
 	"github.com/gnolang/gno/tm2/pkg/amino"
 	"github.com/gnolang/gno/tm2/pkg/bft/types"
 	"github.com/gnolang/gno/tm2/pkg/std"
+
+	indexerTypes "github.com/gnolang/tx-indexer/types"
 )
 
 type Block struct {
-	b   *types.Block
-	txs []*BlockTransaction
+	b *types.Block
+
+	// results are the BeginBlock / EndBlock results, when known upfront.
+	// Otherwise, they are fetched from the storage
+	results *indexerTypes.BlockResults
 
-	onceTxs sync.Once
+	// txs unmarshals the block's transactions once.
+	// It is shared between copies of the Block
+	txs func() []*BlockTransaction
 }
 
 func NewBlock(b *types.Block) *Block {
-	return &Block{
+	block := &Block{
 		b: b,
 	}
+
+	block.txs = sync.OnceValue(block.unmarshalTxs)
+
+	return block
+}
+
+// WithResults attaches the BeginBlock / EndBlock results to the block
+func (b *Block) WithResults(results *indexerTypes.BlockResults) *Block {
+	b.results = results
+
+	return b
+}
+
+// Results returns the attached BeginBlock / EndBlock results, if any
+func (b *Block) Results() *indexerTypes.BlockResults {
+	return b.results
 }
 
 func (b *Block) ID() string {
@@ -32,6 +56,10 @@ func (b *Block) Hash() string {
 	return base64.StdEncoding.EncodeToString(b.b.Hash())
 }
 
+func (b *Block) HashHex() string {
+	return hex.EncodeToString(b.b.Hash())
+}
+
 func (b *Block) Height() int64 {
 	return b.b.Height
 }
@@ -68,6 +96,14 @@ func (b *Block) ProposerAddressRaw() string {
 	return b.b.ProposerAddress.String()
 }
 
+func (b *Block) ProposerAddress() string {
+	if b.b.ProposerAddress.IsZero() {
+		return ""
+	}
+
+	return b.b.ProposerAddress.String()
+}
+
 func (b *Block) LastCommitHash() string {
 	if b.b.LastCommitHash == nil {
 		return ""
@@ -101,23 +137,20 @@ func (b *Block) Txs() []*BlockTransaction {
 }
 
 func (b *Block) getTxs() []*BlockTransaction {
-	// The function to unmarshal a block's transactions is executed once.
-	unmarshalTxs := func() {
-		var blockTxs []*BlockTransaction
-
-		for _, tx := range b.b.Txs {
-			blockTx := NewBlockTransaction(tx)
-			if blockTx != nil {
-				blockTxs = append(blockTxs, blockTx)
-			}
-		}
+	return b.txs()
+}
 
-		b.txs = blockTxs
-	}
+func (b *Block) unmarshalTxs() []*BlockTransaction {
+	var blockTxs []*BlockTransaction
 
-	b.onceTxs.Do(unmarshalTxs)
+	for _, tx := range b.b.Txs {
+		blockTx := NewBlockTransaction(tx)
+		if blockTx != nil {
+			blockTxs = append(blockTxs, blockTx)
+		}
+	}
 
-	return b.txs
+	return blockTxs
 }
 
 func NewBlockTransaction(tx types.Tx) *BlockTransaction {
@@ -139,3 +172,5 @@ func NewBlockTransaction(tx types.Tx) *BlockTransaction {
 		Memo: stdTx.Memo,
 	}
 }
+
+func (Block) IsLookupResult() {}
@@ -0,0 +1,42 @@
+package model
+
+import (
+	"github.com/gnolang/tx-indexer/serve/methods"
+)
+
+type RealmFailures struct {
+	failures *methods.RealmFailures
+}
+
+func NewRealmFailures(failures *methods.RealmFailures) *RealmFailures {
+	return &RealmFailures{
+		failures: failures,
+	}
+}
+
+func (r *RealmFailures) PkgPath() string {
+	return r.failures.PkgPath
+}
+
+func (r *RealmFailures) TotalFailures() int {
+	return int(r.failures.TotalFailures)
+}
+
+func (r *RealmFailures) Reasons() []*FailureReason {
+	reasons := make([]*FailureReason, 0, len(r.failures.Reasons))
+
+	for _, reason := range r.failures.Reasons {
+		reasons = append(reasons, &FailureReason{
+			Func:        reason.Func,
+			Codespace:   reason.Codespace,
+			Code:        reason.Code,
+			Name:        reason.Name,
+			Message:     reason.Message,
+			Count:       int(reason.Count),
+			FirstHeight: int(reason.FirstHeight),
+			LastHeight:  int(reason.LastHeight),
+		})
+	}
+
+	return reasons
+}
@@ -49,7 +49,7 @@ func (f *NestedFilterUnknownEvent) Eval(obj *UnknownEvent) bool {
 	return true
 }
 
-func (f *NestedFilterTxFee) Eval(obj *TxFee) bool {
+func (f *NestedFilterTxSignature) Eval(obj *TxSignature) bool {
 	// Evaluate logical operators first
 	if len(f.And) > 0 {
 		for _, subFilter := range f.And {
@@ -80,22 +80,22 @@ func (f *NestedFilterTxFee) Eval(obj *TxFee) bool {
 
 	// Evaluate individual field filters
 
-	// Handle GasWanted field
-	toEvalGasWanted := toIntPtr(obj.GasWanted)
-	if f.GasWanted != nil && !f.GasWanted.Eval(toEvalGasWanted) {
+	// Handle Signature field
+	toEvalSignature := obj.Signature
+	if f.Signature != nil && !f.Signature.Eval(&toEvalSignature) {
 		return false
 	}
 
-	// Handle GasFee field
-	toEvalGasFee := obj.GasFee
-	if f.GasFee != nil && !f.GasFee.Eval(toEvalGasFee) {
+	// Handle PubKey field
+	toEvalPubKey := obj.PubKey
+	if f.PubKey != nil && !f.PubKey.Eval(toEvalPubKey) {
 		return false
 	}
 
 	return true
 }
 
-func (f *NestedFilterTransactionResponse) Eval(obj *TransactionResponse) bool {
+func (f *NestedFilterTxFee) Eval(obj *TxFee) bool {
 	// Evaluate logical operators first
 	if len(f.And) > 0 {
 		for _, subFilter := range f.And {
@@ -126,49 +126,22 @@ func (f *NestedFilterTransactionResponse) Eval(obj *TransactionResponse) bool {
 
 	// Evaluate individual field filters
 
-	// Handle Log field
-	toEvalLog := obj.Log()
-	if f.Log != nil && !f.Log.Eval(&toEvalLog) {
-		return false
-	}
-
-	// Handle Info field
-	toEvalInfo := obj.Info()
-	if f.Info != nil && !f.Info.Eval(&toEvalInfo) {
-		return false
-	}
-
-	// Handle Events slice
-	if f.Events != nil {
-		elemMatchEvents := false
-		for _, elem := range obj.Events() {
-			if f.Events.Eval(&elem) {
-				elemMatchEvents = true
-			}
-		}
-
-		if !elemMatchEvents {
-			return false
-		}
-
-	}
-
-	// Handle Error field
-	toEvalError := obj.Error()
-	if f.Error != nil && !f.Error.Eval(&toEvalError) {
+	// Handle GasWanted field
+	toEvalGasWanted := toIntPtr(obj.GasWanted)
+	if f.GasWanted != nil && !f.GasWanted.Eval(toEvalGasWanted) {
 		return false
 	}
 
-	// Handle Data field
-	toEvalData := obj.Data()
-	if f.Data != nil && !f.Data.Eval(&toEvalData) {
+	// Handle GasFee field
+	toEvalGasFee := obj.GasFee
+	if f.GasFee != nil && !f.GasFee.Eval(toEvalGasFee) {
 		return false
 	}
 
 	return true
 }
 
-func (f *NestedFilterTransactionMessage) Eval(obj *TransactionMessage) bool {
+func (f *NestedFilterTxError) Eval(obj *TxError) bool {
 	// Evaluate logical operators first
 	if len(f.And) > 0 {
 		for _, subFilter := range f.And {
@@ -199,28 +172,40 @@ func (f *NestedFilterTransactionMessage) Eval(obj *TransactionMessage) bool {
 
 	// Evaluate individual field filters
 
-	// Handle Value field
-	toEvalValue := obj.Value
-	if f.Value != nil && !f.Value.Eval(&toEvalValue) {
+	// Handle Name field
+	toEvalName := obj.Name
+	if f.Name != nil && !f.Name.Eval(&toEvalName) {
 		return false
 	}
 
-	// Handle TypeURL field
-	toEvalTypeURL := obj.TypeURL
-	if f.TypeURL != nil && !f.TypeURL.Eval(&toEvalTypeURL) {
+	// Handle Message field
+	toEvalMessage := obj.Message
+	if f.Message != nil && !f.Message.Eval(&toEvalMessage) {
 		return false
 	}
 
-	// Handle Route field
-	toEvalRoute := obj.Route
-	if f.Route != nil && !f.Route.Eval(&toEvalRoute) {
+	// Handle GnoStacktrace field
+	toEvalGnoStacktrace := obj.GnoStacktrace
+	if f.GnoStacktrace != nil && !f.GnoStacktrace.Eval(toEvalGnoStacktrace) {
+		return false
+	}
+
+	// Handle Codespace field
+	toEvalCodespace := obj.Codespace
+	if f.Codespace != nil && !f.Codespace.Eval(&toEvalCodespace) {
+		return false
+	}
+
+	// Handle Code field
+	toEvalCode := obj.Code
+	if f.Code != nil && !f.Code.Eval(&toEvalCode) {
 		return false
 	}
 
 	return true
 }
 
-func (f *NestedFilterStorageUnlockEvent) Eval(obj *StorageUnlockEvent) bool {
+func (f *NestedFilterTransactionResponse) Eval(obj *TransactionResponse) bool {
 	// Evaluate logical operators first
 	if len(f.And) > 0 {
 		for _, subFilter := range f.And {
@@ -251,34 +236,55 @@ func (f *NestedFilterStorageUnlockEvent) Eval(obj *StorageUnlockEvent) bool {
 
 	// Evaluate individual field filters
 
-	// Handle Type field
-	toEvalType := obj.Type
-	if f.Type != nil && !f.Type.Eval(&toEvalType) {
+	// Handle TxError field
+	toEvalTxError := obj.TxError()
+	if f.TxError != nil && !f.TxError.Eval(toEvalTxError) {
 		return false
 	}
 
-	// Handle PkgPath field
-	toEvalPkgPath := obj.PkgPath
-	if f.PkgPath != nil && !f.PkgPath.Eval(&toEvalPkgPath) {
+	// Handle Log field
+	toEvalLog := obj.Log()
+	if f.Log != nil && !f.Log.Eval(&toEvalLog) {
 		return false
 	}
 
-	// Handle FeeRefund field
-	toEvalFeeRefund := obj.FeeRefund
-	if f.FeeRefund != nil && !f.FeeRefund.Eval(toEvalFeeRefund) {
+	// Handle Info field
+	toEvalInfo := obj.Info()
+	if f.Info != nil && !f.Info.Eval(&toEvalInfo) {
 		return false
 	}
 
-	// Handle BytesDelta field
-	toEvalBytesDelta := toIntPtr(obj.BytesDelta)
-	if f.BytesDelta != nil && !f.BytesDelta.Eval(toEvalBytesDelta) {
+	// Handle Events slice
+	if f.Events != nil {
+		elemMatchEvents := false
+		for _, elem := range obj.Events() {
+			if f.Events.Eval(&elem) {
+				elemMatchEvents = true
+			}
+		}
+
+		if !elemMatchEvents {
+			return false
+		}
+
+	}
+
+	// Handle Error field
+	toEvalError := obj.Error()
+	if f.Error != nil && !f.Error.Eval(&toEvalError) {
+		return false
+	}
+
+	// Handle Data field
+	toEvalData := obj.Data()
+	if f.Data != nil && !f.Data.Eval(&toEvalData) {
 		return false
 	}
 
 	return true
 }
 
-func (f *NestedFilterStorageDepositEvent) Eval(obj *StorageDepositEvent) bool {
+func (f *NestedFilterTransactionMessage) Eval(obj *TransactionMessage) bool {
 	// Evaluate logical operators first
 	if len(f.And) > 0 {
 		for _, subFilter := range f.And {
@@ -309,34 +315,28 @@ func (f *NestedFilterStorageDepositEvent) Eval(obj *StorageDepositEvent) bool {
 
 	// Evaluate individual field filters
 
-	// Handle Type field
-	toEvalType := obj.Type
-	if f.Type != nil && !f.Type.Eval(&toEvalType) {
-		return false
-	}
-
-	// Handle PkgPath field
-	toEvalPkgPath := obj.PkgPath
-	if f.PkgPath != nil && !f.PkgPath.Eval(&toEvalPkgPath) {
+	// Handle Value field
+	toEvalValue := obj.Value
+	if f.Value != nil && !f.Value.Eval(&toEvalValue) {
 		return false
 	}
 
-	// Handle FeeDelta field
-	toEvalFeeDelta := obj.FeeDelta
-	if f.FeeDelta != nil && !f.FeeDelta.Eval(toEvalFeeDelta) {
+	// Handle TypeURL field
+	toEvalTypeURL := obj.TypeURL
+	if f.TypeURL != nil && !f.TypeURL.Eval(&toEvalTypeURL) {
 		return false
 	}
 
-	// Handle BytesDelta field
-	toEvalBytesDelta := toIntPtr(obj.BytesDelta)
-	if f.BytesDelta != nil && !f.BytesDelta.Eval(toEvalBytesDelta) {
+	// Handle Route field
+	toEvalRoute := obj.Route
+	if f.Route != nil && !f.Route.Eval(&toEvalRoute) {
 		return false
 	}
 
 	return true
 }
 
-func (f *NestedFilterMsgRun) Eval(obj *MsgRun) bool {
+func (f *NestedFilterTransaction) Eval(obj *Transaction) bool {
 	// Evaluate logical operators first
 	if len(f.And) > 0 {
 		for _, subFilter := range f.And {
@@ -367,177 +367,229 @@ func (f *NestedFilterMsgRun) Eval(obj *MsgRun) bool {
 
 	// Evaluate individual field filters
 
-	// Handle Send field
-	toEvalSend := obj.Send
-	if f.Send != nil && !f.Send.Eval(&toEvalSend) {
+	// Handle Success field
+	toEvalSuccess := obj.Success()
+	if f.Success != nil && !f.Success.Eval(&toEvalSuccess) {
 		return false
 	}
 
-	// Handle Package field
-	toEvalPackage := obj.Package
-	if f.Package != nil && !f.Package.Eval(toEvalPackage) {
-		return false
-	}
+	// Handle Signers slice
+	if f.Signers != nil {
+		elemMatchSigners := false
+		for _, elem := range obj.Signers() {
+			if f.Signers.Eval(&elem) {
+				elemMatchSigners = true
+			}
+		}
 
-	// Handle MaxDeposit field
-	toEvalMaxDeposit := obj.MaxDeposit
-	if f.MaxDeposit != nil && !f.MaxDeposit.Eval(&toEvalMaxDeposit) {
-		return false
-	}
+		if !elemMatchSigners {
+			return false
+		}
 
-	// Handle Caller field
-	toEvalCaller := obj.Caller
-	if f.Caller != nil && !f.Caller.Eval(&toEvalCaller) {
-		return false
 	}
 
-	return true
-}
-
-func (f *NestedFilterMsgCall) Eval(obj *MsgCall) bool {
-	// Evaluate logical operators first
-	if len(f.And) > 0 {
-		for _, subFilter := range f.And {
-			if !subFilter.Eval(obj) {
-				return false
+	// Handle Signatures slice
+	if f.Signatures != nil {
+		elemMatchSignatures := false
+		for _, elem := range obj.Signatures() {
+			if f.Signatures.Eval(elem) {
+				elemMatchSignatures = true
 			}
 		}
-	}
 
-	if len(f.Or) > 0 {
-		orResult := false
-		for _, subFilter := range f.Or {
-			if subFilter.Eval(obj) {
-				orResult = true
-				break
-			}
-		}
-		if !orResult {
+		if !elemMatchSignatures {
 			return false
 		}
+
 	}
 
-	if f.Not != nil {
-		if f.Not.Eval(obj) {
+	// Handle Response field
+	toEvalResponse := obj.Response()
+	if f.Response != nil && !f.Response.Eval(toEvalResponse) {
+		return false
+	}
+
+	// Handle Messages slice
+	if f.Messages != nil {
+		elemMatchMessages := false
+		for _, elem := range obj.Messages() {
+			if f.Messages.Eval(elem) {
+				elemMatchMessages = true
+			}
+		}
+
+		if !elemMatchMessages {
 			return false
 		}
+
 	}
 
-	// Evaluate individual field filters
+	// Handle Memo field
+	toEvalMemo := obj.Memo()
+	if f.Memo != nil && !f.Memo.Eval(&toEvalMemo) {
+		return false
+	}
 
-	// Handle Send field
-	toEvalSend := obj.Send
-	if f.Send != nil && !f.Send.Eval(&toEvalSend) {
+	// Handle Index field
+	toEvalIndex := toIntPtr(obj.Index())
+	if f.Index != nil && !f.Index.Eval(toEvalIndex) {
 		return false
 	}
 
-	// Handle PkgPath field
-	toEvalPkgPath := obj.PkgPath
-	if f.PkgPath != nil && !f.PkgPath.Eval(&toEvalPkgPath) {
+	// Handle HashHex field
+	toEvalHashHex := obj.HashHex()
+	if f.HashHex != nil && !f.HashHex.Eval(&toEvalHashHex) {
 		return false
 	}
 
-	// Handle MaxDeposit field
-	toEvalMaxDeposit := obj.MaxDeposit
-	if f.MaxDeposit != nil && !f.MaxDeposit.Eval(&toEvalMaxDeposit) {
+	// Handle Hash field
+	toEvalHash := obj.Hash()
+	if f.Hash != nil && !f.Hash.Eval(&toEvalHash) {
 		return false
 	}
 
-	// Handle Func field
-	toEvalFunc := obj.Func
-	if f.Func != nil && !f.Func.Eval(&toEvalFunc) {
+	// Handle GasWanted field
+	toEvalGasWanted := toIntPtr(obj.GasWanted())
+	if f.GasWanted != nil && !f.GasWanted.Eval(toEvalGasWanted) {
 		return false
 	}
 
-	// Handle Caller field
-	toEvalCaller := obj.Caller
-	if f.Caller != nil && !f.Caller.Eval(&toEvalCaller) {
+	// Handle GasUsed field
+	toEvalGasUsed := toIntPtr(obj.GasUsed())
+	if f.GasUsed != nil && !f.GasUsed.Eval(toEvalGasUsed) {
 		return false
 	}
 
-	// Handle Args slice
-	if f.Args != nil {
-		elemMatchArgs := false
-		for _, elem := range obj.Args {
-			if f.Args.Eval(&elem) {
-				elemMatchArgs = true
-			}
-		}
+	// Handle GasFee field
+	toEvalGasFee := obj.GasFee()
+	if f.GasFee != nil && !f.GasFee.Eval(toEvalGasFee) {
+		return false
+	}
 
-		if !elemMatchArgs {
-			return false
-		}
+	// Handle FeePayer field
+	toEvalFeePayer := obj.FeePayer()
+	if f.FeePayer != nil && !f.FeePayer.Eval(&toEvalFeePayer) {
+		return false
+	}
 
+	// Handle BlockHeight field
+	toEvalBlockHeight := toIntPtr(obj.BlockHeight())
+	if f.BlockHeight != nil && !f.BlockHeight.Eval(toEvalBlockHeight) {
+		return false
 	}
 
 	return true
 }
 
-func (f *NestedFilterMsgAddPackage) Eval(obj *MsgAddPackage) bool {
-	// Evaluate logical operators first
+// MinMax function for Index
+func (f *NestedFilterTransaction) MinMaxIndex() (min *int, max *int) {
+	// Recursively handle And conditions
 	if len(f.And) > 0 {
 		for _, subFilter := range f.And {
-			if !subFilter.Eval(obj) {
-				return false
+			subMin, subMax := subFilter.MinMaxIndex()
+			if subMin != nil && (min == nil || *subMin < *min) {
+				min = subMin
+			}
+			if subMax != nil && (max == nil || *subMax > *max) {
+				max = subMax
 			}
 		}
 	}
 
+	// Recursively handle Or conditions
 	if len(f.Or) > 0 {
-		orResult := false
 		for _, subFilter := range f.Or {
-			if subFilter.Eval(obj) {
-				orResult = true
-				break
+			subMin, subMax := subFilter.MinMaxIndex()
+			if subMin != nil && (min == nil || *subMin < *min) {
+				min = subMin
+			}
+			if subMax != nil && (max == nil || *subMax > *max) {
+				max = subMax
 			}
-		}
-		if !orResult {
-			return false
 		}
 	}
 
-	if f.Not != nil {
-		if f.Not.Eval(obj) {
-			return false
+	if f.Index != nil {
+		if f.Index.Gt != nil {
+			if min == nil || *f.Index.Gt < *min {
+				min = f.Index.Gt
+			}
 		}
-	}
 
-	// Evaluate individual field filters
+		if f.Index.Lt != nil {
+			if max == nil || *f.Index.Lt > *max {
+				max = f.Index.Lt
+			}
+		}
 
-	// Handle Send field
-	toEvalSend := obj.Send
-	if f.Send != nil && !f.Send.Eval(&toEvalSend) {
-		return false
+		if f.Index.Eq != nil {
+			if min == nil || *f.Index.Eq < *min {
+				min = f.Index.Eq
+			}
+			if max == nil || *f.Index.Eq > *max {
+				max = f.Index.Eq
+			}
+		}
 	}
 
-	// Handle Package field
-	toEvalPackage := obj.Package
-	if f.Package != nil && !f.Package.Eval(toEvalPackage) {
-		return false
-	}
+	return min, max
+}
 
-	// Handle MaxDeposit field
-	toEvalMaxDeposit := obj.MaxDeposit
-	if f.MaxDeposit != nil && !f.MaxDeposit.Eval(&toEvalMaxDeposit) {
-		return false
+// MinMax function for BlockHeight
+func (f *NestedFilterTransaction) MinMaxBlockHeight() (min *int, max *int) {
+	// Recursively handle And conditions
+	if len(f.And) > 0 {
+		for _, subFilter := range f.And {
+			subMin, subMax := subFilter.MinMaxBlockHeight()
+			if subMin != nil && (min == nil || *subMin < *min) {
+				min = subMin
+			}
+			if subMax != nil && (max == nil || *subMax > *max) {
+				max = subMax
+			}
+		}
 	}
 
-	// Handle Deposit field
-	toEvalDeposit := obj.Deposit
-	if f.Deposit != nil && !f.Deposit.Eval(&toEvalDeposit) {
-		return false
+	// Recursively handle Or conditions
+	if len(f.Or) > 0 {
+		for _, subFilter := range f.Or {
+			subMin, subMax := subFilter.MinMaxBlockHeight()
+			if subMin != nil && (min == nil || *subMin < *min) {
+				min = subMin
+			}
+			if subMax != nil && (max == nil || *subMax > *max) {
+				max = subMax
+			}
+		}
 	}
 
-	// Handle Creator field
-	toEvalCreator := obj.Creator
-	if f.Creator != nil && !f.Creator.Eval(&toEvalCreator) {
-		return false
+	if f.BlockHeight != nil {
+		if f.BlockHeight.Gt != nil {
+			if min == nil || *f.BlockHeight.Gt < *min {
+				min = f.BlockHeight.Gt
+			}
+		}
+
+		if f.BlockHeight.Lt != nil {
+			if max == nil || *f.BlockHeight.Lt > *max {
+				max = f.BlockHeight.Lt
+			}
+		}
+
+		if f.BlockHeight.Eq != nil {
+			if min == nil || *f.BlockHeight.Eq < *min {
+				min = f.BlockHeight.Eq
+			}
+			if max == nil || *f.BlockHeight.Eq > *max {
+				max = f.BlockHeight.Eq
+			}
+		}
 	}
 
-	return true
+	return min, max
 }
 
-func (f *NestedFilterMessageValue) Eval(obj *MessageValue) bool {
+func (f *NestedFilterStorageUnlockEvent) Eval(obj *StorageUnlockEvent) bool {
 	// Evaluate logical operators first
 	if len(f.And) > 0 {
 		for _, subFilter := range f.And {
@@ -566,81 +618,36 @@ func (f *NestedFilterMessageValue) Eval(obj *MessageValue) bool {
 		}
 	}
 
-	// Handle union objects depending of the type
-
-	// Check if any filters are specified
-	filtersSpecified := f.BankMsgSend != nil || f.MsgCall != nil || f.MsgAddPackage != nil || f.MsgRun != nil || false
-
-	// If no filters are specified for any types, accept all objects
-	if !filtersSpecified {
-		return true
-	}
-
-	// Evaluate specified type filters
-	matchedType := false
-
-	tobj := *obj
-	if uObj, ok := tobj.(BankMsgSend); ok {
-		matchedType = true
-		if f.BankMsgSend != nil && f.BankMsgSend.Eval(&uObj) {
-			return true
-		}
-	}
-	if uObj, ok := tobj.(*BankMsgSend); ok {
-		matchedType = true
-		if f.BankMsgSend != nil && f.BankMsgSend.Eval(uObj) {
-			return true
-		}
-	}
+	// Evaluate individual field filters
 
-	if uObj, ok := tobj.(MsgCall); ok {
-		matchedType = true
-		if f.MsgCall != nil && f.MsgCall.Eval(&uObj) {
-			return true
-		}
-	}
-	if uObj, ok := tobj.(*MsgCall); ok {
-		matchedType = true
-		if f.MsgCall != nil && f.MsgCall.Eval(uObj) {
-			return true
-		}
+	// Handle Type field
+	toEvalType := obj.Type
+	if f.Type != nil && !f.Type.Eval(&toEvalType) {
+		return false
 	}
 
-	if uObj, ok := tobj.(MsgAddPackage); ok {
-		matchedType = true
-		if f.MsgAddPackage != nil && f.MsgAddPackage.Eval(&uObj) {
-			return true
-		}
-	}
-	if uObj, ok := tobj.(*MsgAddPackage); ok {
-		matchedType = true
-		if f.MsgAddPackage != nil && f.MsgAddPackage.Eval(uObj) {
-			return true
-		}
+	// Handle PkgPath field
+	toEvalPkgPath := obj.PkgPath
+	if f.PkgPath != nil && !f.PkgPath.Eval(&toEvalPkgPath) {
+		return false
 	}
 
-	if uObj, ok := tobj.(MsgRun); ok {
-		matchedType = true
-		if f.MsgRun != nil && f.MsgRun.Eval(&uObj) {
-			return true
-		}
-	}
-	if uObj, ok := tobj.(*MsgRun); ok {
-		matchedType = true
-		if f.MsgRun != nil && f.MsgRun.Eval(uObj) {
-			return true
-		}
+	// Handle FeeRefund field
+	toEvalFeeRefund := obj.FeeRefund
+	if f.FeeRefund != nil && !f.FeeRefund.Eval(toEvalFeeRefund) {
+		return false
 	}
 
-	// If the object is of a type specified in filters but didn't match, return false.
-	if matchedType {
+	// Handle BytesDelta field
+	toEvalBytesDelta := toIntPtr(obj.BytesDelta)
+	if f.BytesDelta != nil && !f.BytesDelta.Eval(toEvalBytesDelta) {
 		return false
 	}
 
 	return true
 }
 
-func (f *NestedFilterMemPackage) Eval(obj *MemPackage) bool {
+func (f *NestedFilterStorageDepositEvent) Eval(obj *StorageDepositEvent) bool {
 	// Evaluate logical operators first
 	if len(f.And) > 0 {
 		for _, subFilter := range f.And {
@@ -671,37 +678,34 @@ func (f *NestedFilterMemPackage) Eval(obj *MemPackage) bool {
 
 	// Evaluate individual field filters
 
-	// Handle Path field
-	toEvalPath := obj.Path
-	if f.Path != nil && !f.Path.Eval(&toEvalPath) {
+	// Handle Type field
+	toEvalType := obj.Type
+	if f.Type != nil && !f.Type.Eval(&toEvalType) {
 		return false
 	}
 
-	// Handle Name field
-	toEvalName := obj.Name
-	if f.Name != nil && !f.Name.Eval(&toEvalName) {
+	// Handle PkgPath field
+	toEvalPkgPath := obj.PkgPath
+	if f.PkgPath != nil && !f.PkgPath.Eval(&toEvalPkgPath) {
 		return false
 	}
 
-	// Handle Files slice
-	if f.Files != nil {
-		elemMatchFiles := false
-		for _, elem := range obj.Files {
-			if f.Files.Eval(elem) {
-				elemMatchFiles = true
-			}
-		}
-
-		if !elemMatchFiles {
-			return false
-		}
+	// Handle FeeDelta field
+	toEvalFeeDelta := obj.FeeDelta
+	if f.FeeDelta != nil && !f.FeeDelta.Eval(toEvalFeeDelta) {
+		return false
+	}
 
+	// Handle BytesDelta field
+	toEvalBytesDelta := toIntPtr(obj.BytesDelta)
+	if f.BytesDelta != nil && !f.BytesDelta.Eval(toEvalBytesDelta) {
+		return false
 	}
 
 	return true
 }
 
-func (f *NestedFilterMemFile) Eval(obj *MemFile) bool {
+func (f *NestedFilterPubKey) Eval(obj *PubKey) bool {
 	// Evaluate logical operators first
 	if len(f.And) > 0 {
 		for _, subFilter := range f.And {
@@ -732,22 +736,34 @@ func (f *NestedFilterMemFile) Eval(obj *MemFile) bool {
 
 	// Evaluate individual field filters
 
-	// Handle Name field
-	toEvalName := obj.Name
-	if f.Name != nil && !f.Name.Eval(&toEvalName) {
+	// Handle Value field
+	toEvalValue := obj.Value
+	if f.Value != nil && !f.Value.Eval(&toEvalValue) {
 		return false
 	}
 
-	// Handle Body field
-	toEvalBody := obj.Body
-	if f.Body != nil && !f.Body.Eval(&toEvalBody) {
+	// Handle Type field
+	toEvalType := obj.Type
+	if f.Type != nil && !f.Type.Eval(&toEvalType) {
+		return false
+	}
+
+	// Handle Multisig field
+	toEvalMultisig := obj.Multisig
+	if f.Multisig != nil && !f.Multisig.Eval(toEvalMultisig) {
+		return false
+	}
+
+	// Handle Address field
+	toEvalAddress := obj.Address
+	if f.Address != nil && !f.Address.Eval(&toEvalAddress) {
 		return false
 	}
 
 	return true
 }
 
-func (f *NestedFilterGnoEventAttribute) Eval(obj *GnoEventAttribute) bool {
+func (f *NestedFilterPackage) Eval(obj *Package) bool {
 	// Evaluate logical operators first
 	if len(f.And) > 0 {
 		for _, subFilter := range f.And {
@@ -778,22 +794,46 @@ func (f *NestedFilterGnoEventAttribute) Eval(obj *GnoEventAttribute) bool {
 
 	// Evaluate individual field filters
 
-	// Handle Value field
-	toEvalValue := obj.Value
-	if f.Value != nil && !f.Value.Eval(&toEvalValue) {
-		return false
-	}
+	// Handle Success field
+	toEvalSuccess := obj.Success()
+	if f.Success != nil && !f.Success.Eval(&toEvalSuccess) {
+		return false
+	}
 
-	// Handle Key field
-	toEvalKey := obj.Key
-	if f.Key != nil && !f.Key.Eval(&toEvalKey) {
+	// Handle Path field
+	toEvalPath := obj.Path()
+	if f.Path != nil && !f.Path.Eval(&toEvalPath) {
+		return false
+	}
+
+	// Handle Name field
+	toEvalName := obj.Name()
+	if f.Name != nil && !f.Name.Eval(&toEvalName) {
+		return false
+	}
+
+	// Handle DeployIndex field
+	toEvalDeployIndex := toIntPtr(obj.DeployIndex())
+	if f.DeployIndex != nil && !f.DeployIndex.Eval(toEvalDeployIndex) {
+		return false
+	}
+
+	// Handle DeployHeight field
+	toEvalDeployHeight := toIntPtr(obj.DeployHeight())
+	if f.DeployHeight != nil && !f.DeployHeight.Eval(toEvalDeployHeight) {
+		return false
+	}
+
+	// Handle Creator field
+	toEvalCreator := obj.Creator()
+	if f.Creator != nil && !f.Creator.Eval(&toEvalCreator) {
 		return false
 	}
 
 	return true
 }
 
-func (f *NestedFilterGnoEvent) Eval(obj *GnoEvent) bool {
+func (f *NestedFilterMultisigPubKey) Eval(obj *MultisigPubKey) bool {
 	// Evaluate logical operators first
 	if len(f.And) > 0 {
 		for _, subFilter := range f.And {
@@ -824,37 +864,28 @@ func (f *NestedFilterGnoEvent) Eval(obj *GnoEvent) bool {
 
 	// Evaluate individual field filters
 
+	// Handle Value field
+	toEvalValue := obj.Value
+	if f.Value != nil && !f.Value.Eval(&toEvalValue) {
+		return false
+	}
+
 	// Handle Type field
 	toEvalType := obj.Type
 	if f.Type != nil && !f.Type.Eval(&toEvalType) {
 		return false
 	}
 
-	// Handle PkgPath field
-	toEvalPkgPath := obj.PkgPath
-	if f.PkgPath != nil && !f.PkgPath.Eval(&toEvalPkgPath) {
+	// Handle Address field
+	toEvalAddress := obj.Address
+	if f.Address != nil && !f.Address.Eval(&toEvalAddress) {
 		return false
 	}
 
-	// Handle Attrs slice
-	if f.Attrs != nil {
-		elemMatchAttrs := false
-		for _, elem := range obj.Attrs {
-			if f.Attrs.Eval(elem) {
-				elemMatchAttrs = true
-			}
-		}
-
-		if !elemMatchAttrs {
-			return false
-		}
-
-	}
-
 	return true
 }
 
-func (f *NestedFilterEvent) Eval(obj *Event) bool {
+func (f *NestedFilterMultisig) Eval(obj *Multisig) bool {
 	// Evaluate logical operators first
 	if len(f.And) > 0 {
 		for _, subFilter := range f.And {
@@ -883,81 +914,33 @@ func (f *NestedFilterEvent) Eval(obj *Event) bool {
 		}
 	}
 
-	// Handle union objects depending of the type
-
-	// Check if any filters are specified
-	filtersSpecified := f.GnoEvent != nil || f.StorageDepositEvent != nil || f.StorageUnlockEvent != nil || f.UnknownEvent != nil || false
-
-	// If no filters are specified for any types, accept all objects
-	if !filtersSpecified {
-		return true
-	}
-
-	// Evaluate specified type filters
-	matchedType := false
-
-	tobj := *obj
-	if uObj, ok := tobj.(GnoEvent); ok {
-		matchedType = true
-		if f.GnoEvent != nil && f.GnoEvent.Eval(&uObj) {
-			return true
-		}
-	}
-	if uObj, ok := tobj.(*GnoEvent); ok {
-		matchedType = true
-		if f.GnoEvent != nil && f.GnoEvent.Eval(uObj) {
-			return true
-		}
-	}
+	// Evaluate individual field filters
 
-	if uObj, ok := tobj.(StorageDepositEvent); ok {
-		matchedType = true
-		if f.StorageDepositEvent != nil && f.StorageDepositEvent.Eval(&uObj) {
-			return true
-		}
-	}
-	if uObj, ok := tobj.(*StorageDepositEvent); ok {
-		matchedType = true
-		if f.StorageDepositEvent != nil && f.StorageDepositEvent.Eval(uObj) {
-			return true
-		}
+	// Handle Threshold field
+	toEvalThreshold := toIntPtr(obj.Threshold)
+	if f.Threshold != nil && !f.Threshold.Eval(toEvalThreshold) {
+		return false
 	}
 
-	if uObj, ok := tobj.(StorageUnlockEvent); ok {
-		matchedType = true
-		if f.StorageUnlockEvent != nil && f.StorageUnlockEvent.Eval(&uObj) {
-			return true
-		}
-	}
-	if uObj, ok := tobj.(*StorageUnlockEvent); ok {
-		matchedType = true
-		if f.StorageUnlockEvent != nil && f.StorageUnlockEvent.Eval(uObj) {
-			return true
+	// Handle PubKeys slice
+	if f.PubKeys != nil {
+		elemMatchPubKeys := false
+		for _, elem := range obj.PubKeys {
+			if f.PubKeys.Eval(elem) {
+				elemMatchPubKeys = true
+			}
 		}
-	}
 
-	if uObj, ok := tobj.(UnknownEvent); ok {
-		matchedType = true
-		if f.UnknownEvent != nil && f.UnknownEvent.Eval(&uObj) {
-			return true
-		}
-	}
-	if uObj, ok := tobj.(*UnknownEvent); ok {
-		matchedType = true
-		if f.UnknownEvent != nil && f.UnknownEvent.Eval(uObj) {
-			return true
+		if !elemMatchPubKeys {
+			return false
 		}
-	}
 
-	// If the object is of a type specified in filters but didn't match, return false.
-	if matchedType {
-		return false
 	}
 
 	return true
 }
 
-func (f *NestedFilterCoin) Eval(obj *Coin) bool {
+func (f *NestedFilterMsgRun) Eval(obj *MsgRun) bool {
 	// Evaluate logical operators first
 	if len(f.And) > 0 {
 		for _, subFilter := range f.And {
@@ -988,22 +971,49 @@ func (f *NestedFilterCoin) Eval(obj *Coin) bool {
 
 	// Evaluate individual field filters
 
-	// Handle Denom field
-	toEvalDenom := obj.Denom
-	if f.Denom != nil && !f.Denom.Eval(&toEvalDenom) {
+	// Handle SendCoins slice
+	if f.SendCoins != nil {
+		elemMatchSendCoins := false
+		for _, elem := range obj.SendCoins {
+			if f.SendCoins.Eval(elem) {
+				elemMatchSendCoins = true
+			}
+		}
+
+		if !elemMatchSendCoins {
+			return false
+		}
+
+	}
+
+	// Handle Send field
+	toEvalSend := obj.Send
+	if f.Send != nil && !f.Send.Eval(&toEvalSend) {
 		return false
 	}
 
-	// Handle Amount field
-	toEvalAmount := toIntPtr(obj.Amount)
-	if f.Amount != nil && !f.Amount.Eval(toEvalAmount) {
+	// Handle Package field
+	toEvalPackage := obj.Package
+	if f.Package != nil && !f.Package.Eval(toEvalPackage) {
+		return false
+	}
+
+	// Handle MaxDeposit field
+	toEvalMaxDeposit := obj.MaxDeposit
+	if f.MaxDeposit != nil && !f.MaxDeposit.Eval(&toEvalMaxDeposit) {
+		return false
+	}
+
+	// Handle Caller field
+	toEvalCaller := obj.Caller
+	if f.Caller != nil && !f.Caller.Eval(&toEvalCaller) {
 		return false
 	}
 
 	return true
 }
 
-func (f *NestedFilterBlockTransaction) Eval(obj *BlockTransaction) bool {
+func (f *NestedFilterMsgCall) Eval(obj *MsgCall) bool {
 	// Evaluate logical operators first
 	if len(f.And) > 0 {
 		for _, subFilter := range f.And {
@@ -1034,28 +1044,70 @@ func (f *NestedFilterBlockTransaction) Eval(obj *BlockTransaction) bool {
 
 	// Evaluate individual field filters
 
-	// Handle Memo field
-	toEvalMemo := obj.Memo
-	if f.Memo != nil && !f.Memo.Eval(&toEvalMemo) {
+	// Handle SendCoins slice
+	if f.SendCoins != nil {
+		elemMatchSendCoins := false
+		for _, elem := range obj.SendCoins {
+			if f.SendCoins.Eval(elem) {
+				elemMatchSendCoins = true
+			}
+		}
+
+		if !elemMatchSendCoins {
+			return false
+		}
+
+	}
+
+	// Handle Send field
+	toEvalSend := obj.Send
+	if f.Send != nil && !f.Send.Eval(&toEvalSend) {
 		return false
 	}
 
-	// Handle Hash field
-	toEvalHash := obj.Hash
-	if f.Hash != nil && !f.Hash.Eval(&toEvalHash) {
+	// Handle PkgPath field
+	toEvalPkgPath := obj.PkgPath
+	if f.PkgPath != nil && !f.PkgPath.Eval(&toEvalPkgPath) {
 		return false
 	}
 
-	// Handle Fee field
-	toEvalFee := obj.Fee
-	if f.Fee != nil && !f.Fee.Eval(toEvalFee) {
+	// Handle MaxDeposit field
+	toEvalMaxDeposit := obj.MaxDeposit
+	if f.MaxDeposit != nil && !f.MaxDeposit.Eval(&toEvalMaxDeposit) {
+		return false
+	}
+
+	// Handle Func field
+	toEvalFunc := obj.Func
+	if f.Func != nil && !f.Func.Eval(&toEvalFunc) {
+		return false
+	}
+
+	// Handle Caller field
+	toEvalCaller := obj.Caller
+	if f.Caller != nil && !f.Caller.Eval(&toEvalCaller) {
 		return false
 	}
 
+	// Handle Args slice
+	if f.Args != nil {
+		elemMatchArgs := false
+		for _, elem := range obj.Args {
+			if f.Args.Eval(&elem) {
+				elemMatchArgs = true
+			}
+		}
+
+		if !elemMatchArgs {
+			return false
+		}
+
+	}
+
 	return true
 }
 
-func (f *NestedFilterBankMsgSend) Eval(obj *BankMsgSend) bool {
+func (f *NestedFilterMsgAddPackage) Eval(obj *MsgAddPackage) bool {
 	// Evaluate logical operators first
 	if len(f.And) > 0 {
 		for _, subFilter := range f.And {
@@ -1086,28 +1138,55 @@ func (f *NestedFilterBankMsgSend) Eval(obj *BankMsgSend) bool {
 
 	// Evaluate individual field filters
 
-	// Handle ToAddress field
-	toEvalToAddress := obj.ToAddress
-	if f.ToAddress != nil && !f.ToAddress.Eval(&toEvalToAddress) {
+	// Handle SendCoins slice
+	if f.SendCoins != nil {
+		elemMatchSendCoins := false
+		for _, elem := range obj.SendCoins {
+			if f.SendCoins.Eval(elem) {
+				elemMatchSendCoins = true
+			}
+		}
+
+		if !elemMatchSendCoins {
+			return false
+		}
+
+	}
+
+	// Handle Send field
+	toEvalSend := obj.Send
+	if f.Send != nil && !f.Send.Eval(&toEvalSend) {
 		return false
 	}
 
-	// Handle FromAddress field
-	toEvalFromAddress := obj.FromAddress
-	if f.FromAddress != nil && !f.FromAddress.Eval(&toEvalFromAddress) {
+	// Handle Package field
+	toEvalPackage := obj.Package
+	if f.Package != nil && !f.Package.Eval(toEvalPackage) {
 		return false
 	}
 
-	// Handle Amount field
-	toEvalAmount := obj.Amount
-	if f.Amount != nil && !f.Amount.Eval(&toEvalAmount) {
+	// Handle MaxDeposit field
+	toEvalMaxDeposit := obj.MaxDeposit
+	if f.MaxDeposit != nil && !f.MaxDeposit.Eval(&toEvalMaxDeposit) {
+		return false
+	}
+
+	// Handle Deposit field
+	toEvalDeposit := obj.Deposit
+	if f.Deposit != nil && !f.Deposit.Eval(&toEvalDeposit) {
+		return false
+	}
+
+	// Handle Creator field
+	toEvalCreator := obj.Creator
+	if f.Creator != nil && !f.Creator.Eval(&toEvalCreator) {
 		return false
 	}
 
 	return true
 }
 
-func (f *FilterUnknownEvent) Eval(obj *UnknownEvent) bool {
+func (f *NestedFilterMessageValue) Eval(obj *MessageValue) bool {
 	// Evaluate logical operators first
 	if len(f.And) > 0 {
 		for _, subFilter := range f.And {
@@ -1117,37 +1196,1741 @@ func (f *FilterUnknownEvent) Eval(obj *UnknownEvent) bool {
 		}
 	}
 
-	if len(f.Or) > 0 {
-		orResult := false
-		for _, subFilter := range f.Or {
-			if subFilter.Eval(obj) {
-				orResult = true
-				break
+	if len(f.Or) > 0 {
+		orResult := false
+		for _, subFilter := range f.Or {
+			if subFilter.Eval(obj) {
+				orResult = true
+				break
+			}
+		}
+		if !orResult {
+			return false
+		}
+	}
+
+	if f.Not != nil {
+		if f.Not.Eval(obj) {
+			return false
+		}
+	}
+
+	// Handle union objects depending of the type
+
+	// Check if any filters are specified
+	filtersSpecified := f.BankMsgSend != nil || f.MsgCall != nil || f.MsgAddPackage != nil || f.MsgRun != nil || f.GenericMessage != nil || false
+
+	// If no filters are specified for any types, accept all objects
+	if !filtersSpecified {
+		return true
+	}
+
+	// Evaluate specified type filters
+	matchedType := false
+
+	tobj := *obj
+	if uObj, ok := tobj.(BankMsgSend); ok {
+		matchedType = true
+		if f.BankMsgSend != nil && f.BankMsgSend.Eval(&uObj) {
+			return true
+		}
+	}
+	if uObj, ok := tobj.(*BankMsgSend); ok {
+		matchedType = true
+		if f.BankMsgSend != nil && f.BankMsgSend.Eval(uObj) {
+			return true
+		}
+	}
+
+	if uObj, ok := tobj.(MsgCall); ok {
+		matchedType = true
+		if f.MsgCall != nil && f.MsgCall.Eval(&uObj) {
+			return true
+		}
+	}
+	if uObj, ok := tobj.(*MsgCall); ok {
+		matchedType = true
+		if f.MsgCall != nil && f.MsgCall.Eval(uObj) {
+			return true
+		}
+	}
+
+	if uObj, ok := tobj.(MsgAddPackage); ok {
+		matchedType = true
+		if f.MsgAddPackage != nil && f.MsgAddPackage.Eval(&uObj) {
+			return true
+		}
+	}
+	if uObj, ok := tobj.(*MsgAddPackage); ok {
+		matchedType = true
+		if f.MsgAddPackage != nil && f.MsgAddPackage.Eval(uObj) {
+			return true
+		}
+	}
+
+	if uObj, ok := tobj.(MsgRun); ok {
+		matchedType = true
+		if f.MsgRun != nil && f.MsgRun.Eval(&uObj) {
+			return true
+		}
+	}
+	if uObj, ok := tobj.(*MsgRun); ok {
+		matchedType = true
+		if f.MsgRun != nil && f.MsgRun.Eval(uObj) {
+			return true
+		}
+	}
+
+	if uObj, ok := tobj.(GenericMessage); ok {
+		matchedType = true
+		if f.GenericMessage != nil && f.GenericMessage.Eval(&uObj) {
+			return true
+		}
+	}
+	if uObj, ok := tobj.(*GenericMessage); ok {
+		matchedType = true
+		if f.GenericMessage != nil && f.GenericMessage.Eval(uObj) {
+			return true
+		}
+	}
+
+	// If the object is of a type specified in filters but didn't match, return false.
+	if matchedType {
+		return false
+	}
+
+	return true
+}
+
+func (f *NestedFilterMessageField) Eval(obj *MessageField) bool {
+	// Evaluate logical operators first
+	if len(f.And) > 0 {
+		for _, subFilter := range f.And {
+			if !subFilter.Eval(obj) {
+				return false
+			}
+		}
+	}
+
+	if len(f.Or) > 0 {
+		orResult := false
+		for _, subFilter := range f.Or {
+			if subFilter.Eval(obj) {
+				orResult = true
+				break
+			}
+		}
+		if !orResult {
+			return false
+		}
+	}
+
+	if f.Not != nil {
+		if f.Not.Eval(obj) {
+			return false
+		}
+	}
+
+	// Evaluate individual field filters
+
+	// Handle Value field
+	toEvalValue := obj.Value
+	if f.Value != nil && !f.Value.Eval(&toEvalValue) {
+		return false
+	}
+
+	// Handle Path field
+	toEvalPath := obj.Path
+	if f.Path != nil && !f.Path.Eval(&toEvalPath) {
+		return false
+	}
+
+	return true
+}
+
+func (f *NestedFilterMemPackage) Eval(obj *MemPackage) bool {
+	// Evaluate logical operators first
+	if len(f.And) > 0 {
+		for _, subFilter := range f.And {
+			if !subFilter.Eval(obj) {
+				return false
+			}
+		}
+	}
+
+	if len(f.Or) > 0 {
+		orResult := false
+		for _, subFilter := range f.Or {
+			if subFilter.Eval(obj) {
+				orResult = true
+				break
+			}
+		}
+		if !orResult {
+			return false
+		}
+	}
+
+	if f.Not != nil {
+		if f.Not.Eval(obj) {
+			return false
+		}
+	}
+
+	// Evaluate individual field filters
+
+	// Handle Path field
+	toEvalPath := obj.Path
+	if f.Path != nil && !f.Path.Eval(&toEvalPath) {
+		return false
+	}
+
+	// Handle Name field
+	toEvalName := obj.Name
+	if f.Name != nil && !f.Name.Eval(&toEvalName) {
+		return false
+	}
+
+	// Handle Files slice
+	if f.Files != nil {
+		elemMatchFiles := false
+		for _, elem := range obj.Files {
+			if f.Files.Eval(elem) {
+				elemMatchFiles = true
+			}
+		}
+
+		if !elemMatchFiles {
+			return false
+		}
+
+	}
+
+	return true
+}
+
+func (f *NestedFilterMemFile) Eval(obj *MemFile) bool {
+	// Evaluate logical operators first
+	if len(f.And) > 0 {
+		for _, subFilter := range f.And {
+			if !subFilter.Eval(obj) {
+				return false
+			}
+		}
+	}
+
+	if len(f.Or) > 0 {
+		orResult := false
+		for _, subFilter := range f.Or {
+			if subFilter.Eval(obj) {
+				orResult = true
+				break
+			}
+		}
+		if !orResult {
+			return false
+		}
+	}
+
+	if f.Not != nil {
+		if f.Not.Eval(obj) {
+			return false
+		}
+	}
+
+	// Evaluate individual field filters
+
+	// Handle Name field
+	toEvalName := obj.Name
+	if f.Name != nil && !f.Name.Eval(&toEvalName) {
+		return false
+	}
+
+	// Handle Body field
+	toEvalBody := obj.Body
+	if f.Body != nil && !f.Body.Eval(&toEvalBody) {
+		return false
+	}
+
+	return true
+}
+
+func (f *NestedFilterGnoEventAttribute) Eval(obj *GnoEventAttribute) bool {
+	// Evaluate logical operators first
+	if len(f.And) > 0 {
+		for _, subFilter := range f.And {
+			if !subFilter.Eval(obj) {
+				return false
+			}
+		}
+	}
+
+	if len(f.Or) > 0 {
+		orResult := false
+		for _, subFilter := range f.Or {
+			if subFilter.Eval(obj) {
+				orResult = true
+				break
+			}
+		}
+		if !orResult {
+			return false
+		}
+	}
+
+	if f.Not != nil {
+		if f.Not.Eval(obj) {
+			return false
+		}
+	}
+
+	// Evaluate individual field filters
+
+	// Handle Value field
+	toEvalValue := obj.Value
+	if f.Value != nil && !f.Value.Eval(&toEvalValue) {
+		return false
+	}
+
+	// Handle Key field
+	toEvalKey := obj.Key
+	if f.Key != nil && !f.Key.Eval(&toEvalKey) {
+		return false
+	}
+
+	return true
+}
+
+func (f *NestedFilterGnoEvent) Eval(obj *GnoEvent) bool {
+	// Evaluate logical operators first
+	if len(f.And) > 0 {
+		for _, subFilter := range f.And {
+			if !subFilter.Eval(obj) {
+				return false
+			}
+		}
+	}
+
+	if len(f.Or) > 0 {
+		orResult := false
+		for _, subFilter := range f.Or {
+			if subFilter.Eval(obj) {
+				orResult = true
+				break
+			}
+		}
+		if !orResult {
+			return false
+		}
+	}
+
+	if f.Not != nil {
+		if f.Not.Eval(obj) {
+			return false
+		}
+	}
+
+	// Evaluate individual field filters
+
+	// Handle Type field
+	toEvalType := obj.Type
+	if f.Type != nil && !f.Type.Eval(&toEvalType) {
+		return false
+	}
+
+	// Handle PkgPath field
+	toEvalPkgPath := obj.PkgPath
+	if f.PkgPath != nil && !f.PkgPath.Eval(&toEvalPkgPath) {
+		return false
+	}
+
+	// Handle Attrs slice
+	if f.Attrs != nil {
+		elemMatchAttrs := false
+		for _, elem := range obj.Attrs {
+			if f.Attrs.Eval(elem) {
+				elemMatchAttrs = true
+			}
+		}
+
+		if !elemMatchAttrs {
+			return false
+		}
+
+	}
+
+	return true
+}
+
+func (f *NestedFilterGenericMessage) Eval(obj *GenericMessage) bool {
+	// Evaluate logical operators first
+	if len(f.And) > 0 {
+		for _, subFilter := range f.And {
+			if !subFilter.Eval(obj) {
+				return false
+			}
+		}
+	}
+
+	if len(f.Or) > 0 {
+		orResult := false
+		for _, subFilter := range f.Or {
+			if subFilter.Eval(obj) {
+				orResult = true
+				break
+			}
+		}
+		if !orResult {
+			return false
+		}
+	}
+
+	if f.Not != nil {
+		if f.Not.Eval(obj) {
+			return false
+		}
+	}
+
+	// Evaluate individual field filters
+
+	// Handle TypeURL field
+	toEvalTypeURL := obj.TypeURL
+	if f.TypeURL != nil && !f.TypeURL.Eval(&toEvalTypeURL) {
+		return false
+	}
+
+	// Handle Fields slice
+	if f.Fields != nil {
+		elemMatchFields := false
+		for _, elem := range obj.Fields {
+			if f.Fields.Eval(elem) {
+				elemMatchFields = true
+			}
+		}
+
+		if !elemMatchFields {
+			return false
+		}
+
+	}
+
+	return true
+}
+
+func (f *NestedFilterEvent) Eval(obj *Event) bool {
+	// Evaluate logical operators first
+	if len(f.And) > 0 {
+		for _, subFilter := range f.And {
+			if !subFilter.Eval(obj) {
+				return false
+			}
+		}
+	}
+
+	if len(f.Or) > 0 {
+		orResult := false
+		for _, subFilter := range f.Or {
+			if subFilter.Eval(obj) {
+				orResult = true
+				break
+			}
+		}
+		if !orResult {
+			return false
+		}
+	}
+
+	if f.Not != nil {
+		if f.Not.Eval(obj) {
+			return false
+		}
+	}
+
+	// Handle union objects depending of the type
+
+	// Check if any filters are specified
+	filtersSpecified := f.GnoEvent != nil || f.StorageDepositEvent != nil || f.StorageUnlockEvent != nil || f.UnknownEvent != nil || false
+
+	// If no filters are specified for any types, accept all objects
+	if !filtersSpecified {
+		return true
+	}
+
+	// Evaluate specified type filters
+	matchedType := false
+
+	tobj := *obj
+	if uObj, ok := tobj.(GnoEvent); ok {
+		matchedType = true
+		if f.GnoEvent != nil && f.GnoEvent.Eval(&uObj) {
+			return true
+		}
+	}
+	if uObj, ok := tobj.(*GnoEvent); ok {
+		matchedType = true
+		if f.GnoEvent != nil && f.GnoEvent.Eval(uObj) {
+			return true
+		}
+	}
+
+	if uObj, ok := tobj.(StorageDepositEvent); ok {
+		matchedType = true
+		if f.StorageDepositEvent != nil && f.StorageDepositEvent.Eval(&uObj) {
+			return true
+		}
+	}
+	if uObj, ok := tobj.(*StorageDepositEvent); ok {
+		matchedType = true
+		if f.StorageDepositEvent != nil && f.StorageDepositEvent.Eval(uObj) {
+			return true
+		}
+	}
+
+	if uObj, ok := tobj.(StorageUnlockEvent); ok {
+		matchedType = true
+		if f.StorageUnlockEvent != nil && f.StorageUnlockEvent.Eval(&uObj) {
+			return true
+		}
+	}
+	if uObj, ok := tobj.(*StorageUnlockEvent); ok {
+		matchedType = true
+		if f.StorageUnlockEvent != nil && f.StorageUnlockEvent.Eval(uObj) {
+			return true
+		}
+	}
+
+	if uObj, ok := tobj.(UnknownEvent); ok {
+		matchedType = true
+		if f.UnknownEvent != nil && f.UnknownEvent.Eval(&uObj) {
+			return true
+		}
+	}
+	if uObj, ok := tobj.(*UnknownEvent); ok {
+		matchedType = true
+		if f.UnknownEvent != nil && f.UnknownEvent.Eval(uObj) {
+			return true
+		}
+	}
+
+	// If the object is of a type specified in filters but didn't match, return false.
+	if matchedType {
+		return false
+	}
+
+	return true
+}
+
+func (f *NestedFilterCoin) Eval(obj *Coin) bool {
+	// Evaluate logical operators first
+	if len(f.And) > 0 {
+		for _, subFilter := range f.And {
+			if !subFilter.Eval(obj) {
+				return false
+			}
+		}
+	}
+
+	if len(f.Or) > 0 {
+		orResult := false
+		for _, subFilter := range f.Or {
+			if subFilter.Eval(obj) {
+				orResult = true
+				break
+			}
+		}
+		if !orResult {
+			return false
+		}
+	}
+
+	if f.Not != nil {
+		if f.Not.Eval(obj) {
+			return false
+		}
+	}
+
+	// Evaluate individual field filters
+
+	// Handle Denom field
+	toEvalDenom := obj.Denom
+	if f.Denom != nil && !f.Denom.Eval(&toEvalDenom) {
+		return false
+	}
+
+	// Handle Amount field
+	toEvalAmount := toIntPtr(obj.Amount)
+	if f.Amount != nil && !f.Amount.Eval(toEvalAmount) {
+		return false
+	}
+
+	return true
+}
+
+func (f *NestedFilterBlockTransaction) Eval(obj *BlockTransaction) bool {
+	// Evaluate logical operators first
+	if len(f.And) > 0 {
+		for _, subFilter := range f.And {
+			if !subFilter.Eval(obj) {
+				return false
+			}
+		}
+	}
+
+	if len(f.Or) > 0 {
+		orResult := false
+		for _, subFilter := range f.Or {
+			if subFilter.Eval(obj) {
+				orResult = true
+				break
+			}
+		}
+		if !orResult {
+			return false
+		}
+	}
+
+	if f.Not != nil {
+		if f.Not.Eval(obj) {
+			return false
+		}
+	}
+
+	// Evaluate individual field filters
+
+	// Handle Memo field
+	toEvalMemo := obj.Memo
+	if f.Memo != nil && !f.Memo.Eval(&toEvalMemo) {
+		return false
+	}
+
+	// Handle Hash field
+	toEvalHash := obj.Hash
+	if f.Hash != nil && !f.Hash.Eval(&toEvalHash) {
+		return false
+	}
+
+	// Handle Fee field
+	toEvalFee := obj.Fee
+	if f.Fee != nil && !f.Fee.Eval(toEvalFee) {
+		return false
+	}
+
+	return true
+}
+
+func (f *NestedFilterBlock) Eval(obj *Block) bool {
+	// Evaluate logical operators first
+	if len(f.And) > 0 {
+		for _, subFilter := range f.And {
+			if !subFilter.Eval(obj) {
+				return false
+			}
+		}
+	}
+
+	if len(f.Or) > 0 {
+		orResult := false
+		for _, subFilter := range f.Or {
+			if subFilter.Eval(obj) {
+				orResult = true
+				break
+			}
+		}
+		if !orResult {
+			return false
+		}
+	}
+
+	if f.Not != nil {
+		if f.Not.Eval(obj) {
+			return false
+		}
+	}
+
+	// Evaluate individual field filters
+
+	// Handle Version field
+	toEvalVersion := obj.Version()
+	if f.Version != nil && !f.Version.Eval(&toEvalVersion) {
+		return false
+	}
+
+	// Handle ValidatorsHash field
+	toEvalValidatorsHash := obj.ValidatorsHash()
+	if f.ValidatorsHash != nil && !f.ValidatorsHash.Eval(&toEvalValidatorsHash) {
+		return false
+	}
+
+	// Handle Txs slice
+	if f.Txs != nil {
+		elemMatchTxs := false
+		for _, elem := range obj.Txs() {
+			if f.Txs.Eval(elem) {
+				elemMatchTxs = true
+			}
+		}
+
+		if !elemMatchTxs {
+			return false
+		}
+
+	}
+
+	// Handle TotalTxs field
+	toEvalTotalTxs := toIntPtr(obj.TotalTxs())
+	if f.TotalTxs != nil && !f.TotalTxs.Eval(toEvalTotalTxs) {
+		return false
+	}
+
+	// Handle Time field
+	toEvalTime := obj.Time()
+	if f.Time != nil && !f.Time.Eval(&toEvalTime) {
+		return false
+	}
+
+	// Handle ProposerAddressRaw field
+	toEvalProposerAddressRaw := obj.ProposerAddressRaw()
+	if f.ProposerAddressRaw != nil && !f.ProposerAddressRaw.Eval(&toEvalProposerAddressRaw) {
+		return false
+	}
+
+	// Handle ProposerAddress field
+	toEvalProposerAddress := obj.ProposerAddress()
+	if f.ProposerAddress != nil && !f.ProposerAddress.Eval(&toEvalProposerAddress) {
+		return false
+	}
+
+	// Handle NumTxs field
+	toEvalNumTxs := toIntPtr(obj.NumTxs())
+	if f.NumTxs != nil && !f.NumTxs.Eval(toEvalNumTxs) {
+		return false
+	}
+
+	// Handle NextValidatorsHash field
+	toEvalNextValidatorsHash := obj.NextValidatorsHash()
+	if f.NextValidatorsHash != nil && !f.NextValidatorsHash.Eval(&toEvalNextValidatorsHash) {
+		return false
+	}
+
+	// Handle LastResultsHash field
+	toEvalLastResultsHash := obj.LastResultsHash()
+	if f.LastResultsHash != nil && !f.LastResultsHash.Eval(&toEvalLastResultsHash) {
+		return false
+	}
+
+	// Handle LastCommitHash field
+	toEvalLastCommitHash := obj.LastCommitHash()
+	if f.LastCommitHash != nil && !f.LastCommitHash.Eval(&toEvalLastCommitHash) {
+		return false
+	}
+
+	// Handle LastBlockHash field
+	toEvalLastBlockHash := obj.LastBlockHash()
+	if f.LastBlockHash != nil && !f.LastBlockHash.Eval(&toEvalLastBlockHash) {
+		return false
+	}
+
+	// Handle Height field
+	toEvalHeight := toIntPtr(obj.Height())
+	if f.Height != nil && !f.Height.Eval(toEvalHeight) {
+		return false
+	}
+
+	// Handle HashHex field
+	toEvalHashHex := obj.HashHex()
+	if f.HashHex != nil && !f.HashHex.Eval(&toEvalHashHex) {
+		return false
+	}
+
+	// Handle Hash field
+	toEvalHash := obj.Hash()
+	if f.Hash != nil && !f.Hash.Eval(&toEvalHash) {
+		return false
+	}
+
+	// Handle ConsensusHash field
+	toEvalConsensusHash := obj.ConsensusHash()
+	if f.ConsensusHash != nil && !f.ConsensusHash.Eval(&toEvalConsensusHash) {
+		return false
+	}
+
+	// Handle ChainID field
+	toEvalChainID := obj.ChainID()
+	if f.ChainID != nil && !f.ChainID.Eval(&toEvalChainID) {
+		return false
+	}
+
+	// Handle AppVersion field
+	toEvalAppVersion := obj.AppVersion()
+	if f.AppVersion != nil && !f.AppVersion.Eval(&toEvalAppVersion) {
+		return false
+	}
+
+	// Handle AppHash field
+	toEvalAppHash := obj.AppHash()
+	if f.AppHash != nil && !f.AppHash.Eval(&toEvalAppHash) {
+		return false
+	}
+
+	return true
+}
+
+// MinMax function for Height
+func (f *NestedFilterBlock) MinMaxHeight() (min *int, max *int) {
+	// Recursively handle And conditions
+	if len(f.And) > 0 {
+		for _, subFilter := range f.And {
+			subMin, subMax := subFilter.MinMaxHeight()
+			if subMin != nil && (min == nil || *subMin < *min) {
+				min = subMin
+			}
+			if subMax != nil && (max == nil || *subMax > *max) {
+				max = subMax
+			}
+		}
+	}
+
+	// Recursively handle Or conditions
+	if len(f.Or) > 0 {
+		for _, subFilter := range f.Or {
+			subMin, subMax := subFilter.MinMaxHeight()
+			if subMin != nil && (min == nil || *subMin < *min) {
+				min = subMin
+			}
+			if subMax != nil && (max == nil || *subMax > *max) {
+				max = subMax
+			}
+		}
+	}
+
+	if f.Height != nil {
+		if f.Height.Gt != nil {
+			if min == nil || *f.Height.Gt < *min {
+				min = f.Height.Gt
+			}
+		}
+
+		if f.Height.Lt != nil {
+			if max == nil || *f.Height.Lt > *max {
+				max = f.Height.Lt
+			}
+		}
+
+		if f.Height.Eq != nil {
+			if min == nil || *f.Height.Eq < *min {
+				min = f.Height.Eq
+			}
+			if max == nil || *f.Height.Eq > *max {
+				max = f.Height.Eq
+			}
+		}
+	}
+
+	return min, max
+}
+
+func (f *NestedFilterBankMsgSend) Eval(obj *BankMsgSend) bool {
+	// Evaluate logical operators first
+	if len(f.And) > 0 {
+		for _, subFilter := range f.And {
+			if !subFilter.Eval(obj) {
+				return false
+			}
+		}
+	}
+
+	if len(f.Or) > 0 {
+		orResult := false
+		for _, subFilter := range f.Or {
+			if subFilter.Eval(obj) {
+				orResult = true
+				break
+			}
+		}
+		if !orResult {
+			return false
+		}
+	}
+
+	if f.Not != nil {
+		if f.Not.Eval(obj) {
+			return false
+		}
+	}
+
+	// Evaluate individual field filters
+
+	// Handle ToAddress field
+	toEvalToAddress := obj.ToAddress
+	if f.ToAddress != nil && !f.ToAddress.Eval(&toEvalToAddress) {
+		return false
+	}
+
+	// Handle FromAddress field
+	toEvalFromAddress := obj.FromAddress
+	if f.FromAddress != nil && !f.FromAddress.Eval(&toEvalFromAddress) {
+		return false
+	}
+
+	// Handle AmountCoins slice
+	if f.AmountCoins != nil {
+		elemMatchAmountCoins := false
+		for _, elem := range obj.AmountCoins {
+			if f.AmountCoins.Eval(elem) {
+				elemMatchAmountCoins = true
+			}
+		}
+
+		if !elemMatchAmountCoins {
+			return false
+		}
+
+	}
+
+	// Handle Amount field
+	toEvalAmount := obj.Amount
+	if f.Amount != nil && !f.Amount.Eval(&toEvalAmount) {
+		return false
+	}
+
+	return true
+}
+
+func (f *FilterUnknownEvent) Eval(obj *UnknownEvent) bool {
+	// Evaluate logical operators first
+	if len(f.And) > 0 {
+		for _, subFilter := range f.And {
+			if !subFilter.Eval(obj) {
+				return false
+			}
+		}
+	}
+
+	if len(f.Or) > 0 {
+		orResult := false
+		for _, subFilter := range f.Or {
+			if subFilter.Eval(obj) {
+				orResult = true
+				break
+			}
+		}
+		if !orResult {
+			return false
+		}
+	}
+
+	if f.Not != nil {
+		if f.Not.Eval(obj) {
+			return false
+		}
+	}
+
+	// Evaluate individual field filters
+
+	// Handle Value field
+	toEvalValue := obj.Value
+	if f.Value != nil && !f.Value.Eval(&toEvalValue) {
+		return false
+	}
+
+	return true
+}
+
+func (f *FilterTxSignature) Eval(obj *TxSignature) bool {
+	// Evaluate logical operators first
+	if len(f.And) > 0 {
+		for _, subFilter := range f.And {
+			if !subFilter.Eval(obj) {
+				return false
+			}
+		}
+	}
+
+	if len(f.Or) > 0 {
+		orResult := false
+		for _, subFilter := range f.Or {
+			if subFilter.Eval(obj) {
+				orResult = true
+				break
+			}
+		}
+		if !orResult {
+			return false
+		}
+	}
+
+	if f.Not != nil {
+		if f.Not.Eval(obj) {
+			return false
+		}
+	}
+
+	// Evaluate individual field filters
+
+	// Handle Signature field
+	toEvalSignature := obj.Signature
+	if f.Signature != nil && !f.Signature.Eval(&toEvalSignature) {
+		return false
+	}
+
+	// Handle PubKey field
+	toEvalPubKey := obj.PubKey
+	if f.PubKey != nil && !f.PubKey.Eval(toEvalPubKey) {
+		return false
+	}
+
+	return true
+}
+
+func (f *FilterTxFee) Eval(obj *TxFee) bool {
+	// Evaluate logical operators first
+	if len(f.And) > 0 {
+		for _, subFilter := range f.And {
+			if !subFilter.Eval(obj) {
+				return false
+			}
+		}
+	}
+
+	if len(f.Or) > 0 {
+		orResult := false
+		for _, subFilter := range f.Or {
+			if subFilter.Eval(obj) {
+				orResult = true
+				break
+			}
+		}
+		if !orResult {
+			return false
+		}
+	}
+
+	if f.Not != nil {
+		if f.Not.Eval(obj) {
+			return false
+		}
+	}
+
+	// Evaluate individual field filters
+
+	// Handle GasWanted field
+	toEvalGasWanted := toIntPtr(obj.GasWanted)
+	if f.GasWanted != nil && !f.GasWanted.Eval(toEvalGasWanted) {
+		return false
+	}
+
+	// Handle GasFee field
+	toEvalGasFee := obj.GasFee
+	if f.GasFee != nil && !f.GasFee.Eval(toEvalGasFee) {
+		return false
+	}
+
+	return true
+}
+
+func (f *FilterTxError) Eval(obj *TxError) bool {
+	// Evaluate logical operators first
+	if len(f.And) > 0 {
+		for _, subFilter := range f.And {
+			if !subFilter.Eval(obj) {
+				return false
+			}
+		}
+	}
+
+	if len(f.Or) > 0 {
+		orResult := false
+		for _, subFilter := range f.Or {
+			if subFilter.Eval(obj) {
+				orResult = true
+				break
+			}
+		}
+		if !orResult {
+			return false
+		}
+	}
+
+	if f.Not != nil {
+		if f.Not.Eval(obj) {
+			return false
+		}
+	}
+
+	// Evaluate individual field filters
+
+	// Handle Name field
+	toEvalName := obj.Name
+	if f.Name != nil && !f.Name.Eval(&toEvalName) {
+		return false
+	}
+
+	// Handle Message field
+	toEvalMessage := obj.Message
+	if f.Message != nil && !f.Message.Eval(&toEvalMessage) {
+		return false
+	}
+
+	// Handle GnoStacktrace field
+	toEvalGnoStacktrace := obj.GnoStacktrace
+	if f.GnoStacktrace != nil && !f.GnoStacktrace.Eval(toEvalGnoStacktrace) {
+		return false
+	}
+
+	// Handle Codespace field
+	toEvalCodespace := obj.Codespace
+	if f.Codespace != nil && !f.Codespace.Eval(&toEvalCodespace) {
+		return false
+	}
+
+	// Handle Code field
+	toEvalCode := obj.Code
+	if f.Code != nil && !f.Code.Eval(&toEvalCode) {
+		return false
+	}
+
+	return true
+}
+
+func (f *FilterTransfer) Eval(obj *Transfer) bool {
+	// Evaluate logical operators first
+	if len(f.And) > 0 {
+		for _, subFilter := range f.And {
+			if !subFilter.Eval(obj) {
+				return false
+			}
+		}
+	}
+
+	if len(f.Or) > 0 {
+		orResult := false
+		for _, subFilter := range f.Or {
+			if subFilter.Eval(obj) {
+				orResult = true
+				break
+			}
+		}
+		if !orResult {
+			return false
+		}
+	}
+
+	if f.Not != nil {
+		if f.Not.Eval(obj) {
+			return false
+		}
+	}
+
+	// Evaluate individual field filters
+
+	// Handle To field
+	toEvalTo := obj.To()
+	if f.To != nil && !f.To.Eval(&toEvalTo) {
+		return false
+	}
+
+	// Handle Kind field
+	toEvalKind := obj.Kind()
+	if f.Kind != nil && !f.Kind.Eval(&toEvalKind) {
+		return false
+	}
+
+	// Handle Index field
+	toEvalIndex := toIntPtr(obj.Index())
+	if f.Index != nil && !f.Index.Eval(toEvalIndex) {
+		return false
+	}
+
+	// Handle From field
+	toEvalFrom := obj.From()
+	if f.From != nil && !f.From.Eval(&toEvalFrom) {
+		return false
+	}
+
+	// Handle Denom field
+	toEvalDenom := obj.Denom()
+	if f.Denom != nil && !f.Denom.Eval(&toEvalDenom) {
+		return false
+	}
+
+	// Handle BlockHeight field
+	toEvalBlockHeight := toIntPtr(obj.BlockHeight())
+	if f.BlockHeight != nil && !f.BlockHeight.Eval(toEvalBlockHeight) {
+		return false
+	}
+
+	// Handle Amount field
+	toEvalAmount := toIntPtr(obj.Amount())
+	if f.Amount != nil && !f.Amount.Eval(toEvalAmount) {
+		return false
+	}
+
+	return true
+}
+
+// MinMax function for BlockHeight
+func (f *FilterTransfer) MinMaxBlockHeight() (min *int, max *int) {
+	// Recursively handle And conditions
+	if len(f.And) > 0 {
+		for _, subFilter := range f.And {
+			subMin, subMax := subFilter.MinMaxBlockHeight()
+			if subMin != nil && (min == nil || *subMin < *min) {
+				min = subMin
+			}
+			if subMax != nil && (max == nil || *subMax > *max) {
+				max = subMax
+			}
+		}
+	}
+
+	// Recursively handle Or conditions
+	if len(f.Or) > 0 {
+		for _, subFilter := range f.Or {
+			subMin, subMax := subFilter.MinMaxBlockHeight()
+			if subMin != nil && (min == nil || *subMin < *min) {
+				min = subMin
+			}
+			if subMax != nil && (max == nil || *subMax > *max) {
+				max = subMax
+			}
+		}
+	}
+
+	if f.BlockHeight != nil {
+		if f.BlockHeight.Gt != nil {
+			if min == nil || *f.BlockHeight.Gt < *min {
+				min = f.BlockHeight.Gt
+			}
+		}
+
+		if f.BlockHeight.Lt != nil {
+			if max == nil || *f.BlockHeight.Lt > *max {
+				max = f.BlockHeight.Lt
+			}
+		}
+
+		if f.BlockHeight.Eq != nil {
+			if min == nil || *f.BlockHeight.Eq < *min {
+				min = f.BlockHeight.Eq
+			}
+			if max == nil || *f.BlockHeight.Eq > *max {
+				max = f.BlockHeight.Eq
+			}
+		}
+	}
+
+	return min, max
+}
+
+func (f *FilterTransactionResponse) Eval(obj *TransactionResponse) bool {
+	// Evaluate logical operators first
+	if len(f.And) > 0 {
+		for _, subFilter := range f.And {
+			if !subFilter.Eval(obj) {
+				return false
+			}
+		}
+	}
+
+	if len(f.Or) > 0 {
+		orResult := false
+		for _, subFilter := range f.Or {
+			if subFilter.Eval(obj) {
+				orResult = true
+				break
+			}
+		}
+		if !orResult {
+			return false
+		}
+	}
+
+	if f.Not != nil {
+		if f.Not.Eval(obj) {
+			return false
+		}
+	}
+
+	// Evaluate individual field filters
+
+	// Handle TxError field
+	toEvalTxError := obj.TxError()
+	if f.TxError != nil && !f.TxError.Eval(toEvalTxError) {
+		return false
+	}
+
+	// Handle Log field
+	toEvalLog := obj.Log()
+	if f.Log != nil && !f.Log.Eval(&toEvalLog) {
+		return false
+	}
+
+	// Handle Info field
+	toEvalInfo := obj.Info()
+	if f.Info != nil && !f.Info.Eval(&toEvalInfo) {
+		return false
+	}
+
+	// Handle Events slice
+	if f.Events != nil {
+		elemMatchEvents := false
+		for _, elem := range obj.Events() {
+			if f.Events.Eval(&elem) {
+				elemMatchEvents = true
+			}
+		}
+
+		if !elemMatchEvents {
+			return false
+		}
+
+	}
+
+	// Handle Error field
+	toEvalError := obj.Error()
+	if f.Error != nil && !f.Error.Eval(&toEvalError) {
+		return false
+	}
+
+	// Handle Data field
+	toEvalData := obj.Data()
+	if f.Data != nil && !f.Data.Eval(&toEvalData) {
+		return false
+	}
+
+	return true
+}
+
+func (f *FilterTransactionMessage) Eval(obj *TransactionMessage) bool {
+	// Evaluate logical operators first
+	if len(f.And) > 0 {
+		for _, subFilter := range f.And {
+			if !subFilter.Eval(obj) {
+				return false
+			}
+		}
+	}
+
+	if len(f.Or) > 0 {
+		orResult := false
+		for _, subFilter := range f.Or {
+			if subFilter.Eval(obj) {
+				orResult = true
+				break
+			}
+		}
+		if !orResult {
+			return false
+		}
+	}
+
+	if f.Not != nil {
+		if f.Not.Eval(obj) {
+			return false
+		}
+	}
+
+	// Evaluate individual field filters
+
+	// Handle Value field
+	toEvalValue := obj.Value
+	if f.Value != nil && !f.Value.Eval(&toEvalValue) {
+		return false
+	}
+
+	// Handle TypeURL field
+	toEvalTypeURL := obj.TypeURL
+	if f.TypeURL != nil && !f.TypeURL.Eval(&toEvalTypeURL) {
+		return false
+	}
+
+	// Handle Route field
+	toEvalRoute := obj.Route
+	if f.Route != nil && !f.Route.Eval(&toEvalRoute) {
+		return false
+	}
+
+	return true
+}
+
+func (f *FilterTransaction) Eval(obj *Transaction) bool {
+	// Evaluate logical operators first
+	if len(f.And) > 0 {
+		for _, subFilter := range f.And {
+			if !subFilter.Eval(obj) {
+				return false
+			}
+		}
+	}
+
+	if len(f.Or) > 0 {
+		orResult := false
+		for _, subFilter := range f.Or {
+			if subFilter.Eval(obj) {
+				orResult = true
+				break
+			}
+		}
+		if !orResult {
+			return false
+		}
+	}
+
+	if f.Not != nil {
+		if f.Not.Eval(obj) {
+			return false
+		}
+	}
+
+	// Evaluate individual field filters
+
+	// Handle Success field
+	toEvalSuccess := obj.Success()
+	if f.Success != nil && !f.Success.Eval(&toEvalSuccess) {
+		return false
+	}
+
+	// Handle Signers slice
+	if f.Signers != nil {
+		elemMatchSigners := false
+		for _, elem := range obj.Signers() {
+			if f.Signers.Eval(&elem) {
+				elemMatchSigners = true
+			}
+		}
+
+		if !elemMatchSigners {
+			return false
+		}
+
+	}
+
+	// Handle Signatures slice
+	if f.Signatures != nil {
+		elemMatchSignatures := false
+		for _, elem := range obj.Signatures() {
+			if f.Signatures.Eval(elem) {
+				elemMatchSignatures = true
+			}
+		}
+
+		if !elemMatchSignatures {
+			return false
+		}
+
+	}
+
+	// Handle Response field
+	toEvalResponse := obj.Response()
+	if f.Response != nil && !f.Response.Eval(toEvalResponse) {
+		return false
+	}
+
+	// Handle Messages slice
+	if f.Messages != nil {
+		elemMatchMessages := false
+		for _, elem := range obj.Messages() {
+			if f.Messages.Eval(elem) {
+				elemMatchMessages = true
+			}
+		}
+
+		if !elemMatchMessages {
+			return false
+		}
+
+	}
+
+	// Handle Memo field
+	toEvalMemo := obj.Memo()
+	if f.Memo != nil && !f.Memo.Eval(&toEvalMemo) {
+		return false
+	}
+
+	// Handle Index field
+	toEvalIndex := toIntPtr(obj.Index())
+	if f.Index != nil && !f.Index.Eval(toEvalIndex) {
+		return false
+	}
+
+	// Handle HashHex field
+	toEvalHashHex := obj.HashHex()
+	if f.HashHex != nil && !f.HashHex.Eval(&toEvalHashHex) {
+		return false
+	}
+
+	// Handle Hash field
+	toEvalHash := obj.Hash()
+	if f.Hash != nil && !f.Hash.Eval(&toEvalHash) {
+		return false
+	}
+
+	// Handle GasWanted field
+	toEvalGasWanted := toIntPtr(obj.GasWanted())
+	if f.GasWanted != nil && !f.GasWanted.Eval(toEvalGasWanted) {
+		return false
+	}
+
+	// Handle GasUsed field
+	toEvalGasUsed := toIntPtr(obj.GasUsed())
+	if f.GasUsed != nil && !f.GasUsed.Eval(toEvalGasUsed) {
+		return false
+	}
+
+	// Handle GasFee field
+	toEvalGasFee := obj.GasFee()
+	if f.GasFee != nil && !f.GasFee.Eval(toEvalGasFee) {
+		return false
+	}
+
+	// Handle FeePayer field
+	toEvalFeePayer := obj.FeePayer()
+	if f.FeePayer != nil && !f.FeePayer.Eval(&toEvalFeePayer) {
+		return false
+	}
+
+	// Handle BlockHeight field
+	toEvalBlockHeight := toIntPtr(obj.BlockHeight())
+	if f.BlockHeight != nil && !f.BlockHeight.Eval(toEvalBlockHeight) {
+		return false
+	}
+
+	return true
+}
+
+// MinMax function for Index
+func (f *FilterTransaction) MinMaxIndex() (min *int, max *int) {
+	// Recursively handle And conditions
+	if len(f.And) > 0 {
+		for _, subFilter := range f.And {
+			subMin, subMax := subFilter.MinMaxIndex()
+			if subMin != nil && (min == nil || *subMin < *min) {
+				min = subMin
+			}
+			if subMax != nil && (max == nil || *subMax > *max) {
+				max = subMax
+			}
+		}
+	}
+
+	// Recursively handle Or conditions
+	if len(f.Or) > 0 {
+		for _, subFilter := range f.Or {
+			subMin, subMax := subFilter.MinMaxIndex()
+			if subMin != nil && (min == nil || *subMin < *min) {
+				min = subMin
+			}
+			if subMax != nil && (max == nil || *subMax > *max) {
+				max = subMax
+			}
+		}
+	}
+
+	if f.Index != nil {
+		if f.Index.Gt != nil {
+			if min == nil || *f.Index.Gt < *min {
+				min = f.Index.Gt
+			}
+		}
+
+		if f.Index.Lt != nil {
+			if max == nil || *f.Index.Lt > *max {
+				max = f.Index.Lt
+			}
+		}
+
+		if f.Index.Eq != nil {
+			if min == nil || *f.Index.Eq < *min {
+				min = f.Index.Eq
+			}
+			if max == nil || *f.Index.Eq > *max {
+				max = f.Index.Eq
+			}
+		}
+	}
+
+	return min, max
+}
+
+// MinMax function for BlockHeight
+func (f *FilterTransaction) MinMaxBlockHeight() (min *int, max *int) {
+	// Recursively handle And conditions
+	if len(f.And) > 0 {
+		for _, subFilter := range f.And {
+			subMin, subMax := subFilter.MinMaxBlockHeight()
+			if subMin != nil && (min == nil || *subMin < *min) {
+				min = subMin
+			}
+			if subMax != nil && (max == nil || *subMax > *max) {
+				max = subMax
+			}
+		}
+	}
+
+	// Recursively handle Or conditions
+	if len(f.Or) > 0 {
+		for _, subFilter := range f.Or {
+			subMin, subMax := subFilter.MinMaxBlockHeight()
+			if subMin != nil && (min == nil || *subMin < *min) {
+				min = subMin
+			}
+			if subMax != nil && (max == nil || *subMax > *max) {
+				max = subMax
+			}
+		}
+	}
+
+	if f.BlockHeight != nil {
+		if f.BlockHeight.Gt != nil {
+			if min == nil || *f.BlockHeight.Gt < *min {
+				min = f.BlockHeight.Gt
+			}
+		}
+
+		if f.BlockHeight.Lt != nil {
+			if max == nil || *f.BlockHeight.Lt > *max {
+				max = f.BlockHeight.Lt
+			}
+		}
+
+		if f.BlockHeight.Eq != nil {
+			if min == nil || *f.BlockHeight.Eq < *min {
+				min = f.BlockHeight.Eq
+			}
+			if max == nil || *f.BlockHeight.Eq > *max {
+				max = f.BlockHeight.Eq
+			}
+		}
+	}
+
+	return min, max
+}
+
+func (f *FilterTokenTransfer) Eval(obj *TokenTransfer) bool {
+	// Evaluate logical operators first
+	if len(f.And) > 0 {
+		for _, subFilter := range f.And {
+			if !subFilter.Eval(obj) {
+				return false
+			}
+		}
+	}
+
+	if len(f.Or) > 0 {
+		orResult := false
+		for _, subFilter := range f.Or {
+			if subFilter.Eval(obj) {
+				orResult = true
+				break
+			}
+		}
+		if !orResult {
+			return false
+		}
+	}
+
+	if f.Not != nil {
+		if f.Not.Eval(obj) {
+			return false
+		}
+	}
+
+	// Evaluate individual field filters
+
+	// Handle Token field
+	toEvalToken := obj.Token()
+	if f.Token != nil && !f.Token.Eval(&toEvalToken) {
+		return false
+	}
+
+	// Handle To field
+	toEvalTo := obj.To()
+	if f.To != nil && !f.To.Eval(&toEvalTo) {
+		return false
+	}
+
+	// Handle Index field
+	toEvalIndex := toIntPtr(obj.Index())
+	if f.Index != nil && !f.Index.Eval(toEvalIndex) {
+		return false
+	}
+
+	// Handle From field
+	toEvalFrom := obj.From()
+	if f.From != nil && !f.From.Eval(&toEvalFrom) {
+		return false
+	}
+
+	// Handle BlockHeight field
+	toEvalBlockHeight := toIntPtr(obj.BlockHeight())
+	if f.BlockHeight != nil && !f.BlockHeight.Eval(toEvalBlockHeight) {
+		return false
+	}
+
+	// Handle Amount field
+	toEvalAmount := toIntPtr(obj.Amount())
+	if f.Amount != nil && !f.Amount.Eval(toEvalAmount) {
+		return false
+	}
+
+	return true
+}
+
+// MinMax function for BlockHeight
+func (f *FilterTokenTransfer) MinMaxBlockHeight() (min *int, max *int) {
+	// Recursively handle And conditions
+	if len(f.And) > 0 {
+		for _, subFilter := range f.And {
+			subMin, subMax := subFilter.MinMaxBlockHeight()
+			if subMin != nil && (min == nil || *subMin < *min) {
+				min = subMin
+			}
+			if subMax != nil && (max == nil || *subMax > *max) {
+				max = subMax
+			}
+		}
+	}
+
+	// Recursively handle Or conditions
+	if len(f.Or) > 0 {
+		for _, subFilter := range f.Or {
+			subMin, subMax := subFilter.MinMaxBlockHeight()
+			if subMin != nil && (min == nil || *subMin < *min) {
+				min = subMin
+			}
+			if subMax != nil && (max == nil || *subMax > *max) {
+				max = subMax
+			}
+		}
+	}
+
+	if f.BlockHeight != nil {
+		if f.BlockHeight.Gt != nil {
+			if min == nil || *f.BlockHeight.Gt < *min {
+				min = f.BlockHeight.Gt
 			}
 		}
-		if !orResult {
-			return false
-		}
-	}
 
-	if f.Not != nil {
-		if f.Not.Eval(obj) {
-			return false
+		if f.BlockHeight.Lt != nil {
+			if max == nil || *f.BlockHeight.Lt > *max {
+				max = f.BlockHeight.Lt
+			}
 		}
-	}
-
-	// Evaluate individual field filters
 
-	// Handle Value field
-	toEvalValue := obj.Value
-	if f.Value != nil && !f.Value.Eval(&toEvalValue) {
-		return false
+		if f.BlockHeight.Eq != nil {
+			if min == nil || *f.BlockHeight.Eq < *min {
+				min = f.BlockHeight.Eq
+			}
+			if max == nil || *f.BlockHeight.Eq > *max {
+				max = f.BlockHeight.Eq
+			}
+		}
 	}
 
-	return true
+	return min, max
 }
 
-func (f *FilterTxFee) Eval(obj *TxFee) bool {
+func (f *FilterStorageUnlockEvent) Eval(obj *StorageUnlockEvent) bool {
 	// Evaluate logical operators first
 	if len(f.And) > 0 {
 		for _, subFilter := range f.And {
@@ -1178,22 +2961,34 @@ func (f *FilterTxFee) Eval(obj *TxFee) bool {
 
 	// Evaluate individual field filters
 
-	// Handle GasWanted field
-	toEvalGasWanted := toIntPtr(obj.GasWanted)
-	if f.GasWanted != nil && !f.GasWanted.Eval(toEvalGasWanted) {
+	// Handle Type field
+	toEvalType := obj.Type
+	if f.Type != nil && !f.Type.Eval(&toEvalType) {
 		return false
 	}
 
-	// Handle GasFee field
-	toEvalGasFee := obj.GasFee
-	if f.GasFee != nil && !f.GasFee.Eval(toEvalGasFee) {
+	// Handle PkgPath field
+	toEvalPkgPath := obj.PkgPath
+	if f.PkgPath != nil && !f.PkgPath.Eval(&toEvalPkgPath) {
+		return false
+	}
+
+	// Handle FeeRefund field
+	toEvalFeeRefund := obj.FeeRefund
+	if f.FeeRefund != nil && !f.FeeRefund.Eval(toEvalFeeRefund) {
+		return false
+	}
+
+	// Handle BytesDelta field
+	toEvalBytesDelta := toIntPtr(obj.BytesDelta)
+	if f.BytesDelta != nil && !f.BytesDelta.Eval(toEvalBytesDelta) {
 		return false
 	}
 
 	return true
 }
 
-func (f *FilterTransactionResponse) Eval(obj *TransactionResponse) bool {
+func (f *FilterStorageDepositEvent) Eval(obj *StorageDepositEvent) bool {
 	// Evaluate logical operators first
 	if len(f.And) > 0 {
 		for _, subFilter := range f.And {
@@ -1224,49 +3019,34 @@ func (f *FilterTransactionResponse) Eval(obj *TransactionResponse) bool {
 
 	// Evaluate individual field filters
 
-	// Handle Log field
-	toEvalLog := obj.Log()
-	if f.Log != nil && !f.Log.Eval(&toEvalLog) {
+	// Handle Type field
+	toEvalType := obj.Type
+	if f.Type != nil && !f.Type.Eval(&toEvalType) {
 		return false
 	}
 
-	// Handle Info field
-	toEvalInfo := obj.Info()
-	if f.Info != nil && !f.Info.Eval(&toEvalInfo) {
+	// Handle PkgPath field
+	toEvalPkgPath := obj.PkgPath
+	if f.PkgPath != nil && !f.PkgPath.Eval(&toEvalPkgPath) {
 		return false
 	}
 
-	// Handle Events slice
-	if f.Events != nil {
-		elemMatchEvents := false
-		for _, elem := range obj.Events() {
-			if f.Events.Eval(&elem) {
-				elemMatchEvents = true
-			}
-		}
-
-		if !elemMatchEvents {
-			return false
-		}
-
-	}
-
-	// Handle Error field
-	toEvalError := obj.Error()
-	if f.Error != nil && !f.Error.Eval(&toEvalError) {
+	// Handle FeeDelta field
+	toEvalFeeDelta := obj.FeeDelta
+	if f.FeeDelta != nil && !f.FeeDelta.Eval(toEvalFeeDelta) {
 		return false
 	}
 
-	// Handle Data field
-	toEvalData := obj.Data()
-	if f.Data != nil && !f.Data.Eval(&toEvalData) {
+	// Handle BytesDelta field
+	toEvalBytesDelta := toIntPtr(obj.BytesDelta)
+	if f.BytesDelta != nil && !f.BytesDelta.Eval(toEvalBytesDelta) {
 		return false
 	}
 
 	return true
 }
 
-func (f *FilterTransactionMessage) Eval(obj *TransactionMessage) bool {
+func (f *FilterPubKey) Eval(obj *PubKey) bool {
 	// Evaluate logical operators first
 	if len(f.And) > 0 {
 		for _, subFilter := range f.And {
@@ -1303,22 +3083,28 @@ func (f *FilterTransactionMessage) Eval(obj *TransactionMessage) bool {
 		return false
 	}
 
-	// Handle TypeURL field
-	toEvalTypeURL := obj.TypeURL
-	if f.TypeURL != nil && !f.TypeURL.Eval(&toEvalTypeURL) {
+	// Handle Type field
+	toEvalType := obj.Type
+	if f.Type != nil && !f.Type.Eval(&toEvalType) {
 		return false
 	}
 
-	// Handle Route field
-	toEvalRoute := obj.Route
-	if f.Route != nil && !f.Route.Eval(&toEvalRoute) {
+	// Handle Multisig field
+	toEvalMultisig := obj.Multisig
+	if f.Multisig != nil && !f.Multisig.Eval(toEvalMultisig) {
+		return false
+	}
+
+	// Handle Address field
+	toEvalAddress := obj.Address
+	if f.Address != nil && !f.Address.Eval(&toEvalAddress) {
 		return false
 	}
 
 	return true
 }
 
-func (f *FilterTransaction) Eval(obj *Transaction) bool {
+func (f *FilterPendingTransaction) Eval(obj *PendingTransaction) bool {
 	// Evaluate logical operators first
 	if len(f.And) > 0 {
 		for _, subFilter := range f.And {
@@ -1349,16 +3135,25 @@ func (f *FilterTransaction) Eval(obj *Transaction) bool {
 
 	// Evaluate individual field filters
 
-	// Handle Success field
-	toEvalSuccess := obj.Success()
-	if f.Success != nil && !f.Success.Eval(&toEvalSuccess) {
+	// Handle Status field
+	toEvalStatus := obj.Status()
+	if f.Status != nil && !f.Status.Eval(&toEvalStatus) {
 		return false
 	}
 
-	// Handle Response field
-	toEvalResponse := obj.Response()
-	if f.Response != nil && !f.Response.Eval(toEvalResponse) {
-		return false
+	// Handle Signers slice
+	if f.Signers != nil {
+		elemMatchSigners := false
+		for _, elem := range obj.Signers() {
+			if f.Signers.Eval(&elem) {
+				elemMatchSigners = true
+			}
+		}
+
+		if !elemMatchSigners {
+			return false
+		}
+
 	}
 
 	// Handle Messages slice
@@ -1382,9 +3177,9 @@ func (f *FilterTransaction) Eval(obj *Transaction) bool {
 		return false
 	}
 
-	// Handle Index field
-	toEvalIndex := toIntPtr(obj.Index())
-	if f.Index != nil && !f.Index.Eval(toEvalIndex) {
+	// Handle HashHex field
+	toEvalHashHex := obj.HashHex()
+	if f.HashHex != nil && !f.HashHex.Eval(&toEvalHashHex) {
 		return false
 	}
 
@@ -1400,18 +3195,18 @@ func (f *FilterTransaction) Eval(obj *Transaction) bool {
 		return false
 	}
 
-	// Handle GasUsed field
-	toEvalGasUsed := toIntPtr(obj.GasUsed())
-	if f.GasUsed != nil && !f.GasUsed.Eval(toEvalGasUsed) {
-		return false
-	}
-
 	// Handle GasFee field
 	toEvalGasFee := obj.GasFee()
 	if f.GasFee != nil && !f.GasFee.Eval(toEvalGasFee) {
 		return false
 	}
 
+	// Handle FirstSeen field
+	toEvalFirstSeen := obj.FirstSeen()
+	if f.FirstSeen != nil && !f.FirstSeen.Eval(&toEvalFirstSeen) {
+		return false
+	}
+
 	// Handle BlockHeight field
 	toEvalBlockHeight := toIntPtr(obj.BlockHeight())
 	if f.BlockHeight != nil && !f.BlockHeight.Eval(toEvalBlockHeight) {
@@ -1421,62 +3216,8 @@ func (f *FilterTransaction) Eval(obj *Transaction) bool {
 	return true
 }
 
-// MinMax function for Index
-func (f *FilterTransaction) MinMaxIndex() (min *int, max *int) {
-	// Recursively handle And conditions
-	if len(f.And) > 0 {
-		for _, subFilter := range f.And {
-			subMin, subMax := subFilter.MinMaxIndex()
-			if subMin != nil && (min == nil || *subMin < *min) {
-				min = subMin
-			}
-			if subMax != nil && (max == nil || *subMax > *max) {
-				max = subMax
-			}
-		}
-	}
-
-	// Recursively handle Or conditions
-	if len(f.Or) > 0 {
-		for _, subFilter := range f.Or {
-			subMin, subMax := subFilter.MinMaxIndex()
-			if subMin != nil && (min == nil || *subMin < *min) {
-				min = subMin
-			}
-			if subMax != nil && (max == nil || *subMax > *max) {
-				max = subMax
-			}
-		}
-	}
-
-	if f.Index != nil {
-		if f.Index.Gt != nil {
-			if min == nil || *f.Index.Gt < *min {
-				min = f.Index.Gt
-			}
-		}
-
-		if f.Index.Lt != nil {
-			if max == nil || *f.Index.Lt > *max {
-				max = f.Index.Lt
-			}
-		}
-
-		if f.Index.Eq != nil {
-			if min == nil || *f.Index.Eq < *min {
-				min = f.Index.Eq
-			}
-			if max == nil || *f.Index.Eq > *max {
-				max = f.Index.Eq
-			}
-		}
-	}
-
-	return min, max
-}
-
 // MinMax function for BlockHeight
-func (f *FilterTransaction) MinMaxBlockHeight() (min *int, max *int) {
+func (f *FilterPendingTransaction) MinMaxBlockHeight() (min *int, max *int) {
 	// Recursively handle And conditions
 	if len(f.And) > 0 {
 		for _, subFilter := range f.And {
@@ -1529,7 +3270,7 @@ func (f *FilterTransaction) MinMaxBlockHeight() (min *int, max *int) {
 	return min, max
 }
 
-func (f *FilterStorageUnlockEvent) Eval(obj *StorageUnlockEvent) bool {
+func (f *FilterPackage) Eval(obj *Package) bool {
 	// Evaluate logical operators first
 	if len(f.And) > 0 {
 		for _, subFilter := range f.And {
@@ -1560,34 +3301,98 @@ func (f *FilterStorageUnlockEvent) Eval(obj *StorageUnlockEvent) bool {
 
 	// Evaluate individual field filters
 
-	// Handle Type field
-	toEvalType := obj.Type
-	if f.Type != nil && !f.Type.Eval(&toEvalType) {
+	// Handle Success field
+	toEvalSuccess := obj.Success()
+	if f.Success != nil && !f.Success.Eval(&toEvalSuccess) {
+		return false
+	}
+
+	// Handle Path field
+	toEvalPath := obj.Path()
+	if f.Path != nil && !f.Path.Eval(&toEvalPath) {
+		return false
+	}
+
+	// Handle Name field
+	toEvalName := obj.Name()
+	if f.Name != nil && !f.Name.Eval(&toEvalName) {
+		return false
+	}
+
+	// Handle DeployIndex field
+	toEvalDeployIndex := toIntPtr(obj.DeployIndex())
+	if f.DeployIndex != nil && !f.DeployIndex.Eval(toEvalDeployIndex) {
+		return false
+	}
+
+	// Handle DeployHeight field
+	toEvalDeployHeight := toIntPtr(obj.DeployHeight())
+	if f.DeployHeight != nil && !f.DeployHeight.Eval(toEvalDeployHeight) {
+		return false
+	}
+
+	// Handle Creator field
+	toEvalCreator := obj.Creator()
+	if f.Creator != nil && !f.Creator.Eval(&toEvalCreator) {
 		return false
 	}
 
-	// Handle PkgPath field
-	toEvalPkgPath := obj.PkgPath
-	if f.PkgPath != nil && !f.PkgPath.Eval(&toEvalPkgPath) {
+	return true
+}
+
+func (f *FilterMultisigPubKey) Eval(obj *MultisigPubKey) bool {
+	// Evaluate logical operators first
+	if len(f.And) > 0 {
+		for _, subFilter := range f.And {
+			if !subFilter.Eval(obj) {
+				return false
+			}
+		}
+	}
+
+	if len(f.Or) > 0 {
+		orResult := false
+		for _, subFilter := range f.Or {
+			if subFilter.Eval(obj) {
+				orResult = true
+				break
+			}
+		}
+		if !orResult {
+			return false
+		}
+	}
+
+	if f.Not != nil {
+		if f.Not.Eval(obj) {
+			return false
+		}
+	}
+
+	// Evaluate individual field filters
+
+	// Handle Value field
+	toEvalValue := obj.Value
+	if f.Value != nil && !f.Value.Eval(&toEvalValue) {
 		return false
 	}
 
-	// Handle FeeRefund field
-	toEvalFeeRefund := obj.FeeRefund
-	if f.FeeRefund != nil && !f.FeeRefund.Eval(toEvalFeeRefund) {
+	// Handle Type field
+	toEvalType := obj.Type
+	if f.Type != nil && !f.Type.Eval(&toEvalType) {
 		return false
 	}
 
-	// Handle BytesDelta field
-	toEvalBytesDelta := toIntPtr(obj.BytesDelta)
-	if f.BytesDelta != nil && !f.BytesDelta.Eval(toEvalBytesDelta) {
+	// Handle Address field
+	toEvalAddress := obj.Address
+	if f.Address != nil && !f.Address.Eval(&toEvalAddress) {
 		return false
 	}
 
 	return true
 }
 
-func (f *FilterStorageDepositEvent) Eval(obj *StorageDepositEvent) bool {
+func (f *FilterMultisig) Eval(obj *Multisig) bool {
 	// Evaluate logical operators first
 	if len(f.And) > 0 {
 		for _, subFilter := range f.And {
@@ -1618,28 +3423,25 @@ func (f *FilterStorageDepositEvent) Eval(obj *StorageDepositEvent) bool {
 
 	// Evaluate individual field filters
 
-	// Handle Type field
-	toEvalType := obj.Type
-	if f.Type != nil && !f.Type.Eval(&toEvalType) {
+	// Handle Threshold field
+	toEvalThreshold := toIntPtr(obj.Threshold)
+	if f.Threshold != nil && !f.Threshold.Eval(toEvalThreshold) {
 		return false
 	}
 
-	// Handle PkgPath field
-	toEvalPkgPath := obj.PkgPath
-	if f.PkgPath != nil && !f.PkgPath.Eval(&toEvalPkgPath) {
-		return false
-	}
+	// Handle PubKeys slice
+	if f.PubKeys != nil {
+		elemMatchPubKeys := false
+		for _, elem := range obj.PubKeys {
+			if f.PubKeys.Eval(elem) {
+				elemMatchPubKeys = true
+			}
+		}
 
-	// Handle FeeDelta field
-	toEvalFeeDelta := obj.FeeDelta
-	if f.FeeDelta != nil && !f.FeeDelta.Eval(toEvalFeeDelta) {
-		return false
-	}
+		if !elemMatchPubKeys {
+			return false
+		}
 
-	// Handle BytesDelta field
-	toEvalBytesDelta := toIntPtr(obj.BytesDelta)
-	if f.BytesDelta != nil && !f.BytesDelta.Eval(toEvalBytesDelta) {
-		return false
 	}
 
 	return true
@@ -1676,6 +3478,21 @@ func (f *FilterMsgRun) Eval(obj *MsgRun) bool {
 
 	// Evaluate individual field filters
 
+	// Handle SendCoins slice
+	if f.SendCoins != nil {
+		elemMatchSendCoins := false
+		for _, elem := range obj.SendCoins {
+			if f.SendCoins.Eval(elem) {
+				elemMatchSendCoins = true
+			}
+		}
+
+		if !elemMatchSendCoins {
+			return false
+		}
+
+	}
+
 	// Handle Send field
 	toEvalSend := obj.Send
 	if f.Send != nil && !f.Send.Eval(&toEvalSend) {
@@ -1734,6 +3551,21 @@ func (f *FilterMsgCall) Eval(obj *MsgCall) bool {
 
 	// Evaluate individual field filters
 
+	// Handle SendCoins slice
+	if f.SendCoins != nil {
+		elemMatchSendCoins := false
+		for _, elem := range obj.SendCoins {
+			if f.SendCoins.Eval(elem) {
+				elemMatchSendCoins = true
+			}
+		}
+
+		if !elemMatchSendCoins {
+			return false
+		}
+
+	}
+
 	// Handle Send field
 	toEvalSend := obj.Send
 	if f.Send != nil && !f.Send.Eval(&toEvalSend) {
@@ -1813,6 +3645,21 @@ func (f *FilterMsgAddPackage) Eval(obj *MsgAddPackage) bool {
 
 	// Evaluate individual field filters
 
+	// Handle SendCoins slice
+	if f.SendCoins != nil {
+		elemMatchSendCoins := false
+		for _, elem := range obj.SendCoins {
+			if f.SendCoins.Eval(elem) {
+				elemMatchSendCoins = true
+			}
+		}
+
+		if !elemMatchSendCoins {
+			return false
+		}
+
+	}
+
 	// Handle Send field
 	toEvalSend := obj.Send
 	if f.Send != nil && !f.Send.Eval(&toEvalSend) {
@@ -1878,7 +3725,7 @@ func (f *FilterMessageValue) Eval(obj *MessageValue) bool {
 	// Handle union objects depending of the type
 
 	// Check if any filters are specified
-	filtersSpecified := f.BankMsgSend != nil || f.MsgCall != nil || f.MsgAddPackage != nil || f.MsgRun != nil || false
+	filtersSpecified := f.BankMsgSend != nil || f.MsgCall != nil || f.MsgAddPackage != nil || f.MsgRun != nil || f.GenericMessage != nil || false
 
 	// If no filters are specified for any types, accept all objects
 	if !filtersSpecified {
@@ -1941,6 +3788,19 @@ func (f *FilterMessageValue) Eval(obj *MessageValue) bool {
 		}
 	}
 
+	if uObj, ok := tobj.(GenericMessage); ok {
+		matchedType = true
+		if f.GenericMessage != nil && f.GenericMessage.Eval(&uObj) {
+			return true
+		}
+	}
+	if uObj, ok := tobj.(*GenericMessage); ok {
+		matchedType = true
+		if f.GenericMessage != nil && f.GenericMessage.Eval(uObj) {
+			return true
+		}
+	}
+
 	// If the object is of a type specified in filters but didn't match, return false.
 	if matchedType {
 		return false
@@ -1949,6 +3809,52 @@ func (f *FilterMessageValue) Eval(obj *MessageValue) bool {
 	return true
 }
 
+func (f *FilterMessageField) Eval(obj *MessageField) bool {
+	// Evaluate logical operators first
+	if len(f.And) > 0 {
+		for _, subFilter := range f.And {
+			if !subFilter.Eval(obj) {
+				return false
+			}
+		}
+	}
+
+	if len(f.Or) > 0 {
+		orResult := false
+		for _, subFilter := range f.Or {
+			if subFilter.Eval(obj) {
+				orResult = true
+				break
+			}
+		}
+		if !orResult {
+			return false
+		}
+	}
+
+	if f.Not != nil {
+		if f.Not.Eval(obj) {
+			return false
+		}
+	}
+
+	// Evaluate individual field filters
+
+	// Handle Value field
+	toEvalValue := obj.Value
+	if f.Value != nil && !f.Value.Eval(&toEvalValue) {
+		return false
+	}
+
+	// Handle Path field
+	toEvalPath := obj.Path
+	if f.Path != nil && !f.Path.Eval(&toEvalPath) {
+		return false
+	}
+
+	return true
+}
+
 func (f *FilterMemPackage) Eval(obj *MemPackage) bool {
 	// Evaluate logical operators first
 	if len(f.And) > 0 {
@@ -2056,6 +3962,96 @@ func (f *FilterMemFile) Eval(obj *MemFile) bool {
 	return true
 }
 
+func (f *FilterLookupResult) Eval(obj *LookupResult) bool {
+	// Evaluate logical operators first
+	if len(f.And) > 0 {
+		for _, subFilter := range f.And {
+			if !subFilter.Eval(obj) {
+				return false
+			}
+		}
+	}
+
+	if len(f.Or) > 0 {
+		orResult := false
+		for _, subFilter := range f.Or {
+			if subFilter.Eval(obj) {
+				orResult = true
+				break
+			}
+		}
+		if !orResult {
+			return false
+		}
+	}
+
+	if f.Not != nil {
+		if f.Not.Eval(obj) {
+			return false
+		}
+	}
+
+	// Handle union objects depending of the type
+
+	// Check if any filters are specified
+	filtersSpecified := f.Block != nil || f.Transaction != nil || f.Package != nil || false
+
+	// If no filters are specified for any types, accept all objects
+	if !filtersSpecified {
+		return true
+	}
+
+	// Evaluate specified type filters
+	matchedType := false
+
+	tobj := *obj
+	if uObj, ok := tobj.(Block); ok {
+		matchedType = true
+		if f.Block != nil && f.Block.Eval(&uObj) {
+			return true
+		}
+	}
+	if uObj, ok := tobj.(*Block); ok {
+		matchedType = true
+		if f.Block != nil && f.Block.Eval(uObj) {
+			return true
+		}
+	}
+
+	if uObj, ok := tobj.(Transaction); ok {
+		matchedType = true
+		if f.Transaction != nil && f.Transaction.Eval(&uObj) {
+			return true
+		}
+	}
+	if uObj, ok := tobj.(*Transaction); ok {
+		matchedType = true
+		if f.Transaction != nil && f.Transaction.Eval(uObj) {
+			return true
+		}
+	}
+
+	if uObj, ok := tobj.(Package); ok {
+		matchedType = true
+		if f.Package != nil && f.Package.Eval(&uObj) {
+			return true
+		}
+	}
+	if uObj, ok := tobj.(*Package); ok {
+		matchedType = true
+		if f.Package != nil && f.Package.Eval(uObj) {
+			return true
+		}
+	}
+
+	// If the object is of a type specified in filters but didn't match, return false.
+	if matchedType {
+		return false
+	}
+
+	return true
+}
+
 func (f *FilterGnoEventAttribute) Eval(obj *GnoEventAttribute) bool {
 	// Evaluate logical operators first
 	if len(f.And) > 0 {
@@ -2163,6 +4159,61 @@ func (f *FilterGnoEvent) Eval(obj *GnoEvent) bool {
 	return true
 }
 
+func (f *FilterGenericMessage) Eval(obj *GenericMessage) bool {
+	// Evaluate logical operators first
+	if len(f.And) > 0 {
+		for _, subFilter := range f.And {
+			if !subFilter.Eval(obj) {
+				return false
+			}
+		}
+	}
+
+	if len(f.Or) > 0 {
+		orResult := false
+		for _, subFilter := range f.Or {
+			if subFilter.Eval(obj) {
+				orResult = true
+				break
+			}
+		}
+		if !orResult {
+			return false
+		}
+	}
+
+	if f.Not != nil {
+		if f.Not.Eval(obj) {
+			return false
+		}
+	}
+
+	// Evaluate individual field filters
+
+	// Handle TypeURL field
+	toEvalTypeURL := obj.TypeURL
+	if f.TypeURL != nil && !f.TypeURL.Eval(&toEvalTypeURL) {
+		return false
+	}
+
+	// Handle Fields slice
+	if f.Fields != nil {
+		elemMatchFields := false
+		for _, elem := range obj.Fields {
+			if f.Fields.Eval(elem) {
+				elemMatchFields = true
+			}
+		}
+
+		if !elemMatchFields {
+			return false
+		}
+
+	}
+
+	return true
+}
+
 func (f *FilterEvent) Eval(obj *Event) bool {
 	// Evaluate logical operators first
 	if len(f.And) > 0 {
@@ -2440,6 +4491,12 @@ func (f *FilterBlock) Eval(obj *Block) bool {
 		return false
 	}
 
+	// Handle ProposerAddress field
+	toEvalProposerAddress := obj.ProposerAddress()
+	if f.ProposerAddress != nil && !f.ProposerAddress.Eval(&toEvalProposerAddress) {
+		return false
+	}
+
 	// Handle NumTxs field
 	toEvalNumTxs := toIntPtr(obj.NumTxs())
 	if f.NumTxs != nil && !f.NumTxs.Eval(toEvalNumTxs) {
@@ -2476,6 +4533,12 @@ func (f *FilterBlock) Eval(obj *Block) bool {
 		return false
 	}
 
+	// Handle HashHex field
+	toEvalHashHex := obj.HashHex()
+	if f.HashHex != nil && !f.HashHex.Eval(&toEvalHashHex) {
+		return false
+	}
+
 	// Handle Hash field
 	toEvalHash := obj.Hash()
 	if f.Hash != nil && !f.Hash.Eval(&toEvalHash) {
@@ -2606,6 +4669,21 @@ func (f *FilterBankMsgSend) Eval(obj *BankMsgSend) bool {
 		return false
 	}
 
+	// Handle AmountCoins slice
+	if f.AmountCoins != nil {
+		elemMatchAmountCoins := false
+		for _, elem := range obj.AmountCoins {
+			if f.AmountCoins.Eval(elem) {
+				elemMatchAmountCoins = true
+			}
+		}
+
+		if !elemMatchAmountCoins {
+			return false
+		}
+
+	}
+
 	// Handle Amount field
 	toEvalAmount := obj.Amount
 	if f.Amount != nil && !f.Amount.Eval(&toEvalAmount) {
@@ -0,0 +1,195 @@
+package model
+
+import (
+	"time"
+
+	"github.com/gnolang/tx-indexer/serve/methods"
+)
+
+type GasPriceSuggestion struct {
+	suggestion *methods.GasPriceSuggestion
+}
+
+func NewGasPriceSuggestion(suggestion *methods.GasPriceSuggestion) *GasPriceSuggestion {
+	return &GasPriceSuggestion{
+		suggestion: suggestion,
+	}
+}
+
+func (g *GasPriceSuggestion) Denom() string {
+	return g.suggestion.Denom
+}
+
+func (g *GasPriceSuggestion) TxCount() int {
+	return int(g.suggestion.TxCount)
+}
+
+func (g *GasPriceSuggestion) P10() float64 {
+	return g.suggestion.P10
+}
+
+func (g *GasPriceSuggestion) P50() float64 {
+	return g.suggestion.P50
+}
+
+func (g *GasPriceSuggestion) P90() float64 {
+	return g.suggestion.P90
+}
+
+func (g *GasPriceSuggestion) RecommendedPrice() float64 {
+	return g.suggestion.RecommendedPrice
+}
+
+func (g *GasPriceSuggestion) RecommendedFee() *int {
+	if g.suggestion.RecommendedFee == 0 {
+		return nil
+	}
+
+	fee := int(g.suggestion.RecommendedFee)
+
+	return &fee
+}
+
+// MethodsSpeed returns the matching gas price suggestion speed
+func (e InclusionSpeed) MethodsSpeed() methods.InclusionSpeed {
+	switch e {
+	case InclusionSpeedSlow:
+		return methods.SpeedSlow
+	case InclusionSpeedFast:
+		return methods.SpeedFast
+	default:
+		return methods.SpeedStandard
+	}
+}
+
+type GasStats struct {
+	stats *methods.GasStats
+}
+
+func NewGasStats(stats *methods.GasStats) *GasStats {
+	return &GasStats{
+		stats: stats,
+	}
+}
+
+func (g *GasStats) PkgPath() string {
+	return g.stats.PkgPath
+}
+
+func (g *GasStats) Func() string {
+	return g.stats.Func
+}
+
+func (g *GasStats) Calls() int {
+	return int(g.stats.Calls)
+}
+
+func (g *GasStats) Failures() int {
+	return int(g.stats.Failures)
+}
+
+func (g *GasStats) FailureRate() float64 {
+	return g.stats.FailureRate
+}
+
+func (g *GasStats) AverageGasUsed() int {
+	return int(g.stats.AverageGasUsed)
+}
+
+func (g *GasStats) AverageGasWanted() int {
+	return int(g.stats.AverageGasWanted)
+}
+
+func (g *GasStats) MinGasUsed() int {
+	return int(g.stats.MinGasUsed)
+}
+
+func (g *GasStats) MaxGasUsed() int {
+	return int(g.stats.MaxGasUsed)
+}
+
+func (g *GasStats) P50GasUsed() int {
+	return int(g.stats.P50GasUsed)
+}
+
+func (g *GasStats) P90GasUsed() int {
+	return int(g.stats.P90GasUsed)
+}
+
+func (g *GasStats) OverProvisioningRatio() float64 {
+	return g.stats.OverProvisioningRatio
+}
+
+func (g *GasStats) Histogram() []*GasBucket {
+	histogram := make([]*GasBucket, 0, len(g.stats.Histogram))
+
+	for _, bucket := range g.stats.Histogram {
+		histogram = append(histogram, &GasBucket{
+			From:  int(bucket.From),
+			To:    int(bucket.To),
+			Count: int(bucket.Count),
+		})
+	}
+
+	return histogram
+}
+
+type FeeHistoryBucket struct {
+	bucket *methods.FeeHistoryBucket
+}
+
+func NewFeeHistoryBucket(bucket *methods.FeeHistoryBucket) *FeeHistoryBucket {
+	return &FeeHistoryBucket{
+		bucket: bucket,
+	}
+}
+
+func (f *FeeHistoryBucket) Start() time.Time {
+	return f.bucket.Start
+}
+
+func (f *FeeHistoryBucket) End() time.Time {
+	return f.bucket.End
+}
+
+func (f *FeeHistoryBucket) FromHeight() int {
+	return int(f.bucket.FromHeight)
+}
+
+func (f *FeeHistoryBucket) ToHeight() int {
+	return int(f.bucket.ToHeight)
+}
+
+func (f *FeeHistoryBucket) Blocks() int {
+	return int(f.bucket.Blocks)
+}
+
+func (f *FeeHistoryBucket) TxCount() int {
+	return int(f.bucket.TxCount)
+}
+
+func (f *FeeHistoryBucket) TotalGasWanted() int {
+	return int(f.bucket.TotalGasWanted)
+}
+
+func (f *FeeHistoryBucket) TotalGasUsed() int {
+	return int(f.bucket.TotalGasUsed)
+}
+
+func (f *FeeHistoryBucket) Denoms() []*FeeHistoryDenom {
+	denoms := make([]*FeeHistoryDenom, 0, len(f.bucket.Denoms))
+
+	for _, denom := range f.bucket.Denoms {
+		denoms = append(denoms, &FeeHistoryDenom{
+			Denom:       denom.Denom,
+			TxCount:     int(denom.TxCount),
+			TotalAmount: int(denom.TotalAmount),
+			Low:         int(denom.Low),
+			Average:     int(denom.Average),
+			High:        int(denom.High),
+			MedianPrice: denom.MedianPrice,
+		})
+	}
+
+	return denoms
+}
@@ -0,0 +1,76 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gnolang/tx-indexer/types"
+)
+
+type Genesis struct {
+	genesis *types.Genesis
+}
+
+func NewGenesis(genesis *types.Genesis) *Genesis {
+	return &Genesis{
+		genesis: genesis,
+	}
+}
+
+func (g *Genesis) ChainID() string {
+	return g.genesis.ChainID
+}
+
+func (g *Genesis) GenesisTime() time.Time {
+	return g.genesis.GenesisTime
+}
+
+func (g *Genesis) Balances() []*GenesisBalance {
+	balances := make([]*GenesisBalance, 0, len(g.genesis.Balances))
+
+	for _, balance := range g.genesis.Balances {
+		balances = append(balances, &GenesisBalance{
+			Address: balance.Address,
+			Amount:  makeCoins(balance.Coins),
+		})
+	}
+
+	return balances
+}
+
+func (g *Genesis) Packages() []string {
+	return g.genesis.Packages
+}
+
+func (g *Genesis) AuthParams() any {
+	return decodeParams(g.genesis.AuthParams)
+}
+
+func (g *Genesis) BankParams() any {
+	return decodeParams(g.genesis.BankParams)
+}
+
+func (g *Genesis) VMParams() any {
+	return decodeParams(g.genesis.VMParams)
+}
+
+func (g *Genesis) RealmParams() any {
+	return decodeParams(g.genesis.RealmParams)
+}
+
+type GenesisBalance struct {
+	Address string  `json:"address"`
+	Amount  []*Coin `json:"amount"`
+}
+
+// decodeParams decodes the JSON encoded module parameters,
+// falling back to the raw JSON if they can't be decoded
+func decodeParams(params string) any {
+	var decoded any
+
+	if err := json.Unmarshal([]byte(params), &decoded); err != nil {
+		return params
+	}
+
+	return decoded
+}
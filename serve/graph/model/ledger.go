@@ -0,0 +1,77 @@
+package model
+
+import (
+	"github.com/gnolang/tx-indexer/types"
+)
+
+type Transfer struct {
+	transfer *types.Transfer
+}
+
+func NewTransfer(transfer *types.Transfer) *Transfer {
+	return &Transfer{
+		transfer: transfer,
+	}
+}
+
+func (t *Transfer) BlockHeight() int {
+	return int(t.transfer.Height)
+}
+
+func (t *Transfer) Index() int {
+	return int(t.transfer.Index)
+}
+
+func (t *Transfer) Position() int {
+	return int(t.transfer.Position)
+}
+
+func (t *Transfer) Kind() string {
+	return string(t.transfer.Kind)
+}
+
+func (t *Transfer) From() string {
+	return t.transfer.From
+}
+
+func (t *Transfer) To() string {
+	return t.transfer.To
+}
+
+func (t *Transfer) Denom() string {
+	return t.transfer.Denom
+}
+
+func (t *Transfer) Amount() int {
+	return int(t.transfer.Amount)
+}
+
+type BalanceChange struct {
+	delta *types.BalanceDelta
+}
+
+func NewBalanceChange(delta *types.BalanceDelta) *BalanceChange {
+	return &BalanceChange{
+		delta: delta,
+	}
+}
+
+func (b *BalanceChange) BlockHeight() int {
+	return int(b.delta.Height)
+}
+
+func (b *BalanceChange) Address() string {
+	return b.delta.Address
+}
+
+func (b *BalanceChange) Denom() string {
+	return b.delta.Denom
+}
+
+func (b *BalanceChange) Delta() int {
+	return int(b.delta.Delta)
+}
+
+func (b *BalanceChange) Balance() int {
+	return int(b.delta.Balance)
+}
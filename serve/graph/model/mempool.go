@@ -0,0 +1,77 @@
+package model
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+
+	indexerTypes "github.com/gnolang/tx-indexer/types"
+)
+
+type PendingTransaction struct {
+	pending *indexerTypes.PendingTx
+
+	// tx decodes the transaction content
+	tx *Transaction
+}
+
+func NewPendingTransaction(pending *indexerTypes.PendingTx) *PendingTransaction {
+	return &PendingTransaction{
+		pending: pending,
+		tx: NewTransaction(&types.TxResult{
+			Height: pending.Height,
+			Tx:     pending.Tx,
+		}),
+	}
+}
+
+func (p *PendingTransaction) Hash() string {
+	return base64.StdEncoding.EncodeToString(p.pending.Hash)
+}
+
+func (p *PendingTransaction) HashHex() string {
+	return hex.EncodeToString(p.pending.Hash)
+}
+
+func (p *PendingTransaction) Status() string {
+	return string(p.pending.Status)
+}
+
+func (p *PendingTransaction) FirstSeen() time.Time {
+	return p.pending.FirstSeen
+}
+
+func (p *PendingTransaction) BlockHeight() int {
+	return int(p.pending.Height)
+}
+
+func (p *PendingTransaction) GasWanted() int {
+	stdTx := p.tx.getStdTx()
+	if stdTx == nil {
+		return 0
+	}
+
+	return int(stdTx.Fee.GasWanted)
+}
+
+func (p *PendingTransaction) GasFee() *Coin {
+	return p.tx.GasFee()
+}
+
+func (p *PendingTransaction) ContentRaw() string {
+	return p.tx.ContentRaw()
+}
+
+func (p *PendingTransaction) Messages() []*TransactionMessage {
+	return p.tx.Messages()
+}
+
+func (p *PendingTransaction) Memo() string {
+	return p.tx.Memo()
+}
+
+func (p *PendingTransaction) Signers() []string {
+	return p.tx.Signers()
+}
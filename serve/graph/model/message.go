@@ -0,0 +1,150 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/gnolang/gno/gno.land/pkg/sdk/vm"
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	"github.com/gnolang/gno/tm2/pkg/sdk/bank"
+	"github.com/gnolang/gno/tm2/pkg/std"
+)
+
+// MessageDecoder converts a transaction message into its GraphQL value.
+// Decoders of custom Msg types can return a GenericMessage
+// built with NewGenericMessage, optionally with additional filter fields
+type MessageDecoder func(msg std.Msg) (MessageValue, error)
+
+var (
+	decodersMux sync.RWMutex
+	decoders    = map[string]MessageDecoder{
+		amino.GetTypeURL(bank.MsgSend{}): func(msg std.Msg) (MessageValue, error) {
+			return makeBankMsgSend(msg), nil
+		},
+		amino.GetTypeURL(vm.MsgCall{}): func(msg std.Msg) (MessageValue, error) {
+			return makeVMMsgCall(msg), nil
+		},
+		amino.GetTypeURL(vm.MsgAddPackage{}): func(msg std.Msg) (MessageValue, error) {
+			return makeVMAddPackage(msg), nil
+		},
+		amino.GetTypeURL(vm.MsgRun{}): func(msg std.Msg) (MessageValue, error) {
+			return makeVMMsgRun(msg), nil
+		},
+	}
+)
+
+// RegisterMessageDecoder registers the decoder for the messages
+// of the given amino type URL (ex. `/bank.MsgSend`), replacing the existing one.
+// The Msg type itself needs to be registered with amino for the transactions to be decoded.
+// Messages without a decoder are exposed as a GenericMessage
+func RegisterMessageDecoder(typeURL string, decoder MessageDecoder) {
+	decodersMux.Lock()
+	defer decodersMux.Unlock()
+
+	decoders[typeURL] = decoder
+}
+
+// getMessageDecoder returns the decoder registered for the type URL, if any
+func getMessageDecoder(typeURL string) (MessageDecoder, bool) {
+	decodersMux.RLock()
+	defer decodersMux.RUnlock()
+
+	decoder, ok := decoders[typeURL]
+
+	return decoder, ok
+}
+
+// decodeMessage converts the message using its registered decoder,
+// falling back to the generic JSON representation
+func decodeMessage(message std.Msg) MessageValue {
+	typeURL := amino.GetTypeURL(message)
+
+	if decoder, ok := getMessageDecoder(typeURL); ok {
+		if value, err := decoder(message); err == nil && value != nil {
+			return value
+		}
+	}
+
+	generic, err := NewGenericMessage(typeURL, message)
+	if err != nil {
+		return makeUnexpectedMessage(message)
+	}
+
+	return generic
+}
+
+// GenericMessage is a message without a dedicated decoder, exposed as its amino JSON.
+// Its leaf values are flattened into field paths, so they can be filtered on
+type GenericMessage struct {
+	Value   any
+	TypeURL string
+	Fields  []*MessageField
+}
+
+func (GenericMessage) IsMessageValue() {}
+
+// MessageField is a single leaf value of a GenericMessage
+type MessageField struct {
+	Path  string
+	Value string
+}
+
+// NewGenericMessage builds the generic message of the value, using its amino JSON encoding.
+// Nested values are flattened into dot separated paths (ex. `amount.denom`, `args.0`)
+func NewGenericMessage(typeURL string, value any) (GenericMessage, error) {
+	raw, err := amino.MarshalJSON(value)
+	if err != nil {
+		return GenericMessage{}, fmt.Errorf("unable to marshal message, %w", err)
+	}
+
+	var decoded any
+
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return GenericMessage{}, fmt.Errorf("unable to unmarshal message, %w", err)
+	}
+
+	fields := make([]*MessageField, 0)
+	flattenMessageFields("", decoded, &fields)
+
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Path < fields[j].Path
+	})
+
+	return GenericMessage{
+		TypeURL: typeURL,
+		Value:   decoded,
+		Fields:  fields,
+	}, nil
+}
+
+// flattenMessageFields appends the leaf values of the decoded JSON to the fields.
+// Null values are skipped
+func flattenMessageFields(path string, value any, fields *[]*MessageField) {
+	join := func(key string) string {
+		if path == "" {
+			return key
+		}
+
+		return path + "." + key
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		for key, nested := range v {
+			flattenMessageFields(join(key), nested, fields)
+		}
+	case []any:
+		for index, nested := range v {
+			flattenMessageFields(join(strconv.Itoa(index)), nested, fields)
+		}
+	case string:
+		*fields = append(*fields, &MessageField{Path: path, Value: v})
+	case float64:
+		*fields = append(*fields, &MessageField{Path: path, Value: strconv.FormatFloat(v, 'f', -1, 64)})
+	case bool:
+		*fields = append(*fields, &MessageField{Path: path, Value: strconv.FormatBool(v)})
+	}
+}
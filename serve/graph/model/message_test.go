@@ -0,0 +1,335 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	"github.com/gnolang/gno/tm2/pkg/sdk/bank"
+	"github.com/gnolang/gno/tm2/pkg/std"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testMsg is a custom chain module message, without a dedicated decoder
+type testMsg struct {
+	Outputs []testOutput   `json:"outputs"`
+	Caller  crypto.Address `json:"caller"`
+}
+
+// testOutput is a single output of the custom message
+type testOutput struct {
+	Amount  std.Coins      `json:"amount"`
+	Address crypto.Address `json:"address"`
+}
+
+func (msg testMsg) Route() string                { return "test" }
+func (msg testMsg) Type() string                 { return "test" }
+func (msg testMsg) ValidateBasic() error         { return nil }
+func (msg testMsg) GetSignBytes() []byte         { return nil }
+func (msg testMsg) GetSigners() []crypto.Address { return []crypto.Address{msg.Caller} }
+
+var _ = amino.RegisterPackage(amino.NewPackage(
+	"github.com/gnolang/tx-indexer/serve/graph/model",
+	"model_test",
+	amino.GetCallersDirname(),
+).WithDependencies().WithTypes(
+	testMsg{}, "testMsg",
+	testOutput{}, "testOutput",
+))
+
+// newTestMsg creates a custom message, sending coins to the given addresses
+func newTestMsg(caller crypto.Address, to ...crypto.Address) testMsg {
+	msg := testMsg{
+		Caller:  caller,
+		Outputs: make([]testOutput, 0, len(to)),
+	}
+
+	for _, address := range to {
+		msg.Outputs = append(msg.Outputs, testOutput{
+			Address: address,
+			Amount:  std.NewCoins(std.NewCoin("ugnot", 10)),
+		})
+	}
+
+	return msg
+}
+
+func TestRegisterMessageDecoder(t *testing.T) {
+	// The decoder registry is global, so the test can't run in parallel
+	var (
+		typeURL = amino.GetTypeURL(bank.MsgSend{})
+		msg     = bank.MsgSend{
+			FromAddress: crypto.AddressFromPreimage([]byte("from")),
+			ToAddress:   crypto.AddressFromPreimage([]byte("to")),
+			Amount:      std.NewCoins(std.NewCoin("ugnot", 10)),
+		}
+
+		overridden = GenericMessage{TypeURL: "overridden"}
+	)
+
+	builtIn, ok := getMessageDecoder(typeURL)
+	require.True(t, ok)
+
+	t.Cleanup(func() {
+		RegisterMessageDecoder(typeURL, builtIn)
+	})
+
+	testTable := []struct {
+		decoder  MessageDecoder
+		validate func(t *testing.T, value MessageValue)
+		name     string
+	}{
+		{
+			func(std.Msg) (MessageValue, error) {
+				return overridden, nil
+			},
+			func(t *testing.T, value MessageValue) {
+				t.Helper()
+
+				assert.Equal(t, overridden, value)
+			},
+			"decoder overriding the built-in one",
+		},
+		{
+			func(std.Msg) (MessageValue, error) {
+				return nil, errors.New("unable to decode")
+			},
+			func(t *testing.T, value MessageValue) {
+				t.Helper()
+
+				generic, ok := value.(GenericMessage)
+				require.True(t, ok)
+
+				assert.Equal(t, typeURL, generic.TypeURL)
+			},
+			"failing decoder falling back to the generic message",
+		},
+		{
+			builtIn,
+			func(t *testing.T, value MessageValue) {
+				t.Helper()
+
+				send, ok := value.(BankMsgSend)
+				require.True(t, ok)
+
+				assert.Equal(t, msg.ToAddress.String(), send.ToAddress)
+				assert.Equal(t, "10ugnot", send.Amount)
+			},
+			"built-in decoder",
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			RegisterMessageDecoder(typeURL, testCase.decoder)
+
+			testCase.validate(t, decodeMessage(msg))
+		})
+	}
+}
+
+func TestDecodeMessage_UnknownType(t *testing.T) {
+	t.Parallel()
+
+	var (
+		from = crypto.AddressFromPreimage([]byte("from"))
+		to   = crypto.AddressFromPreimage([]byte("to"))
+	)
+
+	value := decodeMessage(newTestMsg(from, to))
+
+	// Make sure messages without a decoder are exposed generically
+	generic, ok := value.(GenericMessage)
+	require.True(t, ok)
+
+	assert.Equal(t, "/model_test.testMsg", generic.TypeURL)
+	assert.Equal(t, []*MessageField{
+		{Path: "caller", Value: from.String()},
+		{Path: "outputs.0.address", Value: to.String()},
+		{Path: "outputs.0.amount", Value: "10ugnot"},
+	}, generic.Fields)
+}
+
+func TestFlattenMessageFields(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name     string
+		raw      string
+		expected []*MessageField
+	}{
+		{
+			"flat values",
+			`{"pkg": "gno.land/r/demo/foo", "amount": 10.5, "send": true}`,
+			[]*MessageField{
+				{Path: "amount", Value: "10.5"},
+				{Path: "pkg", Value: "gno.land/r/demo/foo"},
+				{Path: "send", Value: "true"},
+			},
+		},
+		{
+			"nested values",
+			`{"package": {"name": "foo", "meta": {"version": 2}}}`,
+			[]*MessageField{
+				{Path: "package.meta.version", Value: "2"},
+				{Path: "package.name", Value: "foo"},
+			},
+		},
+		{
+			"array values",
+			`{"args": ["a", "b"], "files": [{"name": "foo.gno"}, {"name": "bar.gno"}]}`,
+			[]*MessageField{
+				{Path: "args.0", Value: "a"},
+				{Path: "args.1", Value: "b"},
+				{Path: "files.0.name", Value: "foo.gno"},
+				{Path: "files.1.name", Value: "bar.gno"},
+			},
+		},
+		{
+			"nested arrays",
+			`{"matrix": [[1, 2], [3]]}`,
+			[]*MessageField{
+				{Path: "matrix.0.0", Value: "1"},
+				{Path: "matrix.0.1", Value: "2"},
+				{Path: "matrix.1.0", Value: "3"},
+			},
+		},
+		{
+			"null and empty values",
+			`{"deposit": null, "args": [], "meta": {}, "memo": ""}`,
+			[]*MessageField{
+				{Path: "memo", Value: ""},
+			},
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var decoded any
+
+			require.NoError(t, json.Unmarshal([]byte(testCase.raw), &decoded))
+
+			fields := make([]*MessageField, 0)
+			flattenMessageFields("", decoded, &fields)
+
+			sort.Slice(fields, func(i, j int) bool {
+				return fields[i].Path < fields[j].Path
+			})
+
+			assert.Equal(t, testCase.expected, fields)
+		})
+	}
+}
+
+func TestGenericMessage_Filter(t *testing.T) {
+	t.Parallel()
+
+	var (
+		from  = crypto.AddressFromPreimage([]byte("from"))
+		to    = crypto.AddressFromPreimage([]byte("to"))
+		other = crypto.AddressFromPreimage([]byte("other"))
+	)
+
+	encodedTx, err := amino.Marshal(&std.Tx{
+		Msgs: []std.Msg{
+			newTestMsg(from, other, to),
+		},
+	})
+	require.NoError(t, err)
+
+	tx := NewTransaction(&types.TxResult{
+		Tx: encodedTx,
+	})
+
+	ptr := func(s string) *string {
+		return &s
+	}
+
+	// fieldFilter filters the transactions on the fields of their generic messages
+	fieldFilter := func(filter *NestedFilterGenericMessage) *FilterTransaction {
+		return &FilterTransaction{
+			Messages: &NestedFilterTransactionMessage{
+				Value: &NestedFilterMessageValue{
+					GenericMessage: filter,
+				},
+			},
+		}
+	}
+
+	testTable := []struct {
+		filter  *FilterTransaction
+		name    string
+		matches bool
+	}{
+		{
+			fieldFilter(&NestedFilterGenericMessage{
+				TypeURL: &FilterString{Eq: ptr("/model_test.testMsg")},
+			}),
+			"matching type URL",
+			true,
+		},
+		{
+			fieldFilter(&NestedFilterGenericMessage{
+				Fields: &NestedFilterMessageField{
+					Path:  &FilterString{Eq: ptr("outputs.1.address")},
+					Value: &FilterString{Eq: ptr(to.String())},
+				},
+			}),
+			"matching field",
+			true,
+		},
+		{
+			fieldFilter(&NestedFilterGenericMessage{
+				Fields: &NestedFilterMessageField{
+					Path:  &FilterString{Like: ptr(`^outputs\.\d+\.address$`)},
+					Value: &FilterString{Eq: ptr(other.String())},
+				},
+			}),
+			"matching field path pattern",
+			true,
+		},
+		{
+			fieldFilter(&NestedFilterGenericMessage{
+				Fields: &NestedFilterMessageField{
+					Path:  &FilterString{Eq: ptr("caller")},
+					Value: &FilterString{Eq: ptr(to.String())},
+				},
+			}),
+			"value at another path",
+			false,
+		},
+		{
+			fieldFilter(&NestedFilterGenericMessage{
+				TypeURL: &FilterString{Eq: ptr("/bank.MsgSend")},
+			}),
+			"other type URL",
+			false,
+		},
+		{
+			&FilterTransaction{
+				Messages: &NestedFilterTransactionMessage{
+					Value: &NestedFilterMessageValue{
+						BankMsgSend: &NestedFilterBankMsgSend{},
+					},
+				},
+			},
+			"other message value type",
+			false,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testCase.matches, testCase.filter.Eval(tx))
+		})
+	}
+}
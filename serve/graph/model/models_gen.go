@@ -13,6 +13,11 @@ type Event interface {
 	IsEvent()
 }
 
+// `LookupResult` is a single candidate matched by the `lookup` query.
+type LookupResult interface {
+	IsLookupResult()
+}
+
 type MessageValue interface {
 	IsMessageValue()
 }
@@ -40,6 +45,8 @@ type BankMsgSend struct {
 	// the denomination and amount of fund sent ("<amount><denomination>").
 	// ex) `1000000ugnot`
 	Amount string `json:"amount"`
+	// the parsed coins of `amount`.
+	AmountCoins []*Coin `json:"amount_coins"`
 }
 
 func (BankMsgSend) IsMessageValue() {}
@@ -125,6 +132,45 @@ type EventInput struct {
 	StorageUnlockEvent *StorageUnlockEventInput `json:"storage_unlock_event,omitempty"`
 }
 
+// `FailureReason` is a single reason calls to a realm function failed for.
+type FailureReason struct {
+	// The name of the called function.
+	Func string `json:"func"`
+	// The amino package of the error type.
+	Codespace string `json:"codespace"`
+	// The amino type URL of the error.
+	Code string `json:"code"`
+	// The name of the error type.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+	// The number of calls that failed for this reason.
+	Count int `json:"count"`
+	// The height of the first Block a call failed for this reason in.
+	FirstHeight int `json:"first_height"`
+	// The height of the latest Block a call failed for this reason in.
+	LastHeight int `json:"last_height"`
+}
+
+// `FeeHistoryDenom` is the fee summary of a single gas fee denomination, within a time bucket.
+// Amounts are whole Transaction gas fees.
+type FeeHistoryDenom struct {
+	// The gas fee denomination.
+	Denom string `json:"denom"`
+	// The number of Transactions paying their fee in the denomination.
+	TxCount int `json:"tx_count"`
+	// The sum of the paid gas fees.
+	TotalAmount int `json:"total_amount"`
+	// The lowest paid gas fee.
+	Low int `json:"low"`
+	// The average paid gas fee.
+	Average int `json:"average"`
+	// The highest paid gas fee.
+	High int `json:"high"`
+	// The median fee per unit of gas wanted.
+	MedianPrice float64 `json:"median_price"`
+}
+
 // filter for BankMsgSend objects
 type FilterBankMsgSend struct {
 	// logical operator for BankMsgSend that will combine two or more conditions, returning true if all of them are true.
@@ -139,6 +185,8 @@ type FilterBankMsgSend struct {
 	ToAddress *FilterString `json:"to_address,omitempty"`
 	// filter for amount field.
 	Amount *FilterString `json:"amount,omitempty"`
+	// filter for amount_coins field.
+	AmountCoins *NestedFilterCoin `json:"amount_coins,omitempty"`
 }
 
 // filter for Block objects
@@ -151,6 +199,8 @@ type FilterBlock struct {
 	Not *FilterBlock `json:"_not,omitempty"`
 	// filter for hash field.
 	Hash *FilterString `json:"hash,omitempty"`
+	// filter for hash_hex field.
+	HashHex *FilterString `json:"hash_hex,omitempty"`
 	// filter for height field.
 	Height *FilterInt `json:"height,omitempty"`
 	// filter for version field.
@@ -181,6 +231,8 @@ type FilterBlock struct {
 	LastResultsHash *FilterString `json:"last_results_hash,omitempty"`
 	// filter for proposer_address_raw field.
 	ProposerAddressRaw *FilterString `json:"proposer_address_raw,omitempty"`
+	// filter for proposer_address field.
+	ProposerAddress *FilterString `json:"proposer_address,omitempty"`
 	// filter for txs field.
 	Txs *NestedFilterBlockTransaction `json:"txs,omitempty"`
 }
@@ -241,6 +293,20 @@ type FilterEvent struct {
 	UnknownEvent *NestedFilterUnknownEvent `json:"UnknownEvent,omitempty"`
 }
 
+// filter for GenericMessage objects
+type FilterGenericMessage struct {
+	// logical operator for GenericMessage that will combine two or more conditions, returning true if all of them are true.
+	And []*FilterGenericMessage `json:"_and,omitempty"`
+	// logical operator for GenericMessage that will combine two or more conditions, returning true if at least one of them is true.
+	Or []*FilterGenericMessage `json:"_or,omitempty"`
+	// logical operator for GenericMessage that will reverse conditions.
+	Not *FilterGenericMessage `json:"_not,omitempty"`
+	// filter for type_url field.
+	TypeURL *FilterString `json:"type_url,omitempty"`
+	// filter for fields field.
+	Fields *NestedFilterMessageField `json:"fields,omitempty"`
+}
+
 // filter for GnoEvent objects
 type FilterGnoEvent struct {
 	// logical operator for GnoEvent that will combine two or more conditions, returning true if all of them are true.
@@ -283,6 +349,22 @@ type FilterInt struct {
 	Lt *int `json:"lt,omitempty"`
 }
 
+// filter for LookupResult objects
+type FilterLookupResult struct {
+	// logical operator for LookupResult that will combine two or more conditions, returning true if all of them are true.
+	And []*FilterLookupResult `json:"_and,omitempty"`
+	// logical operator for LookupResult that will combine two or more conditions, returning true if at least one of them is true.
+	Or []*FilterLookupResult `json:"_or,omitempty"`
+	// logical operator for LookupResult that will reverse conditions.
+	Not *FilterLookupResult `json:"_not,omitempty"`
+	// filter for Block union type.
+	Block *NestedFilterBlock `json:"Block,omitempty"`
+	// filter for Transaction union type.
+	Transaction *NestedFilterTransaction `json:"Transaction,omitempty"`
+	// filter for Package union type.
+	Package *NestedFilterPackage `json:"Package,omitempty"`
+}
+
 // filter for MemFile objects
 type FilterMemFile struct {
 	// logical operator for MemFile that will combine two or more conditions, returning true if all of them are true.
@@ -313,6 +395,20 @@ type FilterMemPackage struct {
 	Files *NestedFilterMemFile `json:"files,omitempty"`
 }
 
+// filter for MessageField objects
+type FilterMessageField struct {
+	// logical operator for MessageField that will combine two or more conditions, returning true if all of them are true.
+	And []*FilterMessageField `json:"_and,omitempty"`
+	// logical operator for MessageField that will combine two or more conditions, returning true if at least one of them is true.
+	Or []*FilterMessageField `json:"_or,omitempty"`
+	// logical operator for MessageField that will reverse conditions.
+	Not *FilterMessageField `json:"_not,omitempty"`
+	// filter for path field.
+	Path *FilterString `json:"path,omitempty"`
+	// filter for value field.
+	Value *FilterString `json:"value,omitempty"`
+}
+
 // filter for MessageValue objects
 type FilterMessageValue struct {
 	// logical operator for MessageValue that will combine two or more conditions, returning true if all of them are true.
@@ -329,6 +425,8 @@ type FilterMessageValue struct {
 	MsgAddPackage *NestedFilterMsgAddPackage `json:"MsgAddPackage,omitempty"`
 	// filter for MsgRun union type.
 	MsgRun *NestedFilterMsgRun `json:"MsgRun,omitempty"`
+	// filter for GenericMessage union type.
+	GenericMessage *NestedFilterGenericMessage `json:"GenericMessage,omitempty"`
 }
 
 // filter for MsgAddPackage objects
@@ -347,6 +445,8 @@ type FilterMsgAddPackage struct {
 	Deposit *FilterString `json:"deposit,omitempty"`
 	// filter for send field.
 	Send *FilterString `json:"send,omitempty"`
+	// filter for send_coins field.
+	SendCoins *NestedFilterCoin `json:"send_coins,omitempty"`
 	// filter for max_deposit field.
 	MaxDeposit *FilterString `json:"max_deposit,omitempty"`
 }
@@ -363,6 +463,8 @@ type FilterMsgCall struct {
 	Caller *FilterString `json:"caller,omitempty"`
 	// filter for send field.
 	Send *FilterString `json:"send,omitempty"`
+	// filter for send_coins field.
+	SendCoins *NestedFilterCoin `json:"send_coins,omitempty"`
 	// filter for pkg_path field.
 	PkgPath *FilterString `json:"pkg_path,omitempty"`
 	// filter for func field.
@@ -385,12 +487,114 @@ type FilterMsgRun struct {
 	Caller *FilterString `json:"caller,omitempty"`
 	// filter for send field.
 	Send *FilterString `json:"send,omitempty"`
+	// filter for send_coins field.
+	SendCoins *NestedFilterCoin `json:"send_coins,omitempty"`
 	// filter for package field.
 	Package *NestedFilterMemPackage `json:"package,omitempty"`
 	// filter for max_deposit field.
 	MaxDeposit *FilterString `json:"max_deposit,omitempty"`
 }
 
+// filter for Multisig objects
+type FilterMultisig struct {
+	// logical operator for Multisig that will combine two or more conditions, returning true if all of them are true.
+	And []*FilterMultisig `json:"_and,omitempty"`
+	// logical operator for Multisig that will combine two or more conditions, returning true if at least one of them is true.
+	Or []*FilterMultisig `json:"_or,omitempty"`
+	// logical operator for Multisig that will reverse conditions.
+	Not *FilterMultisig `json:"_not,omitempty"`
+	// filter for threshold field.
+	Threshold *FilterInt `json:"threshold,omitempty"`
+	// filter for pub_keys field.
+	PubKeys *NestedFilterMultisigPubKey `json:"pub_keys,omitempty"`
+}
+
+// filter for MultisigPubKey objects
+type FilterMultisigPubKey struct {
+	// logical operator for MultisigPubKey that will combine two or more conditions, returning true if all of them are true.
+	And []*FilterMultisigPubKey `json:"_and,omitempty"`
+	// logical operator for MultisigPubKey that will combine two or more conditions, returning true if at least one of them is true.
+	Or []*FilterMultisigPubKey `json:"_or,omitempty"`
+	// logical operator for MultisigPubKey that will reverse conditions.
+	Not *FilterMultisigPubKey `json:"_not,omitempty"`
+	// filter for type field.
+	Type *FilterString `json:"type,omitempty"`
+	// filter for address field.
+	Address *FilterString `json:"address,omitempty"`
+	// filter for value field.
+	Value *FilterString `json:"value,omitempty"`
+}
+
+// filter for Package objects
+type FilterPackage struct {
+	// logical operator for Package that will combine two or more conditions, returning true if all of them are true.
+	And []*FilterPackage `json:"_and,omitempty"`
+	// logical operator for Package that will combine two or more conditions, returning true if at least one of them is true.
+	Or []*FilterPackage `json:"_or,omitempty"`
+	// logical operator for Package that will reverse conditions.
+	Not *FilterPackage `json:"_not,omitempty"`
+	// filter for path field.
+	Path *FilterString `json:"path,omitempty"`
+	// filter for name field.
+	Name *FilterString `json:"name,omitempty"`
+	// filter for creator field.
+	Creator *FilterString `json:"creator,omitempty"`
+	// filter for deploy_height field.
+	DeployHeight *FilterInt `json:"deploy_height,omitempty"`
+	// filter for deploy_index field.
+	DeployIndex *FilterInt `json:"deploy_index,omitempty"`
+	// filter for success field.
+	Success *FilterBoolean `json:"success,omitempty"`
+}
+
+// filter for PendingTransaction objects
+type FilterPendingTransaction struct {
+	// logical operator for PendingTransaction that will combine two or more conditions, returning true if all of them are true.
+	And []*FilterPendingTransaction `json:"_and,omitempty"`
+	// logical operator for PendingTransaction that will combine two or more conditions, returning true if at least one of them is true.
+	Or []*FilterPendingTransaction `json:"_or,omitempty"`
+	// logical operator for PendingTransaction that will reverse conditions.
+	Not *FilterPendingTransaction `json:"_not,omitempty"`
+	// filter for hash field.
+	Hash *FilterString `json:"hash,omitempty"`
+	// filter for hash_hex field.
+	HashHex *FilterString `json:"hash_hex,omitempty"`
+	// filter for status field.
+	Status *FilterString `json:"status,omitempty"`
+	// filter for first_seen field.
+	FirstSeen *FilterTime `json:"first_seen,omitempty"`
+	// filter for block_height field.
+	BlockHeight *FilterInt `json:"block_height,omitempty"`
+	// filter for gas_wanted field.
+	GasWanted *FilterInt `json:"gas_wanted,omitempty"`
+	// filter for gas_fee field.
+	GasFee *NestedFilterCoin `json:"gas_fee,omitempty"`
+	// filter for messages field.
+	Messages *NestedFilterTransactionMessage `json:"messages,omitempty"`
+	// filter for memo field.
+	Memo *FilterString `json:"memo,omitempty"`
+	// filter for signers field.
+	Signers *FilterString `json:"signers,omitempty"`
+}
+
+// filter for PubKey objects
+type FilterPubKey struct {
+	// logical operator for PubKey that will combine two or more conditions, returning true if all of them are true.
+	And []*FilterPubKey `json:"_and,omitempty"`
+	// logical operator for PubKey that will combine two or more conditions, returning true if at least one of them is true.
+	Or []*FilterPubKey `json:"_or,omitempty"`
+	// logical operator for PubKey that will reverse conditions.
+	Not *FilterPubKey `json:"_not,omitempty"`
+	// filter for type field.
+	Type *FilterString `json:"type,omitempty"`
+	// filter for address field.
+	Address *FilterString `json:"address,omitempty"`
+	// filter for value field.
+	Value *FilterString `json:"value,omitempty"`
+	// filter for multisig field.
+	Multisig *NestedFilterMultisig `json:"multisig,omitempty"`
+}
+
 // filter for StorageDepositEvent objects
 type FilterStorageDepositEvent struct {
 	// logical operator for StorageDepositEvent that will combine two or more conditions, returning true if all of them are true.
@@ -449,6 +653,28 @@ type FilterTime struct {
 	After *time.Time `json:"after,omitempty"`
 }
 
+// filter for TokenTransfer objects
+type FilterTokenTransfer struct {
+	// logical operator for TokenTransfer that will combine two or more conditions, returning true if all of them are true.
+	And []*FilterTokenTransfer `json:"_and,omitempty"`
+	// logical operator for TokenTransfer that will combine two or more conditions, returning true if at least one of them is true.
+	Or []*FilterTokenTransfer `json:"_or,omitempty"`
+	// logical operator for TokenTransfer that will reverse conditions.
+	Not *FilterTokenTransfer `json:"_not,omitempty"`
+	// filter for block_height field.
+	BlockHeight *FilterInt `json:"block_height,omitempty"`
+	// filter for index field.
+	Index *FilterInt `json:"index,omitempty"`
+	// filter for token field.
+	Token *FilterString `json:"token,omitempty"`
+	// filter for from field.
+	From *FilterString `json:"from,omitempty"`
+	// filter for to field.
+	To *FilterString `json:"to,omitempty"`
+	// filter for amount field.
+	Amount *FilterInt `json:"amount,omitempty"`
+}
+
 // filter for Transaction objects
 type FilterTransaction struct {
 	// logical operator for Transaction that will combine two or more conditions, returning true if all of them are true.
@@ -461,6 +687,8 @@ type FilterTransaction struct {
 	Index *FilterInt `json:"index,omitempty"`
 	// filter for hash field.
 	Hash *FilterString `json:"hash,omitempty"`
+	// filter for hash_hex field.
+	HashHex *FilterString `json:"hash_hex,omitempty"`
 	// filter for success field.
 	Success *FilterBoolean `json:"success,omitempty"`
 	// filter for block_height field.
@@ -475,6 +703,12 @@ type FilterTransaction struct {
 	Messages *NestedFilterTransactionMessage `json:"messages,omitempty"`
 	// filter for memo field.
 	Memo *FilterString `json:"memo,omitempty"`
+	// filter for signers field.
+	Signers *FilterString `json:"signers,omitempty"`
+	// filter for fee_payer field.
+	FeePayer *FilterString `json:"fee_payer,omitempty"`
+	// filter for signatures field.
+	Signatures *NestedFilterTxSignature `json:"signatures,omitempty"`
 	// filter for response field.
 	Response *NestedFilterTransactionResponse `json:"response,omitempty"`
 }
@@ -509,12 +743,58 @@ type FilterTransactionResponse struct {
 	Info *FilterString `json:"info,omitempty"`
 	// filter for error field.
 	Error *FilterString `json:"error,omitempty"`
+	// filter for tx_error field.
+	TxError *NestedFilterTxError `json:"tx_error,omitempty"`
 	// filter for data field.
 	Data *FilterString `json:"data,omitempty"`
 	// filter for events field.
 	Events *NestedFilterEvent `json:"events,omitempty"`
 }
 
+// filter for Transfer objects
+type FilterTransfer struct {
+	// logical operator for Transfer that will combine two or more conditions, returning true if all of them are true.
+	And []*FilterTransfer `json:"_and,omitempty"`
+	// logical operator for Transfer that will combine two or more conditions, returning true if at least one of them is true.
+	Or []*FilterTransfer `json:"_or,omitempty"`
+	// logical operator for Transfer that will reverse conditions.
+	Not *FilterTransfer `json:"_not,omitempty"`
+	// filter for block_height field.
+	BlockHeight *FilterInt `json:"block_height,omitempty"`
+	// filter for index field.
+	Index *FilterInt `json:"index,omitempty"`
+	// filter for kind field.
+	Kind *FilterString `json:"kind,omitempty"`
+	// filter for from field.
+	From *FilterString `json:"from,omitempty"`
+	// filter for to field.
+	To *FilterString `json:"to,omitempty"`
+	// filter for denom field.
+	Denom *FilterString `json:"denom,omitempty"`
+	// filter for amount field.
+	Amount *FilterInt `json:"amount,omitempty"`
+}
+
+// filter for TxError objects
+type FilterTxError struct {
+	// logical operator for TxError that will combine two or more conditions, returning true if all of them are true.
+	And []*FilterTxError `json:"_and,omitempty"`
+	// logical operator for TxError that will combine two or more conditions, returning true if at least one of them is true.
+	Or []*FilterTxError `json:"_or,omitempty"`
+	// logical operator for TxError that will reverse conditions.
+	Not *FilterTxError `json:"_not,omitempty"`
+	// filter for codespace field.
+	Codespace *FilterString `json:"codespace,omitempty"`
+	// filter for code field.
+	Code *FilterString `json:"code,omitempty"`
+	// filter for name field.
+	Name *FilterString `json:"name,omitempty"`
+	// filter for message field.
+	Message *FilterString `json:"message,omitempty"`
+	// filter for gno_stacktrace field.
+	GnoStacktrace *FilterString `json:"gno_stacktrace,omitempty"`
+}
+
 // filter for TxFee objects
 type FilterTxFee struct {
 	// logical operator for TxFee that will combine two or more conditions, returning true if all of them are true.
@@ -529,6 +809,20 @@ type FilterTxFee struct {
 	GasFee *NestedFilterCoin `json:"gas_fee,omitempty"`
 }
 
+// filter for TxSignature objects
+type FilterTxSignature struct {
+	// logical operator for TxSignature that will combine two or more conditions, returning true if all of them are true.
+	And []*FilterTxSignature `json:"_and,omitempty"`
+	// logical operator for TxSignature that will combine two or more conditions, returning true if at least one of them is true.
+	Or []*FilterTxSignature `json:"_or,omitempty"`
+	// logical operator for TxSignature that will reverse conditions.
+	Not *FilterTxSignature `json:"_not,omitempty"`
+	// filter for pub_key field.
+	PubKey *NestedFilterPubKey `json:"pub_key,omitempty"`
+	// filter for signature field.
+	Signature *FilterString `json:"signature,omitempty"`
+}
+
 // filter for UnknownEvent objects
 type FilterUnknownEvent struct {
 	// logical operator for UnknownEvent that will combine two or more conditions, returning true if all of them are true.
@@ -541,6 +835,16 @@ type FilterUnknownEvent struct {
 	Value *FilterString `json:"value,omitempty"`
 }
 
+// `GasBucket` is a single gas used histogram bucket.
+type GasBucket struct {
+	// The lowest gas amount of the bucket, inclusive.
+	From int `json:"from"`
+	// The highest gas amount of the bucket, inclusive.
+	To int `json:"to"`
+	// The number of calls within the bucket.
+	Count int `json:"count"`
+}
+
 // `GnoEvent` is the event information exported by the Gno VM.
 // It has `type`, `pkg_path`, `func`, and `attrs`.
 type GnoEvent struct {
@@ -626,6 +930,8 @@ type MsgAddPackage struct {
 	// the amount of funds to be deposited at deployment, if any ("<amount><denomination>").
 	// ex) `1000000ugnot`
 	Send string `json:"send"`
+	// the parsed coins of `send`.
+	SendCoins []*Coin `json:"send_coins"`
 	// the maximum amount of funds to be deposited at deployment used for storage, if any ("<amount><denomination>").
 	// ex) `1000000ugnot`
 	MaxDeposit string `json:"max_deposit"`
@@ -655,6 +961,8 @@ type MsgCall struct {
 	// the amount of funds to be deposited to the package, if any ("<amount><denomination>").
 	// ex) `1000000ugnot`
 	Send string `json:"send"`
+	// the parsed coins of `send`.
+	SendCoins []*Coin `json:"send_coins"`
 	// the gno package path.
 	PkgPath string `json:"pkg_path"`
 	// the function name being invoked.
@@ -697,6 +1005,8 @@ type MsgRun struct {
 	// the amount of funds to be deposited to the package, if any ("<amount><denomination>").
 	// ex) `1000000ugnot`
 	Send string `json:"send"`
+	// the parsed coins of `send`.
+	SendCoins []*Coin `json:"send_coins"`
 	// the package being executed.
 	Package *MemPackage `json:"package"`
 	// the maximum amount of funds to be deposited used for storage, if any ("<amount><denomination>").
@@ -719,6 +1029,24 @@ type MsgRunInput struct {
 	Package *MemPackageInput `json:"package,omitempty"`
 }
 
+// `Multisig` describes a K of N multisig public key.
+type Multisig struct {
+	// The minimum number of signatures required.
+	Threshold int `json:"threshold"`
+	// The public keys of the multisig members.
+	PubKeys []*MultisigPubKey `json:"pub_keys"`
+}
+
+// `MultisigPubKey` is the public key of a multisig member.
+type MultisigPubKey struct {
+	// The amino type URL of the key.
+	Type string `json:"type"`
+	// The bech32 address derived from the key.
+	Address string `json:"address"`
+	// The key in bech32 encoding.
+	Value string `json:"value"`
+}
+
 // filter for BankMsgSend objects
 type NestedFilterBankMsgSend struct {
 	// logical operator for BankMsgSend that will combine two or more conditions, returning true if all of them are true.
@@ -733,6 +1061,56 @@ type NestedFilterBankMsgSend struct {
 	ToAddress *FilterString `json:"to_address,omitempty"`
 	// filter for amount field.
 	Amount *FilterString `json:"amount,omitempty"`
+	// filter for amount_coins field.
+	AmountCoins *NestedFilterCoin `json:"amount_coins,omitempty"`
+}
+
+// filter for Block objects
+type NestedFilterBlock struct {
+	// logical operator for Block that will combine two or more conditions, returning true if all of them are true.
+	And []*NestedFilterBlock `json:"_and,omitempty"`
+	// logical operator for Block that will combine two or more conditions, returning true if at least one of them is true.
+	Or []*NestedFilterBlock `json:"_or,omitempty"`
+	// logical operator for Block that will reverse conditions.
+	Not *NestedFilterBlock `json:"_not,omitempty"`
+	// filter for hash field.
+	Hash *FilterString `json:"hash,omitempty"`
+	// filter for hash_hex field.
+	HashHex *FilterString `json:"hash_hex,omitempty"`
+	// filter for height field.
+	Height *FilterInt `json:"height,omitempty"`
+	// filter for version field.
+	Version *FilterString `json:"version,omitempty"`
+	// filter for chain_id field.
+	ChainID *FilterString `json:"chain_id,omitempty"`
+	// filter for time field.
+	Time *FilterTime `json:"time,omitempty"`
+	// filter for num_txs field.
+	NumTxs *FilterInt `json:"num_txs,omitempty"`
+	// filter for total_txs field.
+	TotalTxs *FilterInt `json:"total_txs,omitempty"`
+	// filter for app_version field.
+	AppVersion *FilterString `json:"app_version,omitempty"`
+	// filter for last_block_hash field.
+	LastBlockHash *FilterString `json:"last_block_hash,omitempty"`
+	// filter for last_commit_hash field.
+	LastCommitHash *FilterString `json:"last_commit_hash,omitempty"`
+	// filter for validators_hash field.
+	ValidatorsHash *FilterString `json:"validators_hash,omitempty"`
+	// filter for next_validators_hash field.
+	NextValidatorsHash *FilterString `json:"next_validators_hash,omitempty"`
+	// filter for consensus_hash field.
+	ConsensusHash *FilterString `json:"consensus_hash,omitempty"`
+	// filter for app_hash field.
+	AppHash *FilterString `json:"app_hash,omitempty"`
+	// filter for last_results_hash field.
+	LastResultsHash *FilterString `json:"last_results_hash,omitempty"`
+	// filter for proposer_address_raw field.
+	ProposerAddressRaw *FilterString `json:"proposer_address_raw,omitempty"`
+	// filter for proposer_address field.
+	ProposerAddress *FilterString `json:"proposer_address,omitempty"`
+	// filter for txs field.
+	Txs *NestedFilterBlockTransaction `json:"txs,omitempty"`
 }
 
 // filter for BlockTransaction objects
@@ -783,6 +1161,20 @@ type NestedFilterEvent struct {
 	UnknownEvent *NestedFilterUnknownEvent `json:"UnknownEvent,omitempty"`
 }
 
+// filter for GenericMessage objects
+type NestedFilterGenericMessage struct {
+	// logical operator for GenericMessage that will combine two or more conditions, returning true if all of them are true.
+	And []*NestedFilterGenericMessage `json:"_and,omitempty"`
+	// logical operator for GenericMessage that will combine two or more conditions, returning true if at least one of them is true.
+	Or []*NestedFilterGenericMessage `json:"_or,omitempty"`
+	// logical operator for GenericMessage that will reverse conditions.
+	Not *NestedFilterGenericMessage `json:"_not,omitempty"`
+	// filter for type_url field.
+	TypeURL *FilterString `json:"type_url,omitempty"`
+	// filter for fields field.
+	Fields *NestedFilterMessageField `json:"fields,omitempty"`
+}
+
 // filter for GnoEvent objects
 type NestedFilterGnoEvent struct {
 	// logical operator for GnoEvent that will combine two or more conditions, returning true if all of them are true.
@@ -843,6 +1235,20 @@ type NestedFilterMemPackage struct {
 	Files *NestedFilterMemFile `json:"files,omitempty"`
 }
 
+// filter for MessageField objects
+type NestedFilterMessageField struct {
+	// logical operator for MessageField that will combine two or more conditions, returning true if all of them are true.
+	And []*NestedFilterMessageField `json:"_and,omitempty"`
+	// logical operator for MessageField that will combine two or more conditions, returning true if at least one of them is true.
+	Or []*NestedFilterMessageField `json:"_or,omitempty"`
+	// logical operator for MessageField that will reverse conditions.
+	Not *NestedFilterMessageField `json:"_not,omitempty"`
+	// filter for path field.
+	Path *FilterString `json:"path,omitempty"`
+	// filter for value field.
+	Value *FilterString `json:"value,omitempty"`
+}
+
 // filter for MessageValue objects
 type NestedFilterMessageValue struct {
 	// logical operator for MessageValue that will combine two or more conditions, returning true if all of them are true.
@@ -859,6 +1265,8 @@ type NestedFilterMessageValue struct {
 	MsgAddPackage *NestedFilterMsgAddPackage `json:"MsgAddPackage,omitempty"`
 	// filter for MsgRun union type.
 	MsgRun *NestedFilterMsgRun `json:"MsgRun,omitempty"`
+	// filter for GenericMessage union type.
+	GenericMessage *NestedFilterGenericMessage `json:"GenericMessage,omitempty"`
 }
 
 // filter for MsgAddPackage objects
@@ -877,6 +1285,8 @@ type NestedFilterMsgAddPackage struct {
 	Deposit *FilterString `json:"deposit,omitempty"`
 	// filter for send field.
 	Send *FilterString `json:"send,omitempty"`
+	// filter for send_coins field.
+	SendCoins *NestedFilterCoin `json:"send_coins,omitempty"`
 	// filter for max_deposit field.
 	MaxDeposit *FilterString `json:"max_deposit,omitempty"`
 }
@@ -893,6 +1303,8 @@ type NestedFilterMsgCall struct {
 	Caller *FilterString `json:"caller,omitempty"`
 	// filter for send field.
 	Send *FilterString `json:"send,omitempty"`
+	// filter for send_coins field.
+	SendCoins *NestedFilterCoin `json:"send_coins,omitempty"`
 	// filter for pkg_path field.
 	PkgPath *FilterString `json:"pkg_path,omitempty"`
 	// filter for func field.
@@ -915,12 +1327,84 @@ type NestedFilterMsgRun struct {
 	Caller *FilterString `json:"caller,omitempty"`
 	// filter for send field.
 	Send *FilterString `json:"send,omitempty"`
+	// filter for send_coins field.
+	SendCoins *NestedFilterCoin `json:"send_coins,omitempty"`
 	// filter for package field.
 	Package *NestedFilterMemPackage `json:"package,omitempty"`
 	// filter for max_deposit field.
 	MaxDeposit *FilterString `json:"max_deposit,omitempty"`
 }
 
+// filter for Multisig objects
+type NestedFilterMultisig struct {
+	// logical operator for Multisig that will combine two or more conditions, returning true if all of them are true.
+	And []*NestedFilterMultisig `json:"_and,omitempty"`
+	// logical operator for Multisig that will combine two or more conditions, returning true if at least one of them is true.
+	Or []*NestedFilterMultisig `json:"_or,omitempty"`
+	// logical operator for Multisig that will reverse conditions.
+	Not *NestedFilterMultisig `json:"_not,omitempty"`
+	// filter for threshold field.
+	Threshold *FilterInt `json:"threshold,omitempty"`
+	// filter for pub_keys field.
+	PubKeys *NestedFilterMultisigPubKey `json:"pub_keys,omitempty"`
+}
+
+// filter for MultisigPubKey objects
+type NestedFilterMultisigPubKey struct {
+	// logical operator for MultisigPubKey that will combine two or more conditions, returning true if all of them are true.
+	And []*NestedFilterMultisigPubKey `json:"_and,omitempty"`
+	// logical operator for MultisigPubKey that will combine two or more conditions, returning true if at least one of them is true.
+	Or []*NestedFilterMultisigPubKey `json:"_or,omitempty"`
+	// logical operator for MultisigPubKey that will reverse conditions.
+	Not *NestedFilterMultisigPubKey `json:"_not,omitempty"`
+	// filter for type field.
+	Type *FilterString `json:"type,omitempty"`
+	// filter for address field.
+	Address *FilterString `json:"address,omitempty"`
+	// filter for value field.
+	Value *FilterString `json:"value,omitempty"`
+}
+
+// filter for Package objects
+type NestedFilterPackage struct {
+	// logical operator for Package that will combine two or more conditions, returning true if all of them are true.
+	And []*NestedFilterPackage `json:"_and,omitempty"`
+	// logical operator for Package that will combine two or more conditions, returning true if at least one of them is true.
+	Or []*NestedFilterPackage `json:"_or,omitempty"`
+	// logical operator for Package that will reverse conditions.
+	Not *NestedFilterPackage `json:"_not,omitempty"`
+	// filter for path field.
+	Path *FilterString `json:"path,omitempty"`
+	// filter for name field.
+	Name *FilterString `json:"name,omitempty"`
+	// filter for creator field.
+	Creator *FilterString `json:"creator,omitempty"`
+	// filter for deploy_height field.
+	DeployHeight *FilterInt `json:"deploy_height,omitempty"`
+	// filter for deploy_index field.
+	DeployIndex *FilterInt `json:"deploy_index,omitempty"`
+	// filter for success field.
+	Success *FilterBoolean `json:"success,omitempty"`
+}
+
+// filter for PubKey objects
+type NestedFilterPubKey struct {
+	// logical operator for PubKey that will combine two or more conditions, returning true if all of them are true.
+	And []*NestedFilterPubKey `json:"_and,omitempty"`
+	// logical operator for PubKey that will combine two or more conditions, returning true if at least one of them is true.
+	Or []*NestedFilterPubKey `json:"_or,omitempty"`
+	// logical operator for PubKey that will reverse conditions.
+	Not *NestedFilterPubKey `json:"_not,omitempty"`
+	// filter for type field.
+	Type *FilterString `json:"type,omitempty"`
+	// filter for address field.
+	Address *FilterString `json:"address,omitempty"`
+	// filter for value field.
+	Value *FilterString `json:"value,omitempty"`
+	// filter for multisig field.
+	Multisig *NestedFilterMultisig `json:"multisig,omitempty"`
+}
+
 // filter for StorageDepositEvent objects
 type NestedFilterStorageDepositEvent struct {
 	// logical operator for StorageDepositEvent that will combine two or more conditions, returning true if all of them are true.
@@ -957,6 +1441,44 @@ type NestedFilterStorageUnlockEvent struct {
 	PkgPath *FilterString `json:"pkg_path,omitempty"`
 }
 
+// filter for Transaction objects
+type NestedFilterTransaction struct {
+	// logical operator for Transaction that will combine two or more conditions, returning true if all of them are true.
+	And []*NestedFilterTransaction `json:"_and,omitempty"`
+	// logical operator for Transaction that will combine two or more conditions, returning true if at least one of them is true.
+	Or []*NestedFilterTransaction `json:"_or,omitempty"`
+	// logical operator for Transaction that will reverse conditions.
+	Not *NestedFilterTransaction `json:"_not,omitempty"`
+	// filter for index field.
+	Index *FilterInt `json:"index,omitempty"`
+	// filter for hash field.
+	Hash *FilterString `json:"hash,omitempty"`
+	// filter for hash_hex field.
+	HashHex *FilterString `json:"hash_hex,omitempty"`
+	// filter for success field.
+	Success *FilterBoolean `json:"success,omitempty"`
+	// filter for block_height field.
+	BlockHeight *FilterInt `json:"block_height,omitempty"`
+	// filter for gas_wanted field.
+	GasWanted *FilterInt `json:"gas_wanted,omitempty"`
+	// filter for gas_used field.
+	GasUsed *FilterInt `json:"gas_used,omitempty"`
+	// filter for gas_fee field.
+	GasFee *NestedFilterCoin `json:"gas_fee,omitempty"`
+	// filter for messages field.
+	Messages *NestedFilterTransactionMessage `json:"messages,omitempty"`
+	// filter for memo field.
+	Memo *FilterString `json:"memo,omitempty"`
+	// filter for signers field.
+	Signers *FilterString `json:"signers,omitempty"`
+	// filter for fee_payer field.
+	FeePayer *FilterString `json:"fee_payer,omitempty"`
+	// filter for signatures field.
+	Signatures *NestedFilterTxSignature `json:"signatures,omitempty"`
+	// filter for response field.
+	Response *NestedFilterTransactionResponse `json:"response,omitempty"`
+}
+
 // filter for TransactionMessage objects
 type NestedFilterTransactionMessage struct {
 	// logical operator for TransactionMessage that will combine two or more conditions, returning true if all of them are true.
@@ -987,12 +1509,34 @@ type NestedFilterTransactionResponse struct {
 	Info *FilterString `json:"info,omitempty"`
 	// filter for error field.
 	Error *FilterString `json:"error,omitempty"`
+	// filter for tx_error field.
+	TxError *NestedFilterTxError `json:"tx_error,omitempty"`
 	// filter for data field.
 	Data *FilterString `json:"data,omitempty"`
 	// filter for events field.
 	Events *NestedFilterEvent `json:"events,omitempty"`
 }
 
+// filter for TxError objects
+type NestedFilterTxError struct {
+	// logical operator for TxError that will combine two or more conditions, returning true if all of them are true.
+	And []*NestedFilterTxError `json:"_and,omitempty"`
+	// logical operator for TxError that will combine two or more conditions, returning true if at least one of them is true.
+	Or []*NestedFilterTxError `json:"_or,omitempty"`
+	// logical operator for TxError that will reverse conditions.
+	Not *NestedFilterTxError `json:"_not,omitempty"`
+	// filter for codespace field.
+	Codespace *FilterString `json:"codespace,omitempty"`
+	// filter for code field.
+	Code *FilterString `json:"code,omitempty"`
+	// filter for name field.
+	Name *FilterString `json:"name,omitempty"`
+	// filter for message field.
+	Message *FilterString `json:"message,omitempty"`
+	// filter for gno_stacktrace field.
+	GnoStacktrace *FilterString `json:"gno_stacktrace,omitempty"`
+}
+
 // filter for TxFee objects
 type NestedFilterTxFee struct {
 	// logical operator for TxFee that will combine two or more conditions, returning true if all of them are true.
@@ -1007,6 +1551,20 @@ type NestedFilterTxFee struct {
 	GasFee *NestedFilterCoin `json:"gas_fee,omitempty"`
 }
 
+// filter for TxSignature objects
+type NestedFilterTxSignature struct {
+	// logical operator for TxSignature that will combine two or more conditions, returning true if all of them are true.
+	And []*NestedFilterTxSignature `json:"_and,omitempty"`
+	// logical operator for TxSignature that will combine two or more conditions, returning true if at least one of them is true.
+	Or []*NestedFilterTxSignature `json:"_or,omitempty"`
+	// logical operator for TxSignature that will reverse conditions.
+	Not *NestedFilterTxSignature `json:"_not,omitempty"`
+	// filter for pub_key field.
+	PubKey *NestedFilterPubKey `json:"pub_key,omitempty"`
+	// filter for signature field.
+	Signature *FilterString `json:"signature,omitempty"`
+}
+
 // filter for UnknownEvent objects
 type NestedFilterUnknownEvent struct {
 	// logical operator for UnknownEvent that will combine two or more conditions, returning true if all of them are true.
@@ -1019,6 +1577,26 @@ type NestedFilterUnknownEvent struct {
 	Value *FilterString `json:"value,omitempty"`
 }
 
+// `PageInfo` holds the pagination state of a connection.
+type PageInfo struct {
+	// The cursor of the last element in the current page, if any.
+	EndCursor *string `json:"endCursor,omitempty"`
+	// Flag indicating if there are more elements after the current page.
+	HasNextPage bool `json:"hasNextPage"`
+}
+
+// `PubKey` is the public key of a transaction signer.
+type PubKey struct {
+	// The amino type URL of the key (ex. `/tm.PubKeySecp256k1`, `/tm.PubKeyMultisig`).
+	Type string `json:"type"`
+	// The bech32 address derived from the key.
+	Address string `json:"address"`
+	// The key in bech32 encoding.
+	Value string `json:"value"`
+	// The threshold details, if the key is a multisig key.
+	Multisig *Multisig `json:"multisig,omitempty"`
+}
+
 // Root Query type to fetch data about Blocks and Transactions based on filters or retrieve the latest block height.
 type Query struct {
 }
@@ -1090,6 +1668,22 @@ type TransactionBankMessageInput struct {
 	Send *BankMsgSendInput `json:"send,omitempty"`
 }
 
+// `TransactionConnection` is a paginated list of Transactions.
+type TransactionConnection struct {
+	// The Transactions of the current page, each with its cursor.
+	Edges []*TransactionEdge `json:"edges"`
+	// Information used to fetch the next page.
+	PageInfo *PageInfo `json:"pageInfo"`
+}
+
+// `TransactionEdge` is a single Transaction within a `TransactionConnection`.
+type TransactionEdge struct {
+	// The opaque cursor of the Transaction, to be used as the `after` argument.
+	Cursor string `json:"cursor"`
+	// The Transaction itself.
+	Node *Transaction `json:"node"`
+}
+
 // Filters for querying Transactions within specified criteria related to their execution and placement within Blocks.
 type TransactionFilter struct {
 	// Minimum block height from which to start fetching Transactions, inclusive. Aids in scoping the search to recent Transactions.
@@ -1158,6 +1752,21 @@ type TransactionVMMessageInput struct {
 	Run *MsgRunInput `json:"run,omitempty"`
 }
 
+// `TxError` is the structured error of a failed Transaction.
+type TxError struct {
+	// The amino package of the error type (ex. `std`, `vm`, or `abci` for plain error messages).
+	Codespace string `json:"codespace"`
+	// The amino type URL of the error (ex. `/std.OutOfGasError`).
+	// Errors are identified by their type, as there are no numeric error codes.
+	Code string `json:"code"`
+	// The name of the error type (ex. `OutOfGasError`).
+	Name string `json:"name"`
+	// The most specific error message available, such as the panic message of a failed realm call.
+	Message string `json:"message"`
+	// The Gno VM stacktrace, if the Transaction panicked in the VM.
+	GnoStacktrace *string `json:"gno_stacktrace,omitempty"`
+}
+
 // The `TxFee` has information about the fee used in the transaction and the maximum gas fee specified by the user.
 type TxFee struct {
 	// gas limit
@@ -1166,7 +1775,19 @@ type TxFee struct {
 	GasFee *Coin `json:"gas_fee"`
 }
 
+// `TxSignature` is a single signature of a transaction.
+type TxSignature struct {
+	// The public key of the signer.
+	// It can be omitted once the chain knows the account public key, so it can be empty.
+	PubKey *PubKey `json:"pub_key,omitempty"`
+	// The signature in base64 encoding.
+	Signature string `json:"signature"`
+}
+
 // `UnexpectedMessage` is an Undefined Message, which is a message that decoding failed.
+// It is no longer produced for messages without a dedicated decoder, which are returned as a `GenericMessage` instead,
+// but only for messages that can't be encoded as amino JSON. Clients matching on `UnexpectedMessage`
+// to handle unknown messages need to match on `GenericMessage`.
 type UnexpectedMessage struct {
 	Raw string `json:"raw"`
 }
@@ -1182,6 +1803,26 @@ type UnknownEvent struct {
 
 func (UnknownEvent) IsEvent() {}
 
+// `Validator` is a single member of a validator set.
+type Validator struct {
+	// The bech32 address of the validator.
+	Address string `json:"address"`
+	// The bech32 public key of the validator.
+	PubKey string `json:"pub_key"`
+	// The voting power of the validator within the set.
+	VotingPower int `json:"voting_power"`
+}
+
+// `ValidatorUpdate` is a single validator set change, returned in the EndBlock execution of a Block.
+type ValidatorUpdate struct {
+	// The bech32 address of the validator.
+	Address string `json:"address"`
+	// The bech32 public key of the validator.
+	PubKey string `json:"pub_key"`
+	// The new voting power of the validator, 0 if it is removed from the set.
+	Power int `json:"power"`
+}
+
 type FilterableExtra string
 
 const (
@@ -1223,6 +1864,54 @@ func (e FilterableExtra) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// `InclusionSpeed` is the target inclusion speed of a Transaction,
+// used to pick the recommended gas price.
+type InclusionSpeed string
+
+const (
+	// The fee per gas paid by the cheapest 10% of the recent Transactions (p10).
+	InclusionSpeedSlow InclusionSpeed = "SLOW"
+	// The median fee per gas paid by the recent Transactions (p50).
+	InclusionSpeedStandard InclusionSpeed = "STANDARD"
+	// The fee per gas paid by the most expensive 10% of the recent Transactions (p90).
+	InclusionSpeedFast InclusionSpeed = "FAST"
+)
+
+var AllInclusionSpeed = []InclusionSpeed{
+	InclusionSpeedSlow,
+	InclusionSpeedStandard,
+	InclusionSpeedFast,
+}
+
+func (e InclusionSpeed) IsValid() bool {
+	switch e {
+	case InclusionSpeedSlow, InclusionSpeedStandard, InclusionSpeedFast:
+		return true
+	}
+	return false
+}
+
+func (e InclusionSpeed) String() string {
+	return string(e)
+}
+
+func (e *InclusionSpeed) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = InclusionSpeed(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid InclusionSpeed", str)
+	}
+	return nil
+}
+
+func (e InclusionSpeed) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // `MessageRoute` is route type of the transactional message.
 // `MessageRoute` has the values of vm and bank.
 type MessageRoute string
@@ -1362,3 +2051,50 @@ func (e *Order) UnmarshalGQL(v interface{}) error {
 func (e Order) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+// `SearchKind` is the kind of content matched by a full-text search.
+type SearchKind string
+
+const (
+	// A source file of a successfully deployed package.
+	SearchKindPackageFile SearchKind = "PACKAGE_FILE"
+	// A Transaction memo.
+	SearchKindMemo SearchKind = "MEMO"
+	// A `GnoEvent` attribute value.
+	SearchKindEvent SearchKind = "EVENT"
+)
+
+var AllSearchKind = []SearchKind{
+	SearchKindPackageFile,
+	SearchKindMemo,
+	SearchKindEvent,
+}
+
+func (e SearchKind) IsValid() bool {
+	switch e {
+	case SearchKindPackageFile, SearchKindMemo, SearchKindEvent:
+		return true
+	}
+	return false
+}
+
+func (e SearchKind) String() string {
+	return string(e)
+}
+
+func (e *SearchKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SearchKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SearchKind", str)
+	}
+	return nil
+}
+
+func (e SearchKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -0,0 +1,119 @@
+package model
+
+import (
+	"github.com/gnolang/tx-indexer/types"
+)
+
+type NFTCollection struct {
+	collection *types.NFTCollection
+}
+
+func NewNFTCollection(collection *types.NFTCollection) *NFTCollection {
+	return &NFTCollection{
+		collection: collection,
+	}
+}
+
+func (c *NFTCollection) PkgPath() string {
+	return c.collection.PkgPath
+}
+
+func (c *NFTCollection) Name() string {
+	return c.collection.Name
+}
+
+func (c *NFTCollection) Symbol() string {
+	return c.collection.Symbol
+}
+
+func (c *NFTCollection) Minted() int {
+	return int(c.collection.Minted)
+}
+
+func (c *NFTCollection) Burned() int {
+	return int(c.collection.Burned)
+}
+
+func (c *NFTCollection) Transfers() int {
+	return int(c.collection.Transfers)
+}
+
+func (c *NFTCollection) FirstSeenHeight() int {
+	return int(c.collection.FirstSeenHeight)
+}
+
+type NFT struct {
+	nft *types.NFT
+}
+
+func NewNFT(nft *types.NFT) *NFT {
+	return &NFT{
+		nft: nft,
+	}
+}
+
+func (n *NFT) Collection() string {
+	return n.nft.Collection
+}
+
+func (n *NFT) TokenID() string {
+	return n.nft.TokenID
+}
+
+func (n *NFT) Owner() string {
+	return n.nft.Owner
+}
+
+func (n *NFT) Burned() bool {
+	return n.nft.Burned
+}
+
+func (n *NFT) MintHeight() int {
+	return int(n.nft.MintHeight)
+}
+
+func (n *NFT) BlockHeight() int {
+	return int(n.nft.Height)
+}
+
+type NFTEvent struct {
+	event *types.NFTEvent
+}
+
+func NewNFTEvent(event *types.NFTEvent) *NFTEvent {
+	return &NFTEvent{
+		event: event,
+	}
+}
+
+func (e *NFTEvent) Collection() string {
+	return e.event.Collection
+}
+
+func (e *NFTEvent) TokenID() string {
+	return e.event.TokenID
+}
+
+func (e *NFTEvent) Kind() string {
+	return string(e.event.Kind)
+}
+
+func (e *NFTEvent) From() string {
+	return e.event.From
+}
+
+func (e *NFTEvent) To() string {
+	return e.event.To
+}
+
+func (e *NFTEvent) BlockHeight() int {
+	return int(e.event.Height)
+}
+
+func (e *NFTEvent) Index() int {
+	return int(e.event.Index)
+}
+
+func (e *NFTEvent) EventIndex() int {
+	return int(e.event.EventIndex)
+}
@@ -0,0 +1,87 @@
+package model
+
+import (
+	"github.com/gnolang/tx-indexer/types"
+)
+
+type PackageCall struct {
+	Caller      string
+	Func        string
+	BlockHeight int
+	Index       int
+	Success     bool
+}
+
+type Package struct {
+	pkg *types.Package
+}
+
+func NewPackage(pkg *types.Package) *Package {
+	return &Package{
+		pkg: pkg,
+	}
+}
+
+func (p *Package) Path() string {
+	return p.pkg.Path
+}
+
+func (p *Package) Name() string {
+	return p.pkg.Name
+}
+
+func (p *Package) Creator() string {
+	return p.pkg.Creator
+}
+
+func (p *Package) DeployHeight() int {
+	return int(p.pkg.DeployHeight)
+}
+
+func (p *Package) DeployIndex() int {
+	return int(p.pkg.DeployIndex)
+}
+
+func (p *Package) Success() bool {
+	return p.pkg.Success
+}
+
+func (p *Package) FileNames() []string {
+	return nonNil(p.pkg.Files)
+}
+
+func (p *Package) Imports() []string {
+	return nonNil(p.pkg.Imports)
+}
+
+func (p *Package) RecentCallers() []*PackageCall {
+	out := make([]*PackageCall, 0, len(p.pkg.RecentCallers))
+
+	for _, call := range p.pkg.RecentCallers {
+		out = append(out, &PackageCall{
+			Caller:      call.Caller,
+			Func:        call.Func,
+			BlockHeight: int(call.Height),
+			Index:       int(call.Index),
+			Success:     call.Success,
+		})
+	}
+
+	return out
+}
+
+// LoadFiles extracts the package source files from the deployment transaction
+func (p *Package) LoadFiles(tx *Transaction) []*MemFile {
+	for _, message := range tx.Messages() {
+		addPackage, ok := message.Value.(MsgAddPackage)
+		if !ok || addPackage.Package == nil || addPackage.Package.Path != p.pkg.Path {
+			continue
+		}
+
+		return nonNil(addPackage.Package.Files)
+	}
+
+	return make([]*MemFile, 0)
+}
+
+func (Package) IsLookupResult() {}
@@ -0,0 +1,85 @@
+package model
+
+import (
+	"github.com/gnolang/tx-indexer/search"
+	"github.com/gnolang/tx-indexer/types"
+)
+
+type SearchResult struct {
+	result *search.Result
+}
+
+func NewSearchResult(result *search.Result) *SearchResult {
+	return &SearchResult{
+		result: result,
+	}
+}
+
+func (s *SearchResult) Score() float64 {
+	return s.result.Score
+}
+
+func (s *SearchResult) BlockHeight() int {
+	return int(s.result.Document.Height)
+}
+
+func (s *SearchResult) Index() int {
+	return int(s.result.Document.Index)
+}
+
+func (s *SearchResult) PackagePath() *string {
+	if s.result.Document.Kind == types.SearchKindMemo {
+		return nil
+	}
+
+	return &s.result.Document.PackagePath
+}
+
+func (s *SearchResult) FileName() *string {
+	if s.result.Document.Kind != types.SearchKindPackageFile {
+		return nil
+	}
+
+	return &s.result.Document.FileName
+}
+
+func (s *SearchResult) EventIndex() *int {
+	if s.result.Document.Kind != types.SearchKindEvent {
+		return nil
+	}
+
+	index := int(s.result.Document.EventIndex)
+
+	return &index
+}
+
+func (s *SearchResult) AttributeKey() *string {
+	if s.result.Document.Kind != types.SearchKindEvent {
+		return nil
+	}
+
+	return &s.result.Document.AttributeKey
+}
+
+func (s *SearchResult) Kind() SearchKind {
+	switch s.result.Document.Kind {
+	case types.SearchKindPackageFile:
+		return SearchKindPackageFile
+	case types.SearchKindEvent:
+		return SearchKindEvent
+	default:
+		return SearchKindMemo
+	}
+}
+
+// IndexerKind returns the matching indexer search kind
+func (e SearchKind) IndexerKind() types.SearchKind {
+	switch e {
+	case SearchKindPackageFile:
+		return types.SearchKindPackageFile
+	case SearchKindEvent:
+		return types.SearchKindEvent
+	default:
+		return types.SearchKindMemo
+	}
+}
@@ -0,0 +1,115 @@
+package model
+
+import (
+	"github.com/gnolang/tx-indexer/types"
+)
+
+type Token struct {
+	token *types.Token
+}
+
+func NewToken(token *types.Token) *Token {
+	return &Token{
+		token: token,
+	}
+}
+
+func (t *Token) ID() string {
+	return t.token.ID
+}
+
+func (t *Token) PkgPath() string {
+	return t.token.PkgPath
+}
+
+func (t *Token) Name() string {
+	return t.token.Name
+}
+
+func (t *Token) Symbol() string {
+	return t.token.Symbol
+}
+
+func (t *Token) Decimals() int {
+	return int(t.token.Decimals)
+}
+
+func (t *Token) Announced() bool {
+	return t.token.Announced
+}
+
+func (t *Token) TotalSupply() int {
+	return int(t.token.TotalSupply)
+}
+
+func (t *Token) TransferCount() int {
+	return int(t.token.TransferCount)
+}
+
+func (t *Token) FirstSeenHeight() int {
+	return int(t.token.FirstSeenHeight)
+}
+
+type TokenTransfer struct {
+	transfer *types.TokenTransfer
+}
+
+func NewTokenTransfer(transfer *types.TokenTransfer) *TokenTransfer {
+	return &TokenTransfer{
+		transfer: transfer,
+	}
+}
+
+func (t *TokenTransfer) BlockHeight() int {
+	return int(t.transfer.Height)
+}
+
+func (t *TokenTransfer) Index() int {
+	return int(t.transfer.Index)
+}
+
+func (t *TokenTransfer) EventIndex() int {
+	return int(t.transfer.EventIndex)
+}
+
+func (t *TokenTransfer) Token() string {
+	return t.transfer.Token
+}
+
+func (t *TokenTransfer) From() string {
+	return t.transfer.From
+}
+
+func (t *TokenTransfer) To() string {
+	return t.transfer.To
+}
+
+func (t *TokenTransfer) Amount() int {
+	return int(t.transfer.Amount)
+}
+
+type TokenBalance struct {
+	balance *types.TokenBalance
+}
+
+func NewTokenBalance(balance *types.TokenBalance) *TokenBalance {
+	return &TokenBalance{
+		balance: balance,
+	}
+}
+
+func (b *TokenBalance) Token() string {
+	return b.balance.Token
+}
+
+func (b *TokenBalance) Address() string {
+	return b.balance.Address
+}
+
+func (b *TokenBalance) Balance() int {
+	return int(b.balance.Balance)
+}
+
+func (b *TokenBalance) BlockHeight() int {
+	return int(b.balance.Height)
+}
@@ -2,6 +2,7 @@ package model
 
 import (
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"sync"
@@ -11,25 +12,32 @@ import (
 	"github.com/gnolang/gno/tm2/pkg/amino"
 	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
 	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	"github.com/gnolang/gno/tm2/pkg/crypto/multisig"
 	"github.com/gnolang/gno/tm2/pkg/sdk/bank"
 	"github.com/gnolang/gno/tm2/pkg/std"
+
+	indexerTypes "github.com/gnolang/tx-indexer/types"
 )
 
 type Transaction struct {
-	stdTx    *std.Tx
 	txResult *types.TxResult
-	messages []*TransactionMessage
 
-	mu           sync.Mutex
-	onceTx       sync.Once
-	onceMessages sync.Once
+	// stdTx and messages unmarshal the transaction once.
+	// They are shared between copies of the Transaction
+	stdTx    func() *std.Tx
+	messages func() []*TransactionMessage
 }
 
 func NewTransaction(txResult *types.TxResult) *Transaction {
-	return &Transaction{
+	t := &Transaction{
 		txResult: txResult,
-		messages: make([]*TransactionMessage, 0),
 	}
+
+	t.stdTx = sync.OnceValue(t.unmarshalStdTx)
+	t.messages = sync.OnceValue(t.unmarshalMessages)
+
+	return t
 }
 
 func (t *Transaction) ID() string {
@@ -44,6 +52,10 @@ func (t *Transaction) Hash() string {
 	return base64.StdEncoding.EncodeToString(t.txResult.Tx.Hash())
 }
 
+func (t *Transaction) HashHex() string {
+	return hex.EncodeToString(t.txResult.Tx.Hash())
+}
+
 func (t *Transaction) BlockHeight() int {
 	return int(t.txResult.Height)
 }
@@ -92,42 +104,63 @@ func (t *Transaction) GasFee() *Coin {
 	}
 }
 
-func (t *Transaction) getStdTx() *std.Tx {
-	// The function to unmarshal a `std.Tx` is executed once.
-	unmarshalTx := func() {
-		var stdTx std.Tx
-		if err := amino.Unmarshal(t.txResult.Tx, &stdTx); err != nil {
-			t.stdTx = nil
-		}
+func (t *Transaction) Signers() []string {
+	signers := make([]string, 0)
 
-		t.mu.Lock()
-		t.stdTx = &stdTx
-		t.mu.Unlock()
+	for _, signer := range t.getStdTx().GetSigners() {
+		signers = append(signers, signer.String())
 	}
 
-	t.onceTx.Do(unmarshalTx)
+	return signers
+}
+
+func (t *Transaction) FeePayer() string {
+	signers := t.getStdTx().GetSigners()
+	if len(signers) == 0 {
+		return ""
+	}
+
+	return signers[0].String()
+}
+
+func (t *Transaction) Signatures() []*TxSignature {
+	signatures := make([]*TxSignature, 0)
+
+	for _, signature := range t.getStdTx().Signatures {
+		signatures = append(signatures, &TxSignature{
+			PubKey:    makePubKey(signature.PubKey),
+			Signature: base64.StdEncoding.EncodeToString(signature.Signature),
+		})
+	}
 
-	return t.stdTx
+	return signatures
+}
+
+func (t *Transaction) getStdTx() *std.Tx {
+	return t.stdTx()
+}
+
+func (t *Transaction) unmarshalStdTx() *std.Tx {
+	var stdTx std.Tx
+
+	// Undecodable transactions are served empty
+	_ = amino.Unmarshal(t.txResult.Tx, &stdTx)
+
+	return &stdTx
 }
 
 func (t *Transaction) getMessages() []*TransactionMessage {
-	// Functions that unmarshal transaction messages are executed once.
-	unmarshalMessages := func() {
-		stdTx := t.getStdTx()
-		messages := make([]*TransactionMessage, 0)
+	return t.messages()
+}
 
-		for _, message := range stdTx.GetMsgs() {
-			messages = append(messages, NewTransactionMessage(message))
-		}
+func (t *Transaction) unmarshalMessages() []*TransactionMessage {
+	messages := make([]*TransactionMessage, 0)
 
-		t.mu.Lock()
-		t.messages = messages
-		t.mu.Unlock()
+	for _, message := range t.getStdTx().GetMsgs() {
+		messages = append(messages, NewTransactionMessage(message))
 	}
 
-	t.onceMessages.Do(unmarshalMessages)
-
-	return t.messages
+	return messages
 }
 
 //nolint:errname // Provide a field named `error` as the GraphQL response value
@@ -161,6 +194,26 @@ func (tr *TransactionResponse) Error() string {
 	return ""
 }
 
+func (tr *TransactionResponse) TxError() *TxError {
+	txError := indexerTypes.NewTxError(tr.response)
+	if txError == nil {
+		return nil
+	}
+
+	out := &TxError{
+		Codespace: txError.Codespace,
+		Code:      txError.Code,
+		Name:      txError.Name,
+		Message:   txError.Message,
+	}
+
+	if txError.GnoStacktrace != "" {
+		out.GnoStacktrace = &txError.GnoStacktrace
+	}
+
+	return out
+}
+
 func (tr *TransactionResponse) Data() string {
 	return string(tr.response.Data)
 }
@@ -173,16 +226,7 @@ func (tr *TransactionResponse) getEvents() []Event {
 	// This function creates a 'Event'.
 	// and executed once.
 	makeEvents := func() {
-		events := make([]Event, 0)
-
-		for _, event := range tr.response.Events {
-			event, err := makeEvent(event)
-			if err != nil {
-				continue
-			}
-
-			events = append(events, event)
-		}
+		events := NewEvents(tr.response.Events)
 
 		tr.mu.Lock()
 		tr.events = events
@@ -201,49 +245,11 @@ type TransactionMessage struct {
 }
 
 func NewTransactionMessage(message std.Msg) *TransactionMessage {
-	var contentMessage *TransactionMessage
-
-	switch message.Route() {
-	case bank.RouterKey:
-		if message.Type() == MessageTypeSend.String() {
-			contentMessage = &TransactionMessage{
-				Route:   MessageRouteBank.String(),
-				TypeURL: MessageTypeSend.String(),
-				Value:   makeBankMsgSend(message),
-			}
-		}
-	case vm.RouterKey:
-		switch message.Type() {
-		case MessageTypeExec.String():
-			contentMessage = &TransactionMessage{
-				Route:   MessageRouteVM.String(),
-				TypeURL: MessageTypeExec.String(),
-				Value:   makeVMMsgCall(message),
-			}
-		case MessageTypeAddPackage.String():
-			contentMessage = &TransactionMessage{
-				Route:   MessageRouteVM.String(),
-				TypeURL: MessageTypeAddPackage.String(),
-				Value:   makeVMAddPackage(message),
-			}
-		case MessageTypeRun.String():
-			contentMessage = &TransactionMessage{
-				Route:   MessageRouteVM.String(),
-				TypeURL: MessageTypeRun.String(),
-				Value:   makeVMMsgRun(message),
-			}
-		}
+	return &TransactionMessage{
+		Route:   message.Route(),
+		TypeURL: message.Type(),
+		Value:   decodeMessage(message),
 	}
-
-	if contentMessage == nil {
-		contentMessage = &TransactionMessage{
-			Route:   message.Route(),
-			TypeURL: message.Type(),
-			Value:   makeUnexpectedMessage(message),
-		}
-	}
-
-	return contentMessage
 }
 
 func (tm *TransactionMessage) BankMsgSend() BankMsgSend {
@@ -262,6 +268,22 @@ func (tm *TransactionMessage) VMMsgRun() MsgRun {
 	return tm.Value.(MsgRun)
 }
 
+// NewEvents converts the given events, skipping the ones that can't be encoded
+func NewEvents(abciEvents []abci.Event) []Event {
+	events := make([]Event, 0, len(abciEvents))
+
+	for _, abciEvent := range abciEvents {
+		event, err := makeEvent(abciEvent)
+		if err != nil {
+			continue
+		}
+
+		events = append(events, event)
+	}
+
+	return events
+}
+
 func makeEvent(abciEvent abci.Event) (Event, error) {
 	data, err := json.Marshal(abciEvent)
 	if err != nil {
@@ -314,6 +336,7 @@ func makeBankMsgSend(value std.Msg) BankMsgSend {
 		FromAddress: decodedMessage.FromAddress.String(),
 		ToAddress:   decodedMessage.ToAddress.String(),
 		Amount:      decodedMessage.Amount.String(),
+		AmountCoins: makeCoins(decodedMessage.Amount),
 	}
 }
 
@@ -326,6 +349,7 @@ func makeVMMsgCall(value std.Msg) MsgCall {
 	return MsgCall{
 		Caller:     decodedMessage.Caller.String(),
 		Send:       decodedMessage.Send.String(),
+		SendCoins:  makeCoins(decodedMessage.Send),
 		MaxDeposit: decodedMessage.MaxDeposit.String(),
 		PkgPath:    decodedMessage.PkgPath,
 		Func:       decodedMessage.Func,
@@ -356,6 +380,7 @@ func makeVMAddPackage(value std.Msg) MsgAddPackage {
 		},
 		Deposit:    decodedMessage.Send.String(),
 		Send:       decodedMessage.Send.String(),
+		SendCoins:  makeCoins(decodedMessage.Send),
 		MaxDeposit: decodedMessage.MaxDeposit.String(),
 	}
 }
@@ -377,6 +402,7 @@ func makeVMMsgRun(value std.Msg) MsgRun {
 	return MsgRun{
 		Caller:     decodedMessage.Caller.String(),
 		Send:       decodedMessage.Send.String(),
+		SendCoins:  makeCoins(decodedMessage.Send),
 		MaxDeposit: decodedMessage.MaxDeposit.String(),
 		Package: &MemPackage{
 			Name:  decodedMessage.Package.Name,
@@ -399,6 +425,36 @@ func makeUnexpectedMessage(value std.Msg) UnexpectedMessage {
 	}
 }
 
+func makePubKey(pubKey crypto.PubKey) *PubKey {
+	if pubKey == nil {
+		return nil
+	}
+
+	key := &PubKey{
+		Type:    amino.GetTypeURL(pubKey),
+		Address: pubKey.Address().String(),
+		Value:   crypto.PubKeyToBech32(pubKey),
+	}
+
+	if multisigKey, ok := pubKey.(multisig.PubKeyMultisigThreshold); ok {
+		pubKeys := make([]*MultisigPubKey, 0, len(multisigKey.PubKeys))
+		for _, member := range multisigKey.PubKeys {
+			pubKeys = append(pubKeys, &MultisigPubKey{
+				Type:    amino.GetTypeURL(member),
+				Address: member.Address().String(),
+				Value:   crypto.PubKeyToBech32(member),
+			})
+		}
+
+		key.Multisig = &Multisig{
+			Threshold: int(multisigKey.K),
+			PubKeys:   pubKeys,
+		}
+	}
+
+	return key
+}
+
 func cast[T any](input any) (*T, error) {
 	encoded, err := json.Marshal(input)
 	if err != nil {
@@ -412,3 +468,5 @@ func cast[T any](input any) (*T, error) {
 
 	return &data, nil
 }
+
+func (Transaction) IsLookupResult() {}
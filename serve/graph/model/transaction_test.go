@@ -0,0 +1,318 @@
+package model
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/gnolang/gno/gno.land/pkg/sdk/vm"
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	"github.com/gnolang/gno/tm2/pkg/crypto/multisig"
+	"github.com/gnolang/gno/tm2/pkg/crypto/secp256k1"
+	"github.com/gnolang/gno/tm2/pkg/sdk/bank"
+	"github.com/gnolang/gno/tm2/pkg/std"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testChainID = "dev"
+
+// newTestKey generates a deterministic private key from the given seed
+func newTestKey(seed string) crypto.PrivKey {
+	return secp256k1.GenPrivKeySecp256k1([]byte(seed))
+}
+
+// newSendMsg creates a bank send message from the given address
+func newSendMsg(from crypto.Address) bank.MsgSend {
+	return bank.MsgSend{
+		FromAddress: from,
+		ToAddress:   crypto.AddressFromPreimage([]byte("to")),
+		Amount:      std.NewCoins(std.NewCoin("ugnot", 10)),
+	}
+}
+
+// newIndexedTx encodes the given transaction, as it's stored by the indexer
+func newIndexedTx(t *testing.T, tx std.Tx) *Transaction {
+	t.Helper()
+
+	encodedTx, err := amino.Marshal(tx)
+	require.NoError(t, err)
+
+	return NewTransaction(&types.TxResult{
+		Tx: encodedTx,
+	})
+}
+
+// signTx signs the transaction with the given key, and verifies the signature
+func signTx(t *testing.T, tx std.Tx, key crypto.PrivKey) []byte {
+	t.Helper()
+
+	signBytes, err := tx.GetSignBytes(testChainID, 0, 0)
+	require.NoError(t, err)
+
+	signature, err := key.Sign(signBytes)
+	require.NoError(t, err)
+
+	require.True(t, key.PubKey().VerifyBytes(signBytes, signature))
+
+	return signature
+}
+
+func TestTransaction_Signers(t *testing.T) {
+	t.Parallel()
+
+	var (
+		first  = newTestKey("first").PubKey().Address()
+		second = newTestKey("second").PubKey().Address()
+	)
+
+	testTable := []struct {
+		name            string
+		feePayer        string
+		msgs            []std.Msg
+		expectedSigners []string
+	}{
+		{
+			"single signer",
+			first.String(),
+			[]std.Msg{
+				newSendMsg(first),
+			},
+			[]string{first.String()},
+		},
+		{
+			"multiple signers, in message order",
+			second.String(),
+			[]std.Msg{
+				vm.MsgCall{
+					Caller:  second,
+					PkgPath: "gno.land/r/demo/foo",
+					Func:    "Bar",
+				},
+				newSendMsg(first),
+				newSendMsg(second),
+			},
+			[]string{second.String(), first.String()},
+		},
+		{
+			"no messages",
+			"",
+			[]std.Msg{},
+			[]string{},
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			tx := newIndexedTx(t, std.Tx{
+				Msgs: testCase.msgs,
+				Fee:  std.NewFee(100_000, std.NewCoin("ugnot", 1_000)),
+			})
+
+			assert.Equal(t, testCase.expectedSigners, tx.Signers())
+			assert.Equal(t, testCase.feePayer, tx.FeePayer())
+		})
+	}
+}
+
+func TestTransaction_Signatures(t *testing.T) {
+	t.Parallel()
+
+	var (
+		signerKey = newTestKey("signer")
+		knownKey  = newTestKey("known")
+
+		memberKeys = []crypto.PrivKey{
+			newTestKey("member 1"),
+			newTestKey("member 2"),
+			newTestKey("member 3"),
+		}
+		memberPubKeys = []crypto.PubKey{
+			memberKeys[0].PubKey(),
+			memberKeys[1].PubKey(),
+			memberKeys[2].PubKey(),
+		}
+
+		multisigKey = multisig.NewPubKeyMultisigThreshold(2, memberPubKeys)
+	)
+
+	tx := std.Tx{
+		Msgs: []std.Msg{
+			newSendMsg(signerKey.PubKey().Address()),
+			newSendMsg(knownKey.PubKey().Address()),
+			newSendMsg(multisigKey.Address()),
+		},
+		Fee: std.NewFee(100_000, std.NewCoin("ugnot", 1_000)),
+	}
+
+	// Sign with the multisig threshold, using the first and last member
+	multisignature := multisig.NewMultisig(len(memberPubKeys))
+	for _, index := range []int{0, 2} {
+		require.NoError(
+			t,
+			multisignature.AddSignatureFromPubKey(
+				signTx(t, tx, memberKeys[index]),
+				memberPubKeys[index],
+				memberPubKeys,
+			),
+		)
+	}
+
+	signBytes, err := tx.GetSignBytes(testChainID, 0, 0)
+	require.NoError(t, err)
+	require.True(t, multisigKey.VerifyBytes(signBytes, multisignature.Marshal()))
+
+	tx.Signatures = []std.Signature{
+		{
+			PubKey:    signerKey.PubKey(),
+			Signature: signTx(t, tx, signerKey),
+		},
+		{
+			// Accounts with a known public key can omit it
+			PubKey:    nil,
+			Signature: signTx(t, tx, knownKey),
+		},
+		{
+			PubKey:    multisigKey,
+			Signature: multisignature.Marshal(),
+		},
+	}
+
+	signatures := newIndexedTx(t, tx).Signatures()
+	require.Len(t, signatures, len(tx.Signatures))
+
+	for index, signature := range signatures {
+		assert.Equal(
+			t,
+			base64.StdEncoding.EncodeToString(tx.Signatures[index].Signature),
+			signature.Signature,
+		)
+	}
+
+	t.Run("signature with a public key", func(t *testing.T) {
+		t.Parallel()
+
+		pubKey := signerKey.PubKey()
+
+		assert.Equal(t, &PubKey{
+			Type:    "/tm.PubKeySecp256k1",
+			Address: pubKey.Address().String(),
+			Value:   crypto.PubKeyToBech32(pubKey),
+		}, signatures[0].PubKey)
+	})
+
+	t.Run("signature without a public key", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, signatures[1].PubKey)
+	})
+
+	t.Run("multisig threshold signature", func(t *testing.T) {
+		t.Parallel()
+
+		pubKey := signatures[2].PubKey
+		require.NotNil(t, pubKey)
+
+		assert.Equal(t, "/tm.PubKeyMultisig", pubKey.Type)
+		assert.Equal(t, multisigKey.Address().String(), pubKey.Address)
+		assert.Equal(t, crypto.PubKeyToBech32(multisigKey), pubKey.Value)
+
+		require.NotNil(t, pubKey.Multisig)
+		assert.Equal(t, 2, pubKey.Multisig.Threshold)
+		require.Len(t, pubKey.Multisig.PubKeys, len(memberPubKeys))
+
+		for index, member := range pubKey.Multisig.PubKeys {
+			assert.Equal(t, &MultisigPubKey{
+				Type:    "/tm.PubKeySecp256k1",
+				Address: memberPubKeys[index].Address().String(),
+				Value:   crypto.PubKeyToBech32(memberPubKeys[index]),
+			}, member)
+		}
+	})
+}
+
+func TestTransaction_Signatures_Filter(t *testing.T) {
+	t.Parallel()
+
+	var (
+		key       = newTestKey("signer")
+		threshold = 2
+	)
+
+	multisigKey := multisig.NewPubKeyMultisigThreshold(threshold, []crypto.PubKey{
+		newTestKey("member 1").PubKey(),
+		newTestKey("member 2").PubKey(),
+	})
+
+	tx := newIndexedTx(t, std.Tx{
+		Msgs: []std.Msg{
+			newSendMsg(multisigKey.Address()),
+		},
+		Signatures: []std.Signature{
+			{
+				PubKey:    multisigKey,
+				Signature: []byte("signature"),
+			},
+		},
+	})
+
+	address := multisigKey.Address().String()
+	other := key.PubKey().Address().String()
+
+	testTable := []struct {
+		filter  *FilterTransaction
+		name    string
+		matches bool
+	}{
+		{
+			&FilterTransaction{
+				FeePayer: &FilterString{Eq: &address},
+			},
+			"matching fee payer",
+			true,
+		},
+		{
+			&FilterTransaction{
+				FeePayer: &FilterString{Eq: &other},
+			},
+			"other fee payer",
+			false,
+		},
+		{
+			&FilterTransaction{
+				Signatures: &NestedFilterTxSignature{
+					PubKey: &NestedFilterPubKey{
+						Multisig: &NestedFilterMultisig{
+							Threshold: &FilterInt{Eq: &threshold},
+						},
+					},
+				},
+			},
+			"matching multisig threshold",
+			true,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testCase.matches, testCase.filter.Eval(tx))
+		})
+	}
+}
+
+func TestTransaction_Undecodable(t *testing.T) {
+	t.Parallel()
+
+	tx := NewTransaction(&types.TxResult{
+		Tx: []byte("not an amino transaction"),
+	})
+
+	assert.Empty(t, tx.Signers())
+	assert.Empty(t, tx.FeePayer())
+	assert.Empty(t, tx.Signatures())
+}
@@ -0,0 +1,153 @@
+package model
+
+import (
+	"encoding/base64"
+
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+
+	"github.com/gnolang/tx-indexer/serve/methods"
+	"github.com/gnolang/tx-indexer/types"
+)
+
+type ValidatorSet struct {
+	set *types.ValidatorSet
+}
+
+func NewValidatorSet(set *types.ValidatorSet) *ValidatorSet {
+	return &ValidatorSet{
+		set: set,
+	}
+}
+
+func (v *ValidatorSet) Height() int {
+	return int(v.set.Height)
+}
+
+func (v *ValidatorSet) Hash() string {
+	return base64.StdEncoding.EncodeToString(v.set.Hash)
+}
+
+func (v *ValidatorSet) Validators() []*Validator {
+	validators := make([]*Validator, 0, len(v.set.Validators))
+
+	for _, validator := range v.set.Validators {
+		validators = append(validators, &Validator{
+			Address:     validator.Address,
+			PubKey:      validator.PubKey,
+			VotingPower: int(validator.VotingPower),
+		})
+	}
+
+	return validators
+}
+
+// NewValidatorUpdates converts the given validator set changes
+func NewValidatorUpdates(updates []abci.ValidatorUpdate) []*ValidatorUpdate {
+	out := make([]*ValidatorUpdate, 0, len(updates))
+
+	for _, update := range updates {
+		var pubKey string
+		if update.PubKey != nil {
+			pubKey = crypto.PubKeyToBech32(update.PubKey)
+		}
+
+		out = append(out, &ValidatorUpdate{
+			Address: update.Address.String(),
+			PubKey:  pubKey,
+			Power:   int(update.Power),
+		})
+	}
+
+	return out
+}
+
+type BlockSignatures struct {
+	signatures *types.BlockSignatures
+}
+
+func NewBlockSignatures(signatures *types.BlockSignatures) *BlockSignatures {
+	return &BlockSignatures{
+		signatures: signatures,
+	}
+}
+
+func (b *BlockSignatures) Height() int {
+	return int(b.signatures.Height)
+}
+
+func (b *BlockSignatures) Signers() []string {
+	if b.signatures.Signers == nil {
+		return []string{}
+	}
+
+	return b.signatures.Signers
+}
+
+func (b *BlockSignatures) Missed() []string {
+	if b.signatures.Missed == nil {
+		return []string{}
+	}
+
+	return b.signatures.Missed
+}
+
+type ValidatorUptime struct {
+	uptime *methods.ValidatorUptime
+}
+
+func NewValidatorUptime(uptime *methods.ValidatorUptime) *ValidatorUptime {
+	return &ValidatorUptime{
+		uptime: uptime,
+	}
+}
+
+func (v *ValidatorUptime) Address() string {
+	return v.uptime.Address
+}
+
+func (v *ValidatorUptime) Signed() int {
+	return int(v.uptime.Signed)
+}
+
+func (v *ValidatorUptime) Missed() int {
+	return int(v.uptime.Missed)
+}
+
+func (v *ValidatorUptime) Uptime() float64 {
+	return v.uptime.Uptime
+}
+
+func (v *ValidatorUptime) FromHeight() int {
+	return int(v.uptime.FromHeight)
+}
+
+func (v *ValidatorUptime) ToHeight() int {
+	return int(v.uptime.ToHeight)
+}
+
+type ProposerStats struct {
+	stats *methods.ProposerStats
+}
+
+func NewProposerStats(stats *methods.ProposerStats) *ProposerStats {
+	return &ProposerStats{
+		stats: stats,
+	}
+}
+
+func (p *ProposerStats) Address() string {
+	return p.stats.Address
+}
+
+func (p *ProposerStats) Proposed() int {
+	return int(p.stats.Proposed)
+}
+
+func (p *ProposerStats) Share() float64 {
+	return p.stats.Share
+}
+
+func (p *ProposerStats) LastHeight() int {
+	return int(p.stats.LastHeight)
+}
@@ -0,0 +1,117 @@
+package graph
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+	bfttypes "github.com/gnolang/gno/tm2/pkg/bft/types"
+
+	"github.com/gnolang/tx-indexer/serve/graph/model"
+	"github.com/gnolang/tx-indexer/storage"
+)
+
+const defaultPageSize = 100
+
+// encodeTxCursor encodes the transaction position into an opaque cursor
+func encodeTxCursor(tx *model.Transaction) string {
+	return base64.URLEncoding.EncodeToString([]byte(tx.ID()))
+}
+
+// decodeTxCursor decodes the opaque cursor into the transaction position
+func decodeTxCursor(cursor string) (uint64, uint32, error) {
+	raw, err := base64.URLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid cursor, %w", err)
+	}
+
+	var (
+		height uint64
+		index  uint32
+	)
+
+	if _, err := fmt.Sscanf(string(raw), "%d_%d", &height, &index); err != nil {
+		return 0, 0, fmt.Errorf("invalid cursor, %w", err)
+	}
+
+	return height, index, nil
+}
+
+// afterTxCursor returns the position right after the one encoded in the cursor,
+// or the very first position if the cursor is not set
+func afterTxCursor(after *string) (uint64, uint32, error) {
+	if after == nil {
+		return 0, 0, nil
+	}
+
+	height, index, err := decodeTxCursor(*after)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return height, index + 1, nil
+}
+
+// pageSize returns the sanitized page size
+func pageSize(first *int) (int, error) {
+	if first == nil {
+		return defaultPageSize, nil
+	}
+
+	if *first < 0 {
+		return 0, fmt.Errorf("invalid page size %d", *first)
+	}
+
+	return min(*first, maxElementsPerQuery), nil
+}
+
+// txConnection reads a single page of transactions from the iterator
+func txConnection(
+	ctx context.Context,
+	it storage.Iterator[*bfttypes.TxResult],
+	first int,
+) (*model.TransactionConnection, error) {
+	defer it.Close()
+
+	connection := &model.TransactionConnection{
+		Edges:    make([]*model.TransactionEdge, 0),
+		PageInfo: &model.PageInfo{},
+	}
+
+	for it.Next() {
+		if len(connection.Edges) == first {
+			connection.PageInfo.HasNextPage = true
+
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			graphql.AddError(ctx, ctx.Err())
+
+			return connection, nil
+		default:
+		}
+
+		tx, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		transaction := model.NewTransaction(tx)
+		cursor := encodeTxCursor(transaction)
+
+		connection.Edges = append(connection.Edges, &model.TransactionEdge{
+			Cursor: cursor,
+			Node:   transaction,
+		})
+		connection.PageInfo.EndCursor = &cursor
+	}
+
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+
+	return connection, nil
+}
@@ -4,11 +4,13 @@ package graph
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/99designs/gqlgen/graphql"
 
 	"github.com/gnolang/tx-indexer/events"
+	"github.com/gnolang/tx-indexer/mempool"
 	"github.com/gnolang/tx-indexer/storage"
 	"github.com/gnolang/tx-indexer/types"
 )
@@ -19,6 +21,8 @@ import (
 
 const maxElementsPerQuery = 10000
 
+var errMempoolDisabled = errors.New("pending transaction tracking is not enabled")
+
 func deref[T any](v *T) T {
 	if v == nil {
 		var zero T
@@ -33,13 +37,24 @@ func handleChannel[T any](
 	ctx context.Context,
 	m *events.Manager,
 	writeToChannel func(*types.NewBlock, chan<- T),
+) <-chan T {
+	return handleEventChannel(ctx, m, types.NewBlockEvent, writeToChannel)
+}
+
+// handleEventChannel streams the data of the given event type,
+// written to the returned channel by writeToChannel
+func handleEventChannel[E any, T any](
+	ctx context.Context,
+	m *events.Manager,
+	eventType events.Type,
+	writeToChannel func(E, chan<- T),
 ) <-chan T {
 	ch := make(chan T)
 
 	go func() {
 		defer close(ch)
 
-		sub := m.Subscribe([]events.Type{types.NewBlockEvent})
+		sub := m.Subscribe([]events.Type{eventType})
 		defer m.CancelSubscription(sub.ID)
 
 		for {
@@ -53,7 +68,7 @@ func handleChannel[T any](
 					return
 				}
 
-				e, ok := rawE.GetData().(*types.NewBlock)
+				e, ok := rawE.GetData().(E)
 				if !ok {
 					graphql.AddError(ctx, fmt.Errorf("error casting event data. Obtained event ID: %q", rawE.GetType()))
 
@@ -71,8 +86,11 @@ func handleChannel[T any](
 type Resolver struct {
 	store   storage.Storage
 	manager *events.Manager
+
+	// pool holds the pending transactions, nil if mempool tracking is disabled
+	pool *mempool.Pool
 }
 
-func NewResolver(s storage.Storage, m *events.Manager) *Resolver {
-	return &Resolver{store: s, manager: m}
+func NewResolver(s storage.Storage, m *events.Manager, pool *mempool.Pool) *Resolver {
+	return &Resolver{store: s, manager: m, pool: pool}
 }
@@ -0,0 +1,23 @@
+package graph
+
+import (
+	"fmt"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
+// searchLimit returns the sanitized number of search results
+func searchLimit(limit *int) (int, error) {
+	if limit == nil {
+		return defaultSearchLimit, nil
+	}
+
+	if *limit < 1 {
+		return 0, fmt.Errorf("invalid search limit %d", *limit)
+	}
+
+	return min(*limit, maxSearchLimit), nil
+}
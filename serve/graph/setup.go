@@ -15,6 +15,7 @@ import (
 	"github.com/vektah/gqlparser/v2/ast"
 
 	"github.com/gnolang/tx-indexer/events"
+	"github.com/gnolang/tx-indexer/mempool"
 	"github.com/gnolang/tx-indexer/serve/graph/model"
 	"github.com/gnolang/tx-indexer/storage"
 )
@@ -22,10 +23,16 @@ import (
 //go:embed examples/*.gql
 var examples embed.FS
 
-func Setup(s storage.Storage, manager *events.Manager, m *chi.Mux, disableIntrospection bool) *chi.Mux {
+func Setup(
+	s storage.Storage,
+	manager *events.Manager,
+	pool *mempool.Pool,
+	m *chi.Mux,
+	disableIntrospection bool,
+) *chi.Mux {
 	srv := handler.New(NewExecutableSchema(
 		Config{
-			Resolvers: NewResolver(s, manager),
+			Resolvers: NewResolver(s, manager, pool),
 			Directives: DirectiveRoot{
 				Filterable: func(
 					ctx context.Context,
@@ -42,6 +49,7 @@ func Setup(s storage.Storage, manager *events.Manager, m *chi.Mux, disableIntros
 	srv.AddTransport(transport.Websocket{
 		KeepAlivePingInterval: 10 * time.Second,
 	})
+	srv.AddTransport(transport.SSE{}) // must be added before POST, as both serve POST requests
 	srv.AddTransport(transport.Options{})
 	srv.AddTransport(transport.GET{})
 	srv.AddTransport(transport.POST{})
@@ -0,0 +1,87 @@
+package graph
+
+import (
+	"github.com/gnolang/tx-indexer/serve/graph/model"
+	"github.com/gnolang/tx-indexer/storage"
+	"github.com/gnolang/tx-indexer/types"
+)
+
+// transferIterator iterates over the native coin movements
+// within the block range of the filter. The movements are read from the
+// address index when the filter requires a sender or recipient address
+func transferIterator(store storage.Storage, where *model.FilterTransfer) (storage.Iterator[*types.Transfer], error) {
+	fromh, toh := where.MinMaxBlockHeight()
+
+	if address := transferFilterAddress(where); address != "" {
+		return store.AddressTransferIterator(address, uint64(deref(fromh)), uint64(deref(toh)))
+	}
+
+	return store.TransferIterator(uint64(deref(fromh)), uint64(deref(toh)))
+}
+
+// transferFilterAddress returns the sender or recipient address
+// required by the transfer filter, if any. Only the equality conditions
+// that need to match (top-level or within `_and`) are considered
+func transferFilterAddress(where *model.FilterTransfer) string {
+	if where == nil {
+		return ""
+	}
+
+	if where.From != nil && where.From.Eq != nil {
+		return *where.From.Eq
+	}
+
+	if where.To != nil && where.To.Eq != nil {
+		return *where.To.Eq
+	}
+
+	for _, and := range where.And {
+		if address := transferFilterAddress(and); address != "" {
+			return address
+		}
+	}
+
+	return ""
+}
+
+// tokenTransferIterator iterates over the GRC20 token movements
+// within the block range of the filter. The movements are read from the
+// address index when the filter requires a sender or recipient address
+func tokenTransferIterator(
+	store storage.Storage,
+	where *model.FilterTokenTransfer,
+) (storage.Iterator[*types.TokenTransfer], error) {
+	fromh, toh := where.MinMaxBlockHeight()
+
+	if address := tokenTransferFilterAddress(where); address != "" {
+		return store.AddressTokenTransferIterator(address, uint64(deref(fromh)), uint64(deref(toh)))
+	}
+
+	return store.TokenTransferIterator(uint64(deref(fromh)), uint64(deref(toh)))
+}
+
+// tokenTransferFilterAddress returns the sender or recipient address
+// required by the token transfer filter, if any. Only the equality conditions
+// that need to match (top-level or within `_and`) are considered
+func tokenTransferFilterAddress(where *model.FilterTokenTransfer) string {
+	if where == nil {
+		return ""
+	}
+
+	// Mints and burns have an empty sender or recipient, which isn't indexed
+	if where.From != nil && deref(where.From.Eq) != "" {
+		return *where.From.Eq
+	}
+
+	if where.To != nil && deref(where.To.Eq) != "" {
+		return *where.To.Eq
+	}
+
+	for _, and := range where.And {
+		if address := tokenTransferFilterAddress(and); address != "" {
+			return address
+		}
+	}
+
+	return ""
+}
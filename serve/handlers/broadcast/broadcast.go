@@ -0,0 +1,191 @@
+package broadcast
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	core_types "github.com/gnolang/gno/tm2/pkg/bft/rpc/core/types"
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+
+	"github.com/gnolang/tx-indexer/serve/encode"
+	"github.com/gnolang/tx-indexer/serve/metadata"
+	"github.com/gnolang/tx-indexer/serve/spec"
+	storageErrors "github.com/gnolang/tx-indexer/storage/errors"
+	commonTypes "github.com/gnolang/tx-indexer/types"
+)
+
+const (
+	// DefaultWaitTimeout is the time waitForTx waits
+	// for the transaction, if no timeout is given
+	DefaultWaitTimeout = 30 * time.Second
+
+	// MaxWaitTimeout is the maximum time waitForTx waits for the transaction
+	MaxWaitTimeout = 2 * time.Minute
+)
+
+type Handler struct {
+	ctx context.Context
+
+	client  Client
+	storage Storage
+	waiters *waiterRegistry
+}
+
+// NewHandler creates a new broadcast handler, which tracks
+// the indexed transactions until the context is done
+func NewHandler(
+	ctx context.Context,
+	client Client,
+	storage Storage,
+	events Events,
+) *Handler {
+	h := &Handler{
+		ctx:     ctx,
+		client:  client,
+		storage: storage,
+		waiters: newWaiterRegistry(),
+	}
+
+	h.waiters.start(ctx, events)
+
+	return h
+}
+
+// BroadcastTxHandler relays the transaction (amino-encoded, in base64)
+// to the node, using broadcast_tx_sync
+func (h *Handler) BroadcastTxHandler(
+	_ *metadata.Metadata,
+	params []any,
+) (any, *spec.BaseJSONError) {
+	// Check the params
+	if len(params) != 1 {
+		return nil, spec.GenerateInvalidParamCountError()
+	}
+
+	// Extract the params
+	encodedTx, ok := params[0].(string)
+	if !ok {
+		return nil, spec.GenerateInvalidParamError(1)
+	}
+
+	tx, err := base64.StdEncoding.DecodeString(encodedTx)
+	if err != nil || len(tx) == 0 {
+		return nil, spec.GenerateInvalidParamError(1)
+	}
+
+	// Run the handler
+	response, err := h.broadcastTx(tx)
+	if err != nil {
+		return nil, spec.GenerateResponseError(err)
+	}
+
+	encodedResponse, err := encode.PrepareValue(response)
+	if err != nil {
+		return nil, spec.GenerateResponseError(err)
+	}
+
+	return encodedResponse, nil
+}
+
+// WaitForTxHandler waits for the transaction with the given hash to be indexed,
+// for at most the given timeout (in milliseconds).
+// Returns nil if the transaction was not indexed before the timeout
+func (h *Handler) WaitForTxHandler(
+	_ *metadata.Metadata,
+	params []any,
+) (any, *spec.BaseJSONError) {
+	// Check the params
+	if len(params) < 1 || len(params) > 2 {
+		return nil, spec.GenerateInvalidParamCountError()
+	}
+
+	// Extract the params
+	txHash, ok := params[0].(string)
+	if !ok {
+		return nil, spec.GenerateInvalidParamError(1)
+	}
+
+	if _, err := commonTypes.DecodeHash(txHash); err != nil {
+		return nil, spec.GenerateInvalidParamError(1)
+	}
+
+	timeout := DefaultWaitTimeout
+
+	if len(params) > 1 && params[1] != nil {
+		timeoutMs, err := strconv.ParseUint(fmt.Sprintf("%v", params[1]), 10, 64)
+		if err != nil {
+			return nil, spec.GenerateInvalidParamError(2)
+		}
+
+		timeout = min(time.Duration(timeoutMs)*time.Millisecond, MaxWaitTimeout)
+	}
+
+	// Run the handler
+	response, err := h.waitForTx(commonTypes.NormalizeHash(txHash), timeout)
+	if err != nil {
+		return nil, spec.GenerateResponseError(err)
+	}
+
+	if response == nil {
+		return nil, nil
+	}
+
+	encodedResponse, err := encode.PrepareValue(response)
+	if err != nil {
+		return nil, spec.GenerateResponseError(err)
+	}
+
+	return encodedResponse, nil
+}
+
+// broadcastTx relays the transaction to the node
+func (h *Handler) broadcastTx(tx types.Tx) (*core_types.ResultBroadcastTx, error) {
+	return h.client.BroadcastTxSync(h.ctx, tx)
+}
+
+// waitForTx waits for the transaction to be indexed, if it isn't already.
+// Returns nil if the transaction was not indexed before the timeout
+func (h *Handler) waitForTx(hash string, timeout time.Duration) (*types.TxResult, error) {
+	// The waiter is registered before checking the storage,
+	// so the transaction can't be indexed in between unnoticed:
+	// blocks are only signaled once they are committed to the storage
+	w := h.waiters.add(hash)
+	defer h.waiters.remove(hash, w)
+
+	tx, err := h.getTxByHash(hash)
+	if err != nil || tx != nil {
+		return tx, err
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case tx = <-w:
+		return tx, nil
+	case <-h.ctx.Done():
+		return nil, h.ctx.Err()
+	case <-timer.C:
+		//nolint:nilnil // The transaction was not indexed in time
+		return nil, nil
+	}
+}
+
+// getTxByHash fetches the tx from storage, if any
+func (h *Handler) getTxByHash(hash string) (*types.TxResult, error) {
+	tx, err := h.storage.GetTxByHash(hash)
+	if errors.Is(err, storageErrors.ErrNotFound) {
+		//nolint:nilnil // This is a special case
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
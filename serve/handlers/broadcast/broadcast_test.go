@@ -0,0 +1,360 @@
+package broadcast
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	core_types "github.com/gnolang/gno/tm2/pkg/bft/rpc/core/types"
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/tx-indexer/events"
+	"github.com/gnolang/tx-indexer/serve/spec"
+	storageErrors "github.com/gnolang/tx-indexer/storage/errors"
+	commonTypes "github.com/gnolang/tx-indexer/types"
+)
+
+// notFoundStorage is a storage where no transaction is indexed
+func notFoundStorage() *mockStorage {
+	return &mockStorage{
+		getTxHashFn: func(_ string) (*types.TxResult, error) {
+			return nil, storageErrors.ErrNotFound
+		},
+	}
+}
+
+// decodeTxResult decodes the handler response into a transaction result
+func decodeTxResult(t *testing.T, response any) *types.TxResult {
+	t.Helper()
+
+	encoded, ok := response.(string)
+	require.True(t, ok)
+
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	require.NoError(t, err)
+
+	var txResult types.TxResult
+
+	require.NoError(t, amino.Unmarshal(raw, &txResult))
+
+	return &txResult
+}
+
+func TestBroadcastTx_InvalidParams(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name   string
+		params []any
+	}{
+		{
+			"invalid param length",
+			[]any{},
+		},
+		{
+			"invalid param type",
+			[]any{10},
+		},
+		{
+			"invalid tx encoding",
+			[]any{"not base64 !"},
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := NewHandler(context.Background(), &mockClient{}, &mockStorage{}, events.NewManager())
+
+			response, err := h.BroadcastTxHandler(nil, testCase.params)
+			assert.Nil(t, response)
+
+			require.NotNil(t, err)
+
+			assert.Equal(t, spec.InvalidParamsErrorCode, err.Code)
+		})
+	}
+}
+
+func TestBroadcastTx_Handler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		tx = types.Tx("broadcast tx")
+
+		broadcastTx types.Tx
+
+		client = &mockClient{
+			broadcastTxSyncFn: func(_ context.Context, tx types.Tx) (*core_types.ResultBroadcastTx, error) {
+				broadcastTx = tx
+
+				return &core_types.ResultBroadcastTx{
+					Hash: tx.Hash(),
+				}, nil
+			},
+		}
+	)
+
+	h := NewHandler(context.Background(), client, &mockStorage{}, events.NewManager())
+
+	response, err := h.BroadcastTxHandler(
+		nil,
+		[]any{base64.StdEncoding.EncodeToString(tx)},
+	)
+	require.Nil(t, err)
+
+	// Make sure the tx was relayed
+	assert.Equal(t, tx, broadcastTx)
+
+	encoded, ok := response.(string)
+	require.True(t, ok)
+
+	raw, decodeErr := base64.StdEncoding.DecodeString(encoded)
+	require.NoError(t, decodeErr)
+
+	var result core_types.ResultBroadcastTx
+
+	require.NoError(t, amino.Unmarshal(raw, &result))
+
+	assert.Equal(t, tx.Hash(), result.Hash)
+}
+
+func TestWaitForTx_InvalidParams(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name   string
+		params []any
+	}{
+		{
+			"invalid param length",
+			[]any{},
+		},
+		{
+			"invalid hash type",
+			[]any{10},
+		},
+		{
+			"invalid hash",
+			[]any{"not a hash"},
+		},
+		{
+			"invalid timeout",
+			[]any{base64.StdEncoding.EncodeToString(types.Tx("tx").Hash()), "one second"},
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := NewHandler(context.Background(), &mockClient{}, &mockStorage{}, events.NewManager())
+
+			response, err := h.WaitForTxHandler(nil, testCase.params)
+			assert.Nil(t, response)
+
+			require.NotNil(t, err)
+
+			assert.Equal(t, spec.InvalidParamsErrorCode, err.Code)
+		})
+	}
+}
+
+func TestWaitForTx_Handler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("tx already indexed", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			txResult = &types.TxResult{
+				Height: 10,
+				Tx:     types.Tx("indexed tx"),
+			}
+
+			storage = &mockStorage{
+				getTxHashFn: func(hash string) (*types.TxResult, error) {
+					require.Equal(
+						t,
+						base64.StdEncoding.EncodeToString(txResult.Tx.Hash()),
+						hash,
+					)
+
+					return txResult, nil
+				},
+			}
+		)
+
+		h := NewHandler(context.Background(), &mockClient{}, storage, events.NewManager())
+
+		// Use a hex hash, which is normalized
+		response, err := h.WaitForTxHandler(
+			nil,
+			[]any{hex.EncodeToString(txResult.Tx.Hash())},
+		)
+		require.Nil(t, err)
+
+		assert.Equal(t, txResult.Height, decodeTxResult(t, response).Height)
+	})
+
+	t.Run("tx indexed while waiting", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			txResult = &types.TxResult{
+				Height: 11,
+				Tx:     types.Tx("pending tx"),
+			}
+
+			manager = events.NewManager()
+		)
+
+		h := NewHandler(context.Background(), &mockClient{}, notFoundStorage(), manager)
+
+		// Index the transaction once the waiter is registered
+		go func() {
+			for {
+				h.waiters.mu.Lock()
+				registered := len(h.waiters.waiters) != 0
+				h.waiters.mu.Unlock()
+
+				if registered {
+					break
+				}
+
+				time.Sleep(time.Millisecond)
+			}
+
+			manager.SignalEvent(&commonTypes.NewBlock{
+				Block:   &types.Block{},
+				Results: []*types.TxResult{txResult},
+			})
+		}()
+
+		response, err := h.WaitForTxHandler(
+			nil,
+			[]any{base64.StdEncoding.EncodeToString(txResult.Tx.Hash()), 5000},
+		)
+		require.Nil(t, err)
+
+		assert.Equal(t, txResult.Height, decodeTxResult(t, response).Height)
+
+		// Make sure the waiter is unregistered
+		h.waiters.mu.Lock()
+		defer h.waiters.mu.Unlock()
+
+		assert.Empty(t, h.waiters.waiters)
+	})
+
+	t.Run("tx indexed before the waiter is registered", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			txResult = &types.TxResult{
+				Height: 12,
+				Tx:     types.Tx("just indexed tx"),
+			}
+
+			manager = events.NewManager()
+
+			mu      sync.Mutex
+			indexed = make(map[string]*types.TxResult)
+
+			storage = &mockStorage{
+				getTxHashFn: func(hash string) (*types.TxResult, error) {
+					mu.Lock()
+					defer mu.Unlock()
+
+					tx, ok := indexed[hash]
+					if !ok {
+						return nil, storageErrors.ErrNotFound
+					}
+
+					return tx, nil
+				},
+			}
+
+			hash    = base64.StdEncoding.EncodeToString(txResult.Tx.Hash())
+			timeout = 5 * time.Second
+		)
+
+		h := NewHandler(context.Background(), &mockClient{}, storage, manager)
+
+		// Index the transaction like the fetcher does, by committing
+		// it to the storage, and only then signaling the block
+		mu.Lock()
+		indexed[hash] = txResult
+		mu.Unlock()
+
+		manager.SignalEvent(&commonTypes.NewBlock{
+			Block:   &types.Block{},
+			Results: []*types.TxResult{txResult},
+		})
+
+		start := time.Now()
+
+		response, err := h.WaitForTxHandler(
+			nil,
+			[]any{hash, timeout.Milliseconds()},
+		)
+		require.Nil(t, err)
+
+		// Make sure the transaction is returned right away, without waiting for the timeout
+		assert.Less(t, time.Since(start), timeout)
+		assert.Equal(t, txResult.Height, decodeTxResult(t, response).Height)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		t.Parallel()
+
+		h := NewHandler(context.Background(), &mockClient{}, notFoundStorage(), events.NewManager())
+
+		response, err := h.WaitForTxHandler(
+			nil,
+			[]any{base64.StdEncoding.EncodeToString(types.Tx("missing tx").Hash()), 10},
+		)
+		require.Nil(t, err)
+
+		assert.Nil(t, response)
+
+		// Make sure the waiter is unregistered
+		h.waiters.mu.Lock()
+		defer h.waiters.mu.Unlock()
+
+		assert.Empty(t, h.waiters.waiters)
+	})
+}
+
+func TestWaiterRegistry_NotifyOnce(t *testing.T) {
+	t.Parallel()
+
+	var (
+		registry = newWaiterRegistry()
+		txResult = &types.TxResult{
+			Tx: types.Tx("tx"),
+		}
+
+		hash = base64.StdEncoding.EncodeToString(txResult.Tx.Hash())
+	)
+
+	first := registry.add(hash)
+	second := registry.add(hash)
+
+	registry.notify(txResult)
+
+	// Both waiters are notified, once
+	assert.Equal(t, txResult, <-first)
+	assert.Equal(t, txResult, <-second)
+
+	registry.notify(txResult)
+
+	assert.Empty(t, first)
+	assert.Empty(t, second)
+}
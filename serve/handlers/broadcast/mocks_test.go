@@ -0,0 +1,36 @@
+package broadcast
+
+import (
+	"context"
+
+	core_types "github.com/gnolang/gno/tm2/pkg/bft/rpc/core/types"
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+)
+
+type broadcastTxSyncDelegate func(context.Context, types.Tx) (*core_types.ResultBroadcastTx, error)
+
+type mockClient struct {
+	broadcastTxSyncFn broadcastTxSyncDelegate
+}
+
+func (m *mockClient) BroadcastTxSync(ctx context.Context, tx types.Tx) (*core_types.ResultBroadcastTx, error) {
+	if m.broadcastTxSyncFn != nil {
+		return m.broadcastTxSyncFn(ctx, tx)
+	}
+
+	return nil, nil
+}
+
+type getTxHashDelegate func(string) (*types.TxResult, error)
+
+type mockStorage struct {
+	getTxHashFn getTxHashDelegate
+}
+
+func (m *mockStorage) GetTxByHash(h string) (*types.TxResult, error) {
+	if m.getTxHashFn != nil {
+		return m.getTxHashFn(h)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,31 @@
+package broadcast
+
+import (
+	"context"
+
+	core_types "github.com/gnolang/gno/tm2/pkg/bft/rpc/core/types"
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+
+	"github.com/gnolang/tx-indexer/events"
+)
+
+// Client is the TM2 client the transactions are relayed to
+type Client interface {
+	// BroadcastTxSync broadcasts the transaction to the node,
+	// returning the result of its mempool checks
+	BroadcastTxSync(context.Context, types.Tx) (*core_types.ResultBroadcastTx, error)
+}
+
+type Storage interface {
+	// GetTxByHash fetches the tx using the transaction hash
+	GetTxByHash(txHash string) (*types.TxResult, error)
+}
+
+// Events is the interface for event passing
+type Events interface {
+	// Subscribe subscribes to specific events
+	Subscribe([]events.Type) *events.Subscription
+
+	// CancelSubscription cancels the given subscription
+	CancelSubscription(events.SubscriptionID)
+}
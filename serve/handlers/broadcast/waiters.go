@@ -0,0 +1,109 @@
+package broadcast
+
+import (
+	"context"
+	"encoding/base64"
+	"slices"
+	"sync"
+
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+
+	"github.com/gnolang/tx-indexer/events"
+	commonTypes "github.com/gnolang/tx-indexer/types"
+)
+
+// waiter receives the transaction result, once it's indexed
+type waiter chan *types.TxResult
+
+// waiterRegistry keeps track of the clients waiting for
+// transactions to be indexed, and notifies each one exactly once
+type waiterRegistry struct {
+	waiters map[string][]waiter // tx hash (standard base64) -> waiters
+
+	mu sync.Mutex
+}
+
+func newWaiterRegistry() *waiterRegistry {
+	return &waiterRegistry{
+		waiters: make(map[string][]waiter),
+	}
+}
+
+// add registers a new waiter for the given transaction hash
+func (r *waiterRegistry) add(hash string) waiter {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// The channel is buffered, so notifying never blocks
+	w := make(waiter, 1)
+	r.waiters[hash] = append(r.waiters[hash], w)
+
+	return w
+}
+
+// remove unregisters the waiter, if it wasn't notified already
+func (r *waiterRegistry) remove(hash string, w waiter) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	waiters := slices.DeleteFunc(r.waiters[hash], func(registered waiter) bool {
+		return registered == w
+	})
+
+	if len(waiters) == 0 {
+		delete(r.waiters, hash)
+
+		return
+	}
+
+	r.waiters[hash] = waiters
+}
+
+// notify hands over the transaction result to its waiters,
+// unregistering them
+func (r *waiterRegistry) notify(txResult *types.TxResult) {
+	hash := base64.StdEncoding.EncodeToString(txResult.Tx.Hash())
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, w := range r.waiters[hash] {
+		w <- txResult
+	}
+
+	delete(r.waiters, hash)
+}
+
+// start subscribes to new blocks, and notifies the waiters
+// of their transactions until the context is done
+func (r *waiterRegistry) start(ctx context.Context, e Events) {
+	subscription := e.Subscribe([]events.Type{commonTypes.NewBlockEvent})
+
+	go r.run(ctx, e, subscription)
+}
+
+// run notifies the waiters of the transactions
+// in each new block, until the context is done
+func (r *waiterRegistry) run(ctx context.Context, e Events, subscription *events.Subscription) {
+	defer e.CancelSubscription(subscription.ID)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, more := <-subscription.SubCh:
+			if !more {
+				return
+			}
+
+			newBlock, ok := event.(*commonTypes.NewBlock)
+			if !ok {
+				continue
+			}
+
+			for _, txResult := range newBlock.Results {
+				r.notify(txResult)
+			}
+		}
+	}
+}
@@ -3,9 +3,7 @@ package gas
 import (
 	"fmt"
 	"strconv"
-
-	"github.com/gnolang/gno/tm2/pkg/bft/types"
-	"github.com/vektah/gqlparser/v2/gqlerror"
+	"time"
 
 	"github.com/gnolang/tx-indexer/serve/metadata"
 	"github.com/gnolang/tx-indexer/serve/methods"
@@ -54,36 +52,142 @@ func (h *Handler) GetGasPriceHandler(
 	return response, nil
 }
 
-func (h *Handler) getGasPriceBy(fromBlockNum, toBlockNum uint64) ([]*methods.GasPrice, error) {
-	it, err := h.
-		storage.
-		BlockIterator(
-			fromBlockNum,
-			toBlockNum,
-		)
+// SuggestGasPriceHandler suggests gas prices, per gas fee denomination,
+// based on the fees paid in the most recent blocks.
+// Params (all optional): the block window, the inclusion speed and the gas wanted
+func (h *Handler) SuggestGasPriceHandler(
+	_ *metadata.Metadata,
+	params []any,
+) (any, *spec.BaseJSONError) {
+	// Check the params
+	if len(params) > 3 {
+		return nil, spec.GenerateInvalidParamCountError()
+	}
+
+	var (
+		window    uint64
+		speed     methods.InclusionSpeed
+		gasWanted int64
+		err       error
+	)
+
+	if len(params) > 0 && params[0] != nil {
+		window, err = strconv.ParseUint(fmt.Sprintf("%v", params[0]), 10, 64)
+		if err != nil {
+			return nil, spec.GenerateInvalidParamError(1)
+		}
+	}
+
+	if len(params) > 1 && params[1] != nil {
+		speedParam, ok := params[1].(string)
+		if !ok {
+			return nil, spec.GenerateInvalidParamError(2)
+		}
+
+		if speed, err = methods.ParseInclusionSpeed(speedParam); err != nil {
+			return nil, spec.GenerateInvalidParamError(2)
+		}
+	}
+
+	if len(params) > 2 && params[2] != nil {
+		gasWanted, err = strconv.ParseInt(fmt.Sprintf("%v", params[2]), 10, 64)
+		if err != nil || gasWanted < 0 {
+			return nil, spec.GenerateInvalidParamError(3)
+		}
+	}
+
+	response, err := methods.SuggestGasPrice(h.storage, window, speed, gasWanted)
 	if err != nil {
-		return nil, gqlerror.Wrap(err)
+		return nil, spec.GenerateResponseError(err)
 	}
 
-	defer it.Close()
+	return response, nil
+}
+
+// GetGasStatsHandler returns the gas usage statistics of the calls made to a realm function.
+// Params: the package path, the function name and (optionally) the block window
+func (h *Handler) GetGasStatsHandler(
+	_ *metadata.Metadata,
+	params []any,
+) (any, *spec.BaseJSONError) {
+	// Check the params
+	if len(params) < 2 || len(params) > 3 {
+		return nil, spec.GenerateInvalidParamCountError()
+	}
 
-	blocks := make([]*types.Block, 0)
+	pkgPath, ok := params[0].(string)
+	if !ok || pkgPath == "" {
+		return nil, spec.GenerateInvalidParamError(1)
+	}
 
-	for it.Next() {
-		block, itErr := it.Value()
-		if itErr != nil {
-			return nil, err
+	fn, ok := params[1].(string)
+	if !ok || fn == "" {
+		return nil, spec.GenerateInvalidParamError(2)
+	}
+
+	var (
+		window uint64
+		err    error
+	)
+
+	if len(params) > 2 && params[2] != nil {
+		window, err = strconv.ParseUint(fmt.Sprintf("%v", params[2]), 10, 64)
+		if err != nil {
+			return nil, spec.GenerateInvalidParamError(3)
 		}
+	}
+
+	response, err := methods.GetGasStats(h.storage, pkgPath, fn, window)
+	if err != nil {
+		return nil, spec.GenerateResponseError(err)
+	}
+
+	return response, nil
+}
+
+// GetFeeHistoryHandler returns the fee series of the blocks within the given range,
+// bucketed by block time.
+// Params: the first block number, the last block number (0 for the latest block)
+// and the bucket resolution, in seconds
+func (h *Handler) GetFeeHistoryHandler(
+	_ *metadata.Metadata,
+	params []any,
+) (any, *spec.BaseJSONError) {
+	// Check the params
+	if len(params) != 3 {
+		return nil, spec.GenerateInvalidParamCountError()
+	}
+
+	fromBlockNum, err := strconv.ParseUint(fmt.Sprintf("%v", params[0]), 10, 64)
+	if err != nil {
+		return nil, spec.GenerateInvalidParamError(1)
+	}
+
+	toBlockNum, err := strconv.ParseUint(fmt.Sprintf("%v", params[1]), 10, 64)
+	if err != nil {
+		return nil, spec.GenerateInvalidParamError(2)
+	}
 
-		blocks = append(blocks, block)
+	resolution, err := strconv.ParseUint(fmt.Sprintf("%v", params[2]), 10, 32)
+	if err != nil || resolution == 0 {
+		return nil, spec.GenerateInvalidParamError(3)
 	}
 
-	gasPrices, err := methods.GetGasPricesByBlocks(blocks)
+	response, err := methods.GetFeeHistory(
+		h.storage,
+		fromBlockNum,
+		toBlockNum,
+		time.Duration(resolution)*time.Second,
+	)
 	if err != nil {
-		return nil, err
+		return nil, spec.GenerateResponseError(err)
 	}
 
-	return gasPrices, nil
+	return response, nil
+}
+
+func (h *Handler) getGasPriceBy(fromBlockNum, toBlockNum uint64) ([]*methods.GasPrice, error) {
+	return methods.GetGasPrices(h.storage, fromBlockNum, toBlockNum)
 }
 
 func initializeDefaultBlockRangeByHeight(latestHeight uint64) (uint64, uint64) {
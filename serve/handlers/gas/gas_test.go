@@ -2,8 +2,12 @@ package gas
 
 import (
 	"testing"
+	"time"
 
+	"github.com/gnolang/tx-indexer/serve/methods"
 	"github.com/gnolang/tx-indexer/serve/spec"
+	"github.com/gnolang/tx-indexer/storage"
+	indexerTypes "github.com/gnolang/tx-indexer/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -39,3 +43,321 @@ func TestGetGasPriceHandler_InvalidParams(t *testing.T) {
 		})
 	}
 }
+
+func TestSuggestGasPriceHandler_InvalidParams(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name   string
+		params []any
+	}{
+		{
+			"invalid param length",
+			[]any{1, "fast", 1, 1},
+		},
+		{
+			"invalid window",
+			[]any{"totally invalid window"},
+		},
+		{
+			"invalid speed type",
+			[]any{10, 1},
+		},
+		{
+			"invalid speed",
+			[]any{10, "instant"},
+		},
+		{
+			"invalid gas wanted",
+			[]any{10, "fast", -1},
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := NewHandler(&mockStorage{})
+
+			response, err := h.SuggestGasPriceHandler(nil, testCase.params)
+			assert.Nil(t, response)
+
+			require.NotNil(t, err)
+			assert.Equal(t, spec.InvalidParamsErrorCode, err.Code)
+		})
+	}
+}
+
+func TestSuggestGasPriceHandler_Window(t *testing.T) {
+	t.Parallel()
+
+	var (
+		from, to uint64
+
+		fees = []*indexerTypes.BlockFees{
+			{
+				Height: 95,
+				Txs: []indexerTypes.TxFee{
+					{Denom: "ugnot", Amount: 100, GasWanted: 100},
+					{Denom: "ugnot", Amount: 300, GasWanted: 100},
+				},
+			},
+		}
+
+		mockStorage = &mockStorage{
+			getLatestHeightFn: func() (uint64, error) {
+				return 100, nil
+			},
+			blockFeesIteratorFn: func(fromBlockNum, toBlockNum uint64) (storage.Iterator[*indexerTypes.BlockFees], error) {
+				from, to = fromBlockNum, toBlockNum
+
+				return &mockIterator[*indexerTypes.BlockFees]{values: fees}, nil
+			},
+		}
+	)
+
+	h := NewHandler(mockStorage)
+
+	response, err := h.SuggestGasPriceHandler(nil, []any{10, "fast", 1000})
+	require.Nil(t, err)
+
+	// Make sure only the most recent blocks are read
+	assert.Equal(t, uint64(91), from)
+	assert.Equal(t, uint64(100), to)
+
+	assert.Equal(t, []*methods.GasPriceSuggestion{
+		{
+			Denom:            "ugnot",
+			TxCount:          2,
+			P10:              1,
+			P50:              1,
+			P90:              3,
+			RecommendedPrice: 3,
+			RecommendedFee:   3000,
+		},
+	}, response)
+}
+
+func TestGetGasStatsHandler_InvalidParams(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name   string
+		params []any
+	}{
+		{
+			"invalid param length",
+			[]any{"gno.land/r/demo/users"},
+		},
+		{
+			"invalid package path",
+			[]any{1, "Register"},
+		},
+		{
+			"invalid function",
+			[]any{"gno.land/r/demo/users", ""},
+		},
+		{
+			"invalid window",
+			[]any{"gno.land/r/demo/users", "Register", "totally invalid window"},
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := NewHandler(&mockStorage{})
+
+			response, err := h.GetGasStatsHandler(nil, testCase.params)
+			assert.Nil(t, response)
+
+			require.NotNil(t, err)
+			assert.Equal(t, spec.InvalidParamsErrorCode, err.Code)
+		})
+	}
+}
+
+func TestGetGasStatsHandler_Window(t *testing.T) {
+	t.Parallel()
+
+	var (
+		pkgPath, fn string
+		from, to    uint64
+
+		stats = &indexerTypes.FuncGasStats{
+			PkgPath: "gno.land/r/demo/users",
+			Func:    "Register",
+			Height:  95,
+		}
+
+		mockStorage = &mockStorage{
+			getLatestHeightFn: func() (uint64, error) {
+				return 100, nil
+			},
+			funcGasStatsIteratorFn: func(
+				pkgPathParam,
+				fnParam string,
+				fromBlockNum,
+				toBlockNum uint64,
+			) (storage.Iterator[*indexerTypes.FuncGasStats], error) {
+				pkgPath, fn = pkgPathParam, fnParam
+				from, to = fromBlockNum, toBlockNum
+
+				return &mockIterator[*indexerTypes.FuncGasStats]{
+					values: []*indexerTypes.FuncGasStats{stats},
+				}, nil
+			},
+		}
+	)
+
+	stats.AddCall(2000, 1000, true)
+	stats.AddCall(2000, 1000, false)
+
+	h := NewHandler(mockStorage)
+
+	response, err := h.GetGasStatsHandler(nil, []any{"gno.land/r/demo/users", "Register", 10})
+	require.Nil(t, err)
+
+	// Make sure only the given function, within the most recent blocks, is read
+	assert.Equal(t, "gno.land/r/demo/users", pkgPath)
+	assert.Equal(t, "Register", fn)
+	assert.Equal(t, uint64(91), from)
+	assert.Equal(t, uint64(100), to)
+
+	gasStats, ok := response.(*methods.GasStats)
+	require.True(t, ok)
+
+	assert.Equal(t, uint64(2), gasStats.Calls)
+	assert.Equal(t, 0.5, gasStats.FailureRate)
+	assert.Equal(t, 2.0, gasStats.OverProvisioningRatio)
+}
+
+func TestGetGasPriceHandler_BlockFees(t *testing.T) {
+	t.Parallel()
+
+	var (
+		from, to uint64
+
+		fees = []*indexerTypes.BlockFees{
+			{
+				Height: 5,
+				Txs: []indexerTypes.TxFee{
+					{Denom: "ugnot", Amount: 1},
+					{Denom: "ugnot", Amount: 3},
+				},
+			},
+		}
+
+		mockStorage = &mockStorage{
+			blockFeesIteratorFn: func(fromBlockNum, toBlockNum uint64) (storage.Iterator[*indexerTypes.BlockFees], error) {
+				from, to = fromBlockNum, toBlockNum
+
+				return &mockIterator[*indexerTypes.BlockFees]{values: fees}, nil
+			},
+		}
+	)
+
+	h := NewHandler(mockStorage)
+
+	response, err := h.GetGasPriceHandler(nil, []any{1, 10})
+	require.Nil(t, err)
+
+	// Make sure the prices are computed using the block fee summaries
+	assert.Equal(t, uint64(1), from)
+	assert.Equal(t, uint64(10), to)
+
+	assert.Equal(t, []*methods.GasPrice{
+		{
+			Denom:   "ugnot",
+			Low:     1,
+			Average: 2,
+			High:    3,
+		},
+	}, response)
+}
+
+func TestGetFeeHistoryHandler_InvalidParams(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name   string
+		params []any
+	}{
+		{
+			"invalid param length",
+			[]any{1, 10},
+		},
+		{
+			"invalid from block",
+			[]any{"totally invalid block", 10, 60},
+		},
+		{
+			"invalid to block",
+			[]any{1, -1, 60},
+		},
+		{
+			"invalid resolution",
+			[]any{1, 10, 0},
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := NewHandler(&mockStorage{})
+
+			response, err := h.GetFeeHistoryHandler(nil, testCase.params)
+			assert.Nil(t, response)
+
+			require.NotNil(t, err)
+			assert.Equal(t, spec.InvalidParamsErrorCode, err.Code)
+		})
+	}
+}
+
+func TestGetFeeHistoryHandler_LatestBlock(t *testing.T) {
+	t.Parallel()
+
+	var (
+		from, to uint64
+
+		fees = []*indexerTypes.BlockFees{
+			{
+				Height: 95,
+				Time:   time.Unix(120, 0),
+				Txs: []indexerTypes.TxFee{
+					{Denom: "ugnot", Amount: 10, GasWanted: 10},
+				},
+			},
+		}
+
+		mockStorage = &mockStorage{
+			getLatestHeightFn: func() (uint64, error) {
+				return 100, nil
+			},
+			blockFeesIteratorFn: func(fromBlockNum, toBlockNum uint64) (storage.Iterator[*indexerTypes.BlockFees], error) {
+				from, to = fromBlockNum, toBlockNum
+
+				return &mockIterator[*indexerTypes.BlockFees]{values: fees}, nil
+			},
+		}
+	)
+
+	h := NewHandler(mockStorage)
+
+	response, err := h.GetFeeHistoryHandler(nil, []any{90, 0, 60})
+	require.Nil(t, err)
+
+	// Make sure the range ends at the latest block
+	assert.Equal(t, uint64(90), from)
+	assert.Equal(t, uint64(100), to)
+
+	buckets, ok := response.([]*methods.FeeHistoryBucket)
+	require.True(t, ok)
+	require.Len(t, buckets, 1)
+
+	assert.Equal(t, time.Unix(120, 0).UTC(), buckets[0].Start)
+	assert.Equal(t, int64(1), buckets[0].TxCount)
+}
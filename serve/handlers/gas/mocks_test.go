@@ -1,17 +1,20 @@
 package gas
 
 import (
-	"github.com/gnolang/gno/tm2/pkg/bft/types"
 	"github.com/gnolang/tx-indexer/storage"
+	indexerTypes "github.com/gnolang/tx-indexer/types"
 )
 
 type getLatestHeight func() (uint64, error)
 
-type blockIterator func(uint64, uint64) (storage.Iterator[*types.Block], error)
+type blockFeesIterator func(uint64, uint64) (storage.Iterator[*indexerTypes.BlockFees], error)
+
+type funcGasStatsIterator func(string, string, uint64, uint64) (storage.Iterator[*indexerTypes.FuncGasStats], error)
 
 type mockStorage struct {
-	getLatestHeightFn getLatestHeight
-	blockIteratorFn   blockIterator
+	getLatestHeightFn      getLatestHeight
+	blockFeesIteratorFn    blockFeesIterator
+	funcGasStatsIteratorFn funcGasStatsIterator
 }
 
 func (m *mockStorage) GetLatestHeight() (uint64, error) {
@@ -22,13 +25,54 @@ func (m *mockStorage) GetLatestHeight() (uint64, error) {
 	return 0, nil
 }
 
-func (m *mockStorage) BlockIterator(
+func (m *mockStorage) BlockFeesIterator(
+	fromBlockNum,
+	toBlockNum uint64,
+) (storage.Iterator[*indexerTypes.BlockFees], error) {
+	if m.blockFeesIteratorFn != nil {
+		return m.blockFeesIteratorFn(fromBlockNum, toBlockNum)
+	}
+
+	return &mockIterator[*indexerTypes.BlockFees]{}, nil
+}
+
+func (m *mockStorage) FuncGasStatsIterator(
+	pkgPath,
+	fn string,
 	fromBlockNum,
 	toBlockNum uint64,
-) (storage.Iterator[*types.Block], error) {
-	if m.blockIteratorFn != nil {
-		return m.blockIteratorFn(fromBlockNum, toBlockNum)
+) (storage.Iterator[*indexerTypes.FuncGasStats], error) {
+	if m.funcGasStatsIteratorFn != nil {
+		return m.funcGasStatsIteratorFn(pkgPath, fn, fromBlockNum, toBlockNum)
+	}
+
+	return &mockIterator[*indexerTypes.FuncGasStats]{}, nil
+}
+
+// mockIterator iterates over the given values
+type mockIterator[T any] struct {
+	values []T
+	index  int
+}
+
+func (m *mockIterator[T]) Next() bool {
+	if m.index >= len(m.values) {
+		return false
 	}
 
-	return nil, nil
+	m.index++
+
+	return true
+}
+
+func (m *mockIterator[T]) Error() error {
+	return nil
+}
+
+func (m *mockIterator[T]) Value() (T, error) {
+	return m.values[m.index-1], nil
+}
+
+func (m *mockIterator[T]) Close() error {
+	return nil
 }
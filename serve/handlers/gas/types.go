@@ -1,14 +1,24 @@
 package gas
 
 import (
-	"github.com/gnolang/gno/tm2/pkg/bft/types"
 	"github.com/gnolang/tx-indexer/storage"
+	indexerTypes "github.com/gnolang/tx-indexer/types"
 )
 
 type Storage interface {
 	// GetLatestHeight returns the latest block height from the storage
 	GetLatestHeight() (uint64, error)
 
-	// BlockIterator iterates over Blocks, limiting the results to be between the provided block numbers
-	BlockIterator(fromBlockNum, toBlockNum uint64) (storage.Iterator[*types.Block], error)
+	// BlockFeesIterator iterates over the block fee summaries,
+	// limiting the results to be between the provided block numbers
+	BlockFeesIterator(fromBlockNum, toBlockNum uint64) (storage.Iterator[*indexerTypes.BlockFees], error)
+
+	// FuncGasStatsIterator iterates over the per-block gas usage summaries of the given realm function,
+	// limiting the results to be between the provided block numbers
+	FuncGasStatsIterator(
+		pkgPath,
+		fn string,
+		fromBlockNum,
+		toBlockNum uint64,
+	) (storage.Iterator[*indexerTypes.FuncGasStats], error)
 }
@@ -0,0 +1,82 @@
+package lookup
+
+import (
+	"github.com/gnolang/tx-indexer/search"
+	"github.com/gnolang/tx-indexer/serve/encode"
+	"github.com/gnolang/tx-indexer/serve/metadata"
+	"github.com/gnolang/tx-indexer/serve/spec"
+)
+
+type Handler struct {
+	storage Storage
+}
+
+func NewHandler(storage Storage) *Handler {
+	return &Handler{
+		storage: storage,
+	}
+}
+
+func (h *Handler) SearchHandler(
+	_ *metadata.Metadata,
+	params []any,
+) (any, *spec.BaseJSONError) {
+	// Check the params
+	if len(params) != 1 {
+		return nil, spec.GenerateInvalidParamCountError()
+	}
+
+	// Extract the params
+	term, ok := params[0].(string)
+	if !ok {
+		return nil, spec.GenerateInvalidParamError(1)
+	}
+
+	// Run the handler
+	matches, err := search.Lookup(h.storage, term)
+	if err != nil {
+		return nil, spec.GenerateResponseError(err)
+	}
+
+	results := make([]Result, 0, len(matches))
+
+	for _, match := range matches {
+		result, err := encodeMatch(match)
+		if err != nil {
+			return nil, spec.GenerateResponseError(err)
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// encodeMatch encodes the lookup candidate, along with its type
+func encodeMatch(match *search.Match) (Result, error) {
+	var (
+		resultType string
+		value      any
+	)
+
+	switch {
+	case match.Block != nil:
+		resultType, value = typeBlock, match.Block
+	case match.Tx != nil:
+		resultType, value = typeTransaction, match.Tx
+	case match.Account != nil:
+		resultType, value = typeAccount, match.Account
+	default:
+		resultType, value = typePackage, match.Package
+	}
+
+	encodedValue, err := encode.PrepareValue(value)
+	if err != nil {
+		return Result{}, err
+	}
+
+	return Result{
+		Type:  resultType,
+		Value: encodedValue,
+	}, nil
+}
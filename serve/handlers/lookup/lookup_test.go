@@ -0,0 +1,128 @@
+package lookup
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/tx-indexer/serve/spec"
+	indexerTypes "github.com/gnolang/tx-indexer/types"
+)
+
+func TestSearch_InvalidParams(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name   string
+		params []any
+	}{
+		{
+			"invalid param length",
+			[]any{1, 2},
+		},
+		{
+			"invalid param type",
+			[]any{1},
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := NewHandler(&mockStorage{})
+
+			response, err := h.SearchHandler(nil, testCase.params)
+			assert.Nil(t, response)
+
+			require.NotNil(t, err)
+
+			assert.Equal(t, spec.InvalidParamsErrorCode, err.Code)
+		})
+	}
+}
+
+func TestSearch_Handler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no matches", func(t *testing.T) {
+		t.Parallel()
+
+		h := NewHandler(&mockStorage{})
+
+		response, err := h.SearchHandler(nil, []any{"unknown"})
+		require.Nil(t, err)
+
+		assert.Equal(t, []Result{}, response)
+	})
+
+	t.Run("random fetch error", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			fetchErr = errors.New("random error")
+
+			mockStorage = &mockStorage{
+				getBlockFn: func(_ uint64) (*types.Block, error) {
+					return nil, fetchErr
+				},
+			}
+		)
+
+		h := NewHandler(mockStorage)
+
+		response, err := h.SearchHandler(nil, []any{"10"})
+		assert.Nil(t, response)
+
+		// Make sure the error is populated
+		require.NotNil(t, err)
+
+		assert.Equal(t, spec.ServerErrorCode, err.Code)
+		assert.ErrorContains(t, errors.New(err.Message), fetchErr.Error())
+	})
+
+	t.Run("package found", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			pkg = &indexerTypes.Package{
+				Path: "gno.land/r/demo/users",
+				Name: "users",
+			}
+
+			mockStorage = &mockStorage{
+				getPackageFn: func(path string) (*indexerTypes.Package, error) {
+					require.Equal(t, pkg.Path, path)
+
+					return pkg, nil
+				},
+			}
+		)
+
+		h := NewHandler(mockStorage)
+
+		responseRaw, err := h.SearchHandler(nil, []any{pkg.Path})
+		require.Nil(t, err)
+
+		response, ok := responseRaw.([]Result)
+		require.True(t, ok)
+		require.Len(t, response, 1)
+
+		assert.Equal(t, typePackage, response[0].Type)
+
+		// Make sure the value is valid (base64 + amino)
+		encodedPackage, decodeErr := base64.StdEncoding.DecodeString(response[0].Value)
+		require.Nil(t, decodeErr)
+
+		var decodedPackage indexerTypes.Package
+
+		require.NoError(t, amino.Unmarshal(encodedPackage, &decodedPackage))
+
+		assert.Equal(t, pkg, &decodedPackage)
+	})
+}
@@ -0,0 +1,79 @@
+package lookup
+
+import (
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+
+	"github.com/gnolang/tx-indexer/storage"
+	storageErrors "github.com/gnolang/tx-indexer/storage/errors"
+	indexerTypes "github.com/gnolang/tx-indexer/types"
+)
+
+type getBlockDelegate func(uint64) (*types.Block, error)
+
+type getTxHashDelegate func(string) (*types.TxResult, error)
+
+type getAccountDelegate func(string) (*indexerTypes.Account, error)
+
+type getPackageDelegate func(string) (*indexerTypes.Package, error)
+
+type mockStorage struct {
+	getBlockFn   getBlockDelegate
+	getTxHashFn  getTxHashDelegate
+	getAccountFn getAccountDelegate
+	getPackageFn getPackageDelegate
+}
+
+func (m *mockStorage) GetBlock(bn uint64) (*types.Block, error) {
+	if m.getBlockFn != nil {
+		return m.getBlockFn(bn)
+	}
+
+	return nil, storageErrors.ErrNotFound
+}
+
+func (m *mockStorage) BlockReverseIterator(_, _ uint64) (storage.Iterator[*types.Block], error) {
+	return &mockBlockIterator{}, nil
+}
+
+func (m *mockStorage) GetTxByHash(h string) (*types.TxResult, error) {
+	if m.getTxHashFn != nil {
+		return m.getTxHashFn(h)
+	}
+
+	return nil, storageErrors.ErrNotFound
+}
+
+func (m *mockStorage) GetAccount(address string) (*indexerTypes.Account, error) {
+	if m.getAccountFn != nil {
+		return m.getAccountFn(address)
+	}
+
+	return nil, storageErrors.ErrNotFound
+}
+
+func (m *mockStorage) GetPackage(path string) (*indexerTypes.Package, error) {
+	if m.getPackageFn != nil {
+		return m.getPackageFn(path)
+	}
+
+	return nil, storageErrors.ErrNotFound
+}
+
+// mockBlockIterator is an empty block iterator
+type mockBlockIterator struct{}
+
+func (m *mockBlockIterator) Next() bool {
+	return false
+}
+
+func (m *mockBlockIterator) Error() error {
+	return nil
+}
+
+func (m *mockBlockIterator) Value() (*types.Block, error) {
+	return nil, storageErrors.ErrNotFound
+}
+
+func (m *mockBlockIterator) Close() error {
+	return nil
+}
@@ -0,0 +1,25 @@
+package lookup
+
+import (
+	"github.com/gnolang/tx-indexer/search"
+)
+
+type Storage interface {
+	search.LookupStorage
+}
+
+// Result is a single search candidate
+type Result struct {
+	// Type is the candidate type (block, transaction, account or package)
+	Type string `json:"type"`
+
+	// Value is the Amino binary encoded candidate, in base64
+	Value string `json:"value"`
+}
+
+const (
+	typeBlock       = "block"
+	typeTransaction = "transaction"
+	typeAccount     = "account"
+	typePackage     = "package"
+)
@@ -29,7 +29,7 @@ func NewHandler(
 
 // NewBlockFilterHandler creates a block filter object
 func (h *Handler) NewBlockFilterHandler(
-	_ *metadata.Metadata,
+	metadata *metadata.Metadata,
 	params []any,
 ) (any, *spec.BaseJSONError) {
 	// Check the params
@@ -37,16 +37,21 @@ func (h *Handler) NewBlockFilterHandler(
 		return nil, spec.GenerateInvalidParamCountError()
 	}
 
-	return h.newBlockFilter(), nil
+	id, err := h.newBlockFilter(clientID(metadata))
+	if err != nil {
+		return nil, spec.GenerateResponseError(err)
+	}
+
+	return id, nil
 }
 
-func (h *Handler) newBlockFilter() string {
-	return h.filterManager.NewBlockFilter()
+func (h *Handler) newBlockFilter(clientID string) (string, error) {
+	return h.filterManager.NewBlockFilter(clientID)
 }
 
 // NewTransactionFilterHandler creates a transaction filter object
 func (h *Handler) NewTransactionFilterHandler(
-	_ *metadata.Metadata,
+	metadata *metadata.Metadata,
 	params []any,
 ) (any, *spec.BaseJSONError) {
 	// Check the params
@@ -56,16 +61,64 @@ func (h *Handler) NewTransactionFilterHandler(
 
 	var options filter.TxFilterOption
 
-	err := spec.ParseObjectParameter(params[0], &options)
+	if err := spec.ParseStrictObjectParameter(params[0], &options); err != nil {
+		return nil, spec.NewJSONError(
+			fmt.Sprintf("invalid filter options, %s", err.Error()),
+			spec.InvalidParamsErrorCode,
+		)
+	}
+
+	if err := options.Validate(); err != nil {
+		return nil, spec.NewJSONError(
+			fmt.Sprintf("invalid filter options, %s", err.Error()),
+			spec.InvalidParamsErrorCode,
+		)
+	}
+
+	id, err := h.newTxFilter(clientID(metadata), options)
 	if err != nil {
-		return nil, spec.GenerateInvalidParamError(1)
+		return nil, spec.GenerateResponseError(err)
 	}
 
-	return h.newTxFilter(options), nil
+	return id, nil
 }
 
-func (h *Handler) newTxFilter(options filter.TxFilterOption) string {
-	return h.filterManager.NewTxFilter(options)
+func (h *Handler) newTxFilter(clientID string, options filter.TxFilterOption) (string, error) {
+	return h.filterManager.NewTxFilter(clientID, options)
+}
+
+// NewEventFilterHandler creates a Gno event filter object
+func (h *Handler) NewEventFilterHandler(
+	metadata *metadata.Metadata,
+	params []any,
+) (any, *spec.BaseJSONError) {
+	// Check the params
+	if len(params) != 1 {
+		return nil, spec.GenerateInvalidParamCountError()
+	}
+
+	var options filter.EventFilterOption
+
+	if err := spec.ParseStrictObjectParameter(params[0], &options); err != nil {
+		return nil, spec.NewJSONError(
+			fmt.Sprintf("invalid filter options, %s", err.Error()),
+			spec.InvalidParamsErrorCode,
+		)
+	}
+
+	if err := options.Validate(); err != nil {
+		return nil, spec.NewJSONError(
+			fmt.Sprintf("invalid filter options, %s", err.Error()),
+			spec.InvalidParamsErrorCode,
+		)
+	}
+
+	id, err := h.filterManager.NewEventFilter(clientID(metadata), options)
+	if err != nil {
+		return nil, spec.GenerateResponseError(err)
+	}
+
+	return id, nil
 }
 
 // UninstallFilterHandler uninstalls a filter with given id
@@ -104,7 +157,7 @@ func (h *Handler) SubscribeHandler(
 	}
 
 	// Check the params
-	if len(params) == 0 {
+	if len(params) == 0 || len(params) > 2 {
 		return nil, spec.GenerateInvalidParamCountError()
 	}
 
@@ -114,7 +167,31 @@ func (h *Handler) SubscribeHandler(
 		return nil, spec.GenerateInvalidParamError(1)
 	}
 
-	subscriptionID, err := h.subscribe(*metadata.WebSocketID, eventType)
+	// Extract the optional filter
+	var subscriptionFilter *filter.SubscriptionFilter
+
+	if len(params) > 1 && params[1] != nil {
+		var options filter.SubscriptionFilterOption
+
+		if err := spec.ParseStrictObjectParameter(params[1], &options); err != nil {
+			return nil, spec.NewJSONError(
+				fmt.Sprintf("invalid filter options, %s", err.Error()),
+				spec.InvalidParamsErrorCode,
+			)
+		}
+
+		parsedFilter, err := filter.NewSubscriptionFilter(options)
+		if err != nil {
+			return nil, spec.NewJSONError(
+				fmt.Sprintf("invalid filter options, %s", err.Error()),
+				spec.InvalidParamsErrorCode,
+			)
+		}
+
+		subscriptionFilter = parsedFilter
+	}
+
+	subscriptionID, err := h.subscribe(*metadata.WebSocketID, eventType, subscriptionFilter)
 	if err != nil {
 		return nil, spec.NewJSONError(
 			fmt.Sprintf("unable to subscribe, %s", err.Error()),
@@ -125,17 +202,30 @@ func (h *Handler) SubscribeHandler(
 	return subscriptionID, nil
 }
 
-func (h *Handler) subscribe(connID, eventType string) (string, error) {
+func (h *Handler) subscribe(
+	connID,
+	eventType string,
+	subscriptionFilter *filter.SubscriptionFilter,
+) (string, error) {
 	conn := h.connFetcher.GetWSConnection(connID)
 	if conn == nil {
 		return "", fmt.Errorf("WS connection with ID %s not found", connID)
 	}
 
+	switch eventType {
+	case subscription.NewHeadsEvent, subscription.NewGasPriceEvent:
+		if subscriptionFilter != nil {
+			return "", fmt.Errorf("filters are not supported for %s subscriptions", eventType)
+		}
+	}
+
 	switch eventType {
 	case subscription.NewHeadsEvent:
 		return h.filterManager.NewBlockSubscription(conn), nil
 	case subscription.NewTransactionsEvent:
-		return h.filterManager.NewTransactionSubscription(conn), nil
+		return h.filterManager.NewTransactionSubscription(conn, subscriptionFilter), nil
+	case subscription.NewEventsEvent:
+		return h.filterManager.NewEventSubscription(conn, subscriptionFilter), nil
 	case subscription.NewGasPriceEvent:
 		return h.filterManager.NewGasPriceSubscription(conn), nil
 	default:
@@ -193,7 +283,15 @@ func (h *Handler) GetFilterChangesHandler(_ *metadata.Metadata, params []any) (a
 	}
 
 	// Handle filter changes
-	changes := f.GetChanges()
+	changes, err := f.GetChanges()
+	if err != nil {
+		return nil, spec.GenerateResponseError(err)
+	}
+
+	// Events are returned as JSON objects
+	if f.GetType() == filter.EventFilterType {
+		return changes, nil
+	}
 
 	results := make([]string, len(changes))
 
@@ -208,3 +306,34 @@ func (h *Handler) GetFilterChangesHandler(_ *metadata.Metadata, params []any) (a
 
 	return results, nil
 }
+
+// GetFilterLogsHandler returns all the events matching
+// the specified event filter, within its block range
+func (h *Handler) GetFilterLogsHandler(_ *metadata.Metadata, params []any) (any, *spec.BaseJSONError) {
+	// Check the params
+	if len(params) != 1 {
+		return nil, spec.GenerateInvalidParamCountError()
+	}
+
+	// Extract the params
+	id, ok := params[0].(string)
+	if !ok {
+		return nil, spec.GenerateInvalidParamError(1)
+	}
+
+	events, err := h.filterManager.GetFilterLogs(id)
+	if err != nil {
+		return nil, spec.GenerateResponseError(err)
+	}
+
+	return events, nil
+}
+
+// clientID returns the ID of the client owning the created filters
+func clientID(metadata *metadata.Metadata) string {
+	if metadata == nil {
+		return ""
+	}
+
+	return metadata.ClientIP()
+}
@@ -8,7 +8,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gnolang/gno/gnovm/stdlibs/chain"
 	"github.com/gnolang/gno/tm2/pkg/amino"
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
 	"github.com/gnolang/gno/tm2/pkg/bft/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -20,6 +22,7 @@ import (
 	"github.com/gnolang/tx-indexer/serve/filters/filter"
 	"github.com/gnolang/tx-indexer/serve/filters/subscription"
 	"github.com/gnolang/tx-indexer/serve/metadata"
+	"github.com/gnolang/tx-indexer/serve/methods"
 	"github.com/gnolang/tx-indexer/serve/spec"
 	indexerTypes "github.com/gnolang/tx-indexer/types"
 )
@@ -90,6 +93,332 @@ func TestNewBlockFilter_Valid(t *testing.T) {
 	assert.Equal(t, filter.BlockFilterType, ft.GetType())
 }
 
+func TestNewTransactionFilter_InvalidParams(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name   string
+		errMsg string
+		params []any
+	}{
+		{
+			"invalid param length",
+			"Invalid number of parameters",
+			[]any{},
+		},
+		{
+			"unknown option",
+			`unknown field "gasUse"`,
+			[]any{
+				map[string]any{
+					"gasUse": map[string]any{"min": 1},
+				},
+			},
+		},
+		{
+			"invalid range",
+			"range min is greater than max for gasUsed (10 > 1)",
+			[]any{
+				map[string]any{
+					"gasUsed": map[string]any{"min": 10, "max": 1},
+				},
+			},
+		},
+		{
+			"invalid address",
+			`invalid addresses[0] "g1invalid"`,
+			[]any{
+				map[string]any{
+					"addresses": []string{"g1invalid"},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := NewHandler(nil, nil)
+
+			response, err := h.NewTransactionFilterHandler(nil, testCase.params)
+			assert.Nil(t, response)
+
+			require.NotNil(t, err)
+
+			assert.Equal(t, spec.InvalidParamsErrorCode, err.Code)
+			assert.Contains(t, err.Message, testCase.errMsg)
+		})
+	}
+}
+
+func TestNewTransactionFilter_Valid(t *testing.T) {
+	t.Parallel()
+
+	fm := filters.NewFilterManager(
+		context.Background(),
+		&mock.Storage{},
+		&mock.Events{
+			SubscribeFn: func(_ []events.Type) *events.Subscription {
+				return &events.Subscription{}
+			},
+		},
+	)
+
+	h := NewHandler(fm, nil)
+
+	responseRaw, err := h.NewTransactionFilterHandler(nil, []any{
+		map[string]any{
+			"success": true,
+			"memo":    map[string]any{"like": "^transfer"},
+			"messages": map[string]any{
+				"value": map[string]any{
+					"MsgCall": map[string]any{
+						"pkg_path": map[string]any{"eq": "gno.land/r/demo/foo20"},
+					},
+				},
+			},
+		},
+	})
+	require.Nil(t, err)
+
+	response, ok := responseRaw.(string)
+	require.True(t, ok)
+
+	// Make sure the filter exists
+	ft, filterErr := fm.GetFilter(response)
+	require.Nil(t, filterErr)
+
+	assert.Equal(t, filter.TxFilterType, ft.GetType())
+}
+
+func TestNewEventFilter_InvalidParams(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name   string
+		errMsg string
+		params []any
+	}{
+		{
+			"invalid param length",
+			"Invalid number of parameters",
+			[]any{},
+		},
+		{
+			"unknown option",
+			`unknown field "pkg_path"`,
+			[]any{
+				map[string]any{
+					"pkg_path": "gno.land/r/demo/foo20",
+				},
+			},
+		},
+		{
+			"invalid block range",
+			"fromBlock is greater than toBlock (10 > 1)",
+			[]any{
+				map[string]any{
+					"fromBlock": 10,
+					"toBlock":   1,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := NewHandler(nil, nil)
+
+			response, err := h.NewEventFilterHandler(nil, testCase.params)
+			assert.Nil(t, response)
+
+			require.NotNil(t, err)
+
+			assert.Equal(t, spec.InvalidParamsErrorCode, err.Code)
+			assert.Contains(t, err.Message, testCase.errMsg)
+		})
+	}
+}
+
+func TestEventFilter_GetFilterChanges(t *testing.T) {
+	t.Parallel()
+
+	var (
+		eventsCh = make(chan events.Event)
+
+		mockEvents = &mock.Events{
+			SubscribeFn: func(_ []events.Type) *events.Subscription {
+				return &events.Subscription{
+					ID:    events.SubscriptionID(1),
+					SubCh: eventsCh,
+				}
+			},
+		}
+
+		txResult = &types.TxResult{
+			Height: 10,
+			Tx:     []byte("tx"),
+			Response: abci.ResponseDeliverTx{
+				ResponseBase: abci.ResponseBase{
+					Events: []abci.Event{
+						chain.Event{
+							Type:    "Transfer",
+							PkgPath: "gno.land/r/demo/foo20",
+							Attributes: []chain.EventAttribute{
+								{Key: "to", Value: "alice"},
+							},
+						},
+						chain.Event{
+							Type:    "Transfer",
+							PkgPath: "gno.land/r/demo/foo20",
+							Attributes: []chain.EventAttribute{
+								{Key: "to", Value: "bob"},
+							},
+						},
+					},
+				},
+			},
+		}
+	)
+
+	fm := filters.NewFilterManager(
+		context.Background(),
+		&mock.Storage{},
+		mockEvents,
+	)
+
+	h := NewHandler(fm, nil)
+
+	responseRaw, filterErr := h.NewEventFilterHandler(nil, []any{
+		map[string]any{
+			"pkgPath": "gno.land/r/demo/foo20",
+			"attrs": []map[string]any{
+				{"key": "to", "value": "bob"},
+			},
+		},
+	})
+	require.Nil(t, filterErr)
+
+	id, ok := responseRaw.(string)
+	require.True(t, ok)
+
+	for height := int64(10); height <= 11; height++ {
+		block := &indexerTypes.NewBlock{
+			Block: &types.Block{Header: types.Header{Height: height}},
+		}
+
+		if height == txResult.Height {
+			block.Results = []*types.TxResult{txResult}
+		}
+
+		// The block is processed in full before the next one is received
+		select {
+		case eventsCh <- block:
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out")
+		}
+	}
+
+	changesRaw, changesErr := h.GetFilterChangesHandler(nil, []any{id})
+	require.Nil(t, changesErr)
+
+	changes, ok := changesRaw.([]any)
+	require.True(t, ok)
+	require.Len(t, changes, 1)
+
+	event, ok := changes[0].(*methods.Event)
+	require.True(t, ok)
+
+	assert.Equal(t, uint32(1), event.EventIndex)
+	assert.Equal(t, "bob", event.Attrs[0].Value)
+}
+
+func TestGetFilterLogs_InvalidParams(t *testing.T) {
+	t.Parallel()
+
+	fm := filters.NewFilterManager(
+		context.Background(),
+		&mock.Storage{
+			GetLatestSavedHeightFn: func() (uint64, error) {
+				return filters.MaxFilterLogsRange, nil
+			},
+		},
+		&mock.Events{
+			SubscribeFn: func(_ []events.Type) *events.Subscription {
+				return &events.Subscription{}
+			},
+		},
+	)
+
+	blockFilterID, filterErr := fm.NewBlockFilter("")
+	require.NoError(t, filterErr)
+
+	var genesisBlock uint64
+
+	// The filter spans one block more than allowed
+	eventFilterID, filterErr := fm.NewEventFilter("", filter.EventFilterOption{
+		FromBlock: &genesisBlock,
+	})
+	require.NoError(t, filterErr)
+
+	testTable := []struct {
+		name   string
+		errMsg string
+		params []any
+		code   int
+	}{
+		{
+			"invalid param length",
+			"Invalid number of parameters",
+			[]any{},
+			spec.InvalidParamsErrorCode,
+		},
+		{
+			"invalid param type",
+			"Invalid 1st parameter",
+			[]any{10},
+			spec.InvalidParamsErrorCode,
+		},
+		{
+			"filter not found",
+			filters.ErrFilterNotFound.Error(),
+			[]any{"unknown"},
+			spec.ServerErrorCode,
+		},
+		{
+			"not an event filter",
+			filters.ErrInvalidFilterType.Error(),
+			[]any{blockFilterID},
+			spec.ServerErrorCode,
+		},
+		{
+			"block range too large",
+			fmt.Sprintf("block range too large (0 - %d)", filters.MaxFilterLogsRange),
+			[]any{eventFilterID},
+			spec.ServerErrorCode,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := NewHandler(fm, nil)
+
+			response, err := h.GetFilterLogsHandler(nil, testCase.params)
+			assert.Nil(t, response)
+
+			require.NotNil(t, err)
+
+			assert.Equal(t, testCase.code, err.Code)
+			assert.Contains(t, err.Message, testCase.errMsg)
+		})
+	}
+}
+
 func TestUninstallFilter_InvalidParams(t *testing.T) {
 	t.Parallel()
 
@@ -376,6 +705,142 @@ func TestSubscribe_InvalidParams(t *testing.T) {
 		assert.Equal(t, spec.ServerErrorCode, err.Code)
 		assert.Contains(t, err.Message, fmt.Sprintf("invalid event type: %s", eventType))
 	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			id = "connection ID"
+
+			metadata = &metadata.Metadata{
+				WebSocketID: &id,
+			}
+		)
+
+		h := NewHandler(nil, &mockConnectionFetcher{})
+
+		response, err := h.SubscribeHandler(
+			metadata,
+			[]any{
+				subscription.NewTransactionsEvent,
+				map[string]any{
+					"gasUsed": map[string]any{
+						"min": 10,
+						"max": 1,
+					},
+				},
+			},
+		)
+		assert.Nil(t, response)
+
+		// Check the error
+		require.NotNil(t, err)
+
+		assert.Equal(t, spec.InvalidParamsErrorCode, err.Code)
+		assert.Contains(t, err.Message, "invalid filter")
+	})
+
+	t.Run("invalid filter options", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			id = "connection ID"
+
+			metadata = &metadata.Metadata{
+				WebSocketID: &id,
+			}
+		)
+
+		testTable := []struct {
+			options  map[string]any
+			name     string
+			expected string
+		}{
+			{
+				map[string]any{
+					"package": "gno.land/r/demo/foo20",
+				},
+				"misspelled field",
+				`invalid filter options, json: unknown field "package"`,
+			},
+			{
+				map[string]any{
+					"success": "yes",
+				},
+				"invalid field type",
+				"invalid filter options, json: cannot unmarshal string",
+			},
+			{
+				map[string]any{
+					"address": "invalid",
+				},
+				"invalid address",
+				"invalid filter options, invalid address",
+			},
+		}
+
+		for _, testCase := range testTable {
+			t.Run(testCase.name, func(t *testing.T) {
+				t.Parallel()
+
+				h := NewHandler(nil, &mockConnectionFetcher{})
+
+				response, err := h.SubscribeHandler(
+					metadata,
+					[]any{
+						subscription.NewTransactionsEvent,
+						testCase.options,
+					},
+				)
+				assert.Nil(t, response)
+
+				// Check the error
+				require.NotNil(t, err)
+
+				assert.Equal(t, spec.InvalidParamsErrorCode, err.Code)
+				assert.Contains(t, err.Message, testCase.expected)
+			})
+		}
+	})
+
+	t.Run("filter not supported", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			id = "connection ID"
+
+			metadata = &metadata.Metadata{
+				WebSocketID: &id,
+			}
+
+			connFetcher = &mockConnectionFetcher{
+				getWSConnectionFn: func(wsID string) conns.WSConnection {
+					require.Equal(t, id, wsID)
+
+					return &mock.Conn{} // connection found
+				},
+			}
+		)
+
+		h := NewHandler(nil, connFetcher)
+
+		response, err := h.SubscribeHandler(
+			metadata,
+			[]any{
+				subscription.NewHeadsEvent,
+				map[string]any{
+					"pkgPath": "gno.land/r/demo/foo20",
+				},
+			},
+		)
+		assert.Nil(t, response)
+
+		// Check the error
+		require.NotNil(t, err)
+
+		assert.Equal(t, spec.ServerErrorCode, err.Code)
+		assert.Contains(t, err.Message, "filters are not supported")
+	})
 }
 
 func TestSubscribe_Valid(t *testing.T) {
@@ -499,6 +964,117 @@ func TestSubscribe_Valid(t *testing.T) {
 	}
 }
 
+func TestSubscribe_FilteredEvents(t *testing.T) {
+	t.Parallel()
+
+	var (
+		wg sync.WaitGroup
+
+		eventsCh = make(chan events.Event)
+
+		connID   = "connection ID"
+		metadata = &metadata.Metadata{
+			WebSocketID: &connID,
+		}
+
+		mockEvents = &mock.Events{
+			SubscribeFn: func(_ []events.Type) *events.Subscription {
+				return &events.Subscription{
+					ID:    events.SubscriptionID(1),
+					SubCh: eventsCh,
+				}
+			},
+		}
+
+		writtenData = make([]any, 0)
+		mockConn    = &mock.Conn{
+			WriteDataFn: func(data any) error {
+				defer wg.Done()
+				writtenData = append(writtenData, data)
+
+				return nil
+			},
+		}
+		mockConnFetcher = &mockConnectionFetcher{
+			getWSConnectionFn: func(id string) conns.WSConnection {
+				require.Equal(t, connID, id)
+
+				return mockConn
+			},
+		}
+
+		txResult = &types.TxResult{
+			Height: 10,
+			Index:  0,
+			Tx:     []byte("tx"),
+			Response: abci.ResponseDeliverTx{
+				ResponseBase: abci.ResponseBase{
+					Events: []abci.Event{
+						chain.Event{Type: "Approval", PkgPath: "gno.land/r/demo/foo20"},
+						chain.Event{Type: "Transfer", PkgPath: "gno.land/r/demo/foo20"},
+					},
+				},
+			},
+		}
+	)
+
+	fm := filters.NewFilterManager(
+		context.Background(),
+		&mock.Storage{},
+		mockEvents,
+	)
+
+	h := NewHandler(fm, mockConnFetcher)
+
+	// Subscribe to the transfer events only
+	responseRaw, subscribeErr := h.SubscribeHandler(metadata, []any{
+		subscription.NewEventsEvent,
+		map[string]any{
+			"eventType": "Transfer",
+		},
+	})
+	require.Nil(t, subscribeErr)
+
+	id, ok := responseRaw.(string)
+	require.True(t, ok)
+
+	wg.Add(1)
+
+	select {
+	case eventsCh <- &indexerTypes.NewBlock{
+		Block:   &types.Block{Header: types.Header{Height: 10}},
+		Results: []*types.TxResult{txResult},
+	}:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out")
+	}
+
+	// The block is processed in full before the next one is received
+	select {
+	case eventsCh <- &indexerTypes.NewBlock{
+		Block: &types.Block{Header: types.Header{Height: 11}},
+	}:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out")
+	}
+
+	wg.Wait()
+
+	// Make sure only the matching event was written
+	require.Len(t, writtenData, 1)
+
+	response, ok := writtenData[0].(*spec.BaseJSONSubscribeResponse)
+	require.True(t, ok)
+
+	assert.Equal(t, id, response.Params.Subscription)
+
+	event, ok := response.Params.Result.(*methods.Event)
+	require.True(t, ok)
+
+	assert.Equal(t, "Transfer", event.Type)
+	assert.Equal(t, uint32(1), event.EventIndex)
+}
+
 func TestSubscribeUnsubscribe_InvalidParams(t *testing.T) {
 	t.Parallel()
 
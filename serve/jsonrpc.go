@@ -16,11 +16,14 @@ import (
 	"github.com/gnolang/tx-indexer/serve/conns/wsconn"
 	"github.com/gnolang/tx-indexer/serve/filters"
 	"github.com/gnolang/tx-indexer/serve/handlers/block"
+	"github.com/gnolang/tx-indexer/serve/handlers/broadcast"
 	"github.com/gnolang/tx-indexer/serve/handlers/gas"
+	"github.com/gnolang/tx-indexer/serve/handlers/lookup"
 	"github.com/gnolang/tx-indexer/serve/handlers/subs"
 	"github.com/gnolang/tx-indexer/serve/handlers/tx"
 	"github.com/gnolang/tx-indexer/serve/metadata"
 	"github.com/gnolang/tx-indexer/serve/spec"
+	"github.com/gnolang/tx-indexer/serve/sse"
 	"github.com/gnolang/tx-indexer/serve/writer"
 	httpWriter "github.com/gnolang/tx-indexer/serve/writer/http"
 	wsWriter "github.com/gnolang/tx-indexer/serve/writer/ws"
@@ -60,6 +63,10 @@ type JSONRPC struct {
 
 	// ws handles incoming and active WS connections
 	ws *melody.Melody
+
+	// sse streams the subscription events over SSE,
+	// once the subscription endpoints are registered
+	sse *sse.Handler
 }
 
 // NewJSONRPC creates a new instance of the JSONRPC server
@@ -99,6 +106,9 @@ func (j *JSONRPC) SetupRoutes(mux *chi.Mux) *chi.Mux {
 	// Register the WS methodHandler
 	mux.HandleFunc("/ws", j.handleWSRequest)
 
+	// Register the SSE event stream handler
+	mux.Get("/events", j.handleSSERequest)
+
 	return mux
 }
 
@@ -136,6 +146,21 @@ func (j *JSONRPC) RegisterGasEndpoints(db gas.Storage) {
 		"getGasPrice",
 		gasPriceHandler.GetGasPriceHandler,
 	)
+
+	j.RegisterHandler(
+		"suggestGasPrice",
+		gasPriceHandler.SuggestGasPriceHandler,
+	)
+
+	j.RegisterHandler(
+		"getGasStats",
+		gasPriceHandler.GetGasStatsHandler,
+	)
+
+	j.RegisterHandler(
+		"getFeeHistory",
+		gasPriceHandler.GetFeeHistoryHandler,
+	)
 }
 
 // RegisterBlockEndpoints registers the block endpoints
@@ -148,8 +173,39 @@ func (j *JSONRPC) RegisterBlockEndpoints(db block.Storage) {
 	)
 }
 
-func (j *JSONRPC) RegisterSubEndpoints(db storage.Storage) {
-	fm := filters.NewFilterManager(context.Background(), db, j.events)
+// RegisterLookupEndpoints registers the universal search endpoints
+func (j *JSONRPC) RegisterLookupEndpoints(db lookup.Storage) {
+	lookupHandler := lookup.NewHandler(db)
+
+	j.RegisterHandler(
+		"search",
+		lookupHandler.SearchHandler,
+	)
+}
+
+// RegisterBroadcastEndpoints registers the transaction relay endpoints
+func (j *JSONRPC) RegisterBroadcastEndpoints(client broadcast.Client, db broadcast.Storage) {
+	broadcastHandler := broadcast.NewHandler(context.Background(), client, db, j.events)
+
+	j.RegisterHandler(
+		"broadcastTx",
+		broadcastHandler.BroadcastTxHandler,
+	)
+
+	j.RegisterHandler(
+		"waitForTx",
+		broadcastHandler.WaitForTxHandler,
+	)
+}
+
+func (j *JSONRPC) RegisterSubEndpoints(db storage.Storage, opts ...filters.Option) {
+	fm := filters.NewFilterManager(context.Background(), db, j.events, opts...)
+
+	j.sse = sse.NewHandler(
+		fm,
+		db,
+		sse.WithLogger(j.logger.Named("sse")),
+	)
 
 	subsHandler := subs.NewHandler(
 		fm,
@@ -176,11 +232,21 @@ func (j *JSONRPC) RegisterSubEndpoints(db storage.Storage) {
 		subsHandler.NewTransactionFilterHandler,
 	)
 
+	j.RegisterHandler(
+		"newEventFilter",
+		subsHandler.NewEventFilterHandler,
+	)
+
 	j.RegisterHandler(
 		"getFilterChanges",
 		subsHandler.GetFilterChangesHandler,
 	)
 
+	j.RegisterHandler(
+		"getFilterLogs",
+		subsHandler.GetFilterLogsHandler,
+	)
+
 	j.RegisterHandler(
 		"uninstallFilter",
 		subsHandler.UninstallFilterHandler,
@@ -287,6 +353,17 @@ func (j *JSONRPC) handleWSRequest(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// handleSSERequest handles incoming SSE event stream requests
+func (j *JSONRPC) handleSSERequest(w http.ResponseWriter, r *http.Request) {
+	if j.sse == nil {
+		http.NotFound(w, r)
+
+		return
+	}
+
+	j.sse.ServeHTTP(w, r)
+}
+
 // handleRequest handles the specific requests with a
 // custom response writer
 func (j *JSONRPC) handleRequest(
@@ -1,5 +1,7 @@
 package metadata
 
+import "net"
+
 // Metadata houses the active request metadata
 type Metadata struct {
 	WebSocketID *string
@@ -24,3 +26,14 @@ func NewMetadata(remoteAddr string, opts ...Option) *Metadata {
 func (m *Metadata) IsWS() bool {
 	return m.WebSocketID != nil
 }
+
+// ClientIP returns the IP address of the client,
+// or the remote address if it has no port
+func (m *Metadata) ClientIP() string {
+	host, _, err := net.SplitHostPort(m.RemoteAddr)
+	if err != nil {
+		return m.RemoteAddr
+	}
+
+	return host
+}
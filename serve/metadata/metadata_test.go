@@ -36,3 +36,37 @@ func TestMetadata_NewMetadata(t *testing.T) {
 		assert.Equal(t, wsID, *m.WebSocketID)
 	})
 }
+
+func TestMetadata_ClientIP(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name       string
+		remoteAddr string
+		expectedIP string
+	}{
+		{
+			"IPv4 address",
+			"127.0.0.1:8545",
+			"127.0.0.1",
+		},
+		{
+			"IPv6 address",
+			"[::1]:8545",
+			"::1",
+		},
+		{
+			"address without port",
+			"127.0.0.1",
+			"127.0.0.1",
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testCase.expectedIP, NewMetadata(testCase.remoteAddr).ClientIP())
+		})
+	}
+}
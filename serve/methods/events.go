@@ -0,0 +1,44 @@
+package methods
+
+import (
+	"encoding/base64"
+
+	"github.com/gnolang/gno/gnovm/stdlibs/chain"
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+)
+
+// GetEventsByTx returns the Gno events emitted by the transaction,
+// skipping any other event kind
+func GetEventsByTx(txResult *types.TxResult) []*Event {
+	var (
+		events = make([]*Event, 0, len(txResult.Response.Events))
+		txHash = base64.StdEncoding.EncodeToString(txResult.Tx.Hash())
+	)
+
+	for eventIndex, abciEvent := range txResult.Response.Events {
+		gnoEvent, ok := abciEvent.(chain.Event)
+		if !ok {
+			continue
+		}
+
+		attrs := make([]*EventAttribute, 0, len(gnoEvent.Attributes))
+		for _, attr := range gnoEvent.Attributes {
+			attrs = append(attrs, &EventAttribute{
+				Key:   attr.Key,
+				Value: attr.Value,
+			})
+		}
+
+		events = append(events, &Event{
+			TxHash:     txHash,
+			Type:       gnoEvent.Type,
+			PkgPath:    gnoEvent.PkgPath,
+			Attrs:      attrs,
+			Height:     txResult.Height,
+			Index:      txResult.Index,
+			EventIndex: uint32(eventIndex),
+		})
+	}
+
+	return events
+}
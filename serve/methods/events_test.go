@@ -0,0 +1,49 @@
+package methods
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/gnolang/gno/gnovm/stdlibs/chain"
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEventsByTx(t *testing.T) {
+	t.Parallel()
+
+	tx := &types.TxResult{
+		Height: 10,
+		Index:  2,
+		Tx:     []byte("tx"),
+		Response: abci.ResponseDeliverTx{
+			ResponseBase: abci.ResponseBase{
+				Events: []abci.Event{
+					abci.EventString("not a Gno event"),
+					chain.Event{
+						Type:    "Transfer",
+						PkgPath: "gno.land/r/demo/foo20",
+						Attributes: []chain.EventAttribute{
+							{Key: "from", Value: "alice"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, []*Event{
+		{
+			TxHash:  base64.StdEncoding.EncodeToString(tx.Tx.Hash()),
+			Type:    "Transfer",
+			PkgPath: "gno.land/r/demo/foo20",
+			Attrs: []*EventAttribute{
+				{Key: "from", Value: "alice"},
+			},
+			Height:     10,
+			Index:      2,
+			EventIndex: 1,
+		},
+	}, GetEventsByTx(tx))
+}
@@ -0,0 +1,135 @@
+package methods
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/gnolang/tx-indexer/storage"
+	"github.com/gnolang/tx-indexer/types"
+)
+
+const (
+	// DefaultFailureWindow is the default number of most recent blocks
+	// the realm failure reasons are aggregated over
+	DefaultFailureWindow = 10_000
+
+	// MaxFailureWindow is the maximum number of most recent blocks
+	// the realm failure reasons can be aggregated over
+	MaxFailureWindow = 100_000
+
+	// DefaultFailureReasonsLimit is the default number of most frequent failure reasons returned
+	DefaultFailureReasonsLimit = 50
+)
+
+// FailureStorage is the storage the realm failures are read from
+type FailureStorage interface {
+	// GetLatestHeight returns the latest block height from the storage
+	GetLatestHeight() (uint64, error)
+
+	// RealmFailureIterator iterates over the failed calls to the given realm,
+	// limiting the results to be between the provided block numbers
+	RealmFailureIterator(pkgPath string, fromBlockNum, toBlockNum uint64) (storage.Iterator[*types.RealmFailure], error)
+}
+
+// failureReasonKey identifies a single failure reason of a realm
+type failureReasonKey struct {
+	fn      string
+	code    string
+	message string
+}
+
+// GetFailureReasons aggregates the failed calls made to the given realm
+// in the given number of most recent blocks (the default window if 0),
+// by called function and error. Only the given number of most frequent
+// reasons are returned (the default limit if 0)
+func GetFailureReasons(store FailureStorage, pkgPath string, window, limit uint64) (*RealmFailures, error) {
+	if window == 0 {
+		window = DefaultFailureWindow
+	}
+
+	if limit == 0 {
+		limit = DefaultFailureReasonsLimit
+	}
+
+	window = min(window, MaxFailureWindow)
+
+	latestHeight, err := store.GetLatestHeight()
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch latest height, %w", err)
+	}
+
+	var fromBlockNum uint64
+
+	if latestHeight >= window {
+		fromBlockNum = latestHeight - window + 1
+	}
+
+	it, err := store.RealmFailureIterator(pkgPath, fromBlockNum, latestHeight)
+	if err != nil {
+		return nil, fmt.Errorf("unable to iterate realm failures, %w", err)
+	}
+
+	defer it.Close()
+
+	var (
+		failures = &RealmFailures{
+			PkgPath: pkgPath,
+			Reasons: make([]*FailureReason, 0),
+		}
+
+		reasons = make(map[failureReasonKey]*FailureReason)
+	)
+
+	for it.Next() {
+		failure, err := it.Value()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read realm failure, %w", err)
+		}
+
+		key := failureReasonKey{
+			fn:      failure.Func,
+			code:    failure.Error.Code,
+			message: failure.Error.Message,
+		}
+
+		reason, ok := reasons[key]
+		if !ok {
+			reason = &FailureReason{
+				Func:        failure.Func,
+				Codespace:   failure.Error.Codespace,
+				Code:        failure.Error.Code,
+				Name:        failure.Error.Name,
+				Message:     failure.Error.Message,
+				FirstHeight: failure.Height,
+			}
+
+			reasons[key] = reason
+			failures.Reasons = append(failures.Reasons, reason)
+		}
+
+		reason.Count++
+		reason.LastHeight = failure.Height
+
+		failures.TotalFailures++
+	}
+
+	if err := it.Error(); err != nil {
+		return nil, fmt.Errorf("unable to iterate realm failures, %w", err)
+	}
+
+	// Most frequent reasons first, most recent first on ties.
+	// The stable sort keeps the first seen order for the rest
+	sort.SliceStable(failures.Reasons, func(i, j int) bool {
+		if failures.Reasons[i].Count != failures.Reasons[j].Count {
+			return failures.Reasons[i].Count > failures.Reasons[j].Count
+		}
+
+		return failures.Reasons[i].LastHeight > failures.Reasons[j].LastHeight
+	})
+
+	if uint64(len(failures.Reasons)) > limit {
+		failures.Reasons = failures.Reasons[:limit]
+	}
+
+	return failures, nil
+}
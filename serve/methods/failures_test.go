@@ -0,0 +1,156 @@
+package methods
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/tx-indexer/storage"
+	"github.com/gnolang/tx-indexer/types"
+)
+
+// failureStore is an in-memory realm failure storage
+type failureStore struct {
+	failures     []*types.RealmFailure
+	latestHeight uint64
+}
+
+func (s *failureStore) GetLatestHeight() (uint64, error) {
+	return s.latestHeight, nil
+}
+
+func (s *failureStore) RealmFailureIterator(
+	pkgPath string,
+	fromBlockNum,
+	toBlockNum uint64,
+) (storage.Iterator[*types.RealmFailure], error) {
+	it := &failureIterator{
+		index: -1,
+	}
+
+	for _, failure := range s.failures {
+		height := uint64(failure.Height)
+
+		if failure.PkgPath == pkgPath && height >= fromBlockNum && height <= toBlockNum {
+			it.failures = append(it.failures, failure)
+		}
+	}
+
+	return it, nil
+}
+
+type failureIterator struct {
+	failures []*types.RealmFailure
+	index    int
+}
+
+func (i *failureIterator) Next() bool {
+	i.index++
+
+	return i.index < len(i.failures)
+}
+
+func (i *failureIterator) Error() error {
+	return nil
+}
+
+func (i *failureIterator) Value() (*types.RealmFailure, error) {
+	return i.failures[i.index], nil
+}
+
+func (i *failureIterator) Close() error {
+	return nil
+}
+
+func TestGetFailureReasons(t *testing.T) {
+	t.Parallel()
+
+	newFailure := func(pkgPath, fn string, txError types.TxError, height int64) *types.RealmFailure {
+		return &types.RealmFailure{
+			PkgPath: pkgPath,
+			Func:    fn,
+			Error:   txError,
+			Height:  height,
+		}
+	}
+
+	var (
+		exists = types.TxError{
+			Codespace: "abci",
+			Code:      "/abci.StringError",
+			Name:      "StringError",
+			Message:   "user already exists",
+		}
+
+		outOfGas = types.TxError{
+			Codespace: "std",
+			Code:      "/std.OutOfGasError",
+			Name:      "OutOfGasError",
+			Message:   "out of gas error",
+		}
+
+		store = &failureStore{
+			latestHeight: 100,
+			failures: []*types.RealmFailure{
+				// Outside the window
+				newFailure("gno.land/r/demo/users", "Register", outOfGas, 1),
+				newFailure("gno.land/r/demo/users", "Register", outOfGas, 1),
+				newFailure("gno.land/r/demo/users", "Register", outOfGas, 1),
+
+				newFailure("gno.land/r/demo/users", "Register", exists, 95),
+				newFailure("gno.land/r/demo/users", "Invite", outOfGas, 96),
+				newFailure("gno.land/r/demo/users", "Register", exists, 97),
+				newFailure("gno.land/r/demo/users", "Register", outOfGas, 98),
+				newFailure("gno.land/r/demo/foo20", "Register", exists, 99),
+			},
+		}
+	)
+
+	failures, err := GetFailureReasons(store, "gno.land/r/demo/users", 10, 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, &RealmFailures{
+		PkgPath: "gno.land/r/demo/users",
+		Reasons: []*FailureReason{
+			{
+				Func:        "Register",
+				Codespace:   "abci",
+				Code:        "/abci.StringError",
+				Name:        "StringError",
+				Message:     "user already exists",
+				Count:       2,
+				FirstHeight: 95,
+				LastHeight:  97,
+			},
+			{
+				Func:        "Register",
+				Codespace:   "std",
+				Code:        "/std.OutOfGasError",
+				Name:        "OutOfGasError",
+				Message:     "out of gas error",
+				Count:       1,
+				FirstHeight: 98,
+				LastHeight:  98,
+			},
+			{
+				Func:        "Invite",
+				Codespace:   "std",
+				Code:        "/std.OutOfGasError",
+				Name:        "OutOfGasError",
+				Message:     "out of gas error",
+				Count:       1,
+				FirstHeight: 96,
+				LastHeight:  96,
+			},
+		},
+		TotalFailures: 4,
+	}, failures)
+
+	// Make sure the total accounts for the reasons left out by the limit
+	limited, err := GetFailureReasons(store, "gno.land/r/demo/users", 10, 1)
+	require.NoError(t, err)
+
+	assert.Len(t, limited.Reasons, 1)
+	assert.Equal(t, uint64(4), limited.TotalFailures)
+}
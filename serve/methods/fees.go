@@ -0,0 +1,179 @@
+package methods
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gnolang/tx-indexer/storage"
+	"github.com/gnolang/tx-indexer/types"
+)
+
+// MaxFeeHistoryRange is the maximum number of blocks the fee history can span
+const MaxFeeHistoryRange = 100_000
+
+// FeeStorage is the storage the fee statistics are read from
+type FeeStorage interface {
+	// GetLatestHeight returns the latest block height from the storage
+	GetLatestHeight() (uint64, error)
+
+	// BlockFeesIterator iterates over the block fee summaries,
+	// limiting the results to be between the provided block numbers
+	BlockFeesIterator(fromBlockNum, toBlockNum uint64) (storage.Iterator[*types.BlockFees], error)
+}
+
+// GetBlockFees reads the fee summaries of the blocks between the provided block numbers.
+// Blocks without decodable transactions have no summary
+func GetBlockFees(store FeeStorage, fromBlockNum, toBlockNum uint64) ([]*types.BlockFees, error) {
+	it, err := store.BlockFeesIterator(fromBlockNum, toBlockNum)
+	if err != nil {
+		return nil, fmt.Errorf("unable to iterate block fees, %w", err)
+	}
+
+	defer it.Close()
+
+	fees := make([]*types.BlockFees, 0)
+
+	for it.Next() {
+		blockFees, err := it.Value()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read block fees, %w", err)
+		}
+
+		fees = append(fees, blockFees)
+	}
+
+	if err := it.Error(); err != nil {
+		return nil, fmt.Errorf("unable to iterate block fees, %w", err)
+	}
+
+	return fees, nil
+}
+
+// GetFeeHistory returns the fee series of the blocks between the provided block numbers
+// (up to the latest block if toBlockNum is 0), bucketed by block time using the given resolution.
+// Buckets without transactions are omitted
+func GetFeeHistory(
+	store FeeStorage,
+	fromBlockNum,
+	toBlockNum uint64,
+	resolution time.Duration,
+) ([]*FeeHistoryBucket, error) {
+	if resolution < time.Second {
+		return nil, fmt.Errorf("invalid resolution %s, minimum is 1s", resolution)
+	}
+
+	if toBlockNum == 0 {
+		latestHeight, err := store.GetLatestHeight()
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch latest height, %w", err)
+		}
+
+		toBlockNum = latestHeight
+	}
+
+	if toBlockNum < fromBlockNum {
+		return nil, fmt.Errorf("invalid block range %d - %d", fromBlockNum, toBlockNum)
+	}
+
+	if toBlockNum-fromBlockNum >= MaxFeeHistoryRange {
+		return nil, fmt.Errorf("block range too large, maximum is %d blocks", MaxFeeHistoryRange)
+	}
+
+	fees, err := GetBlockFees(store, fromBlockNum, toBlockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	return feeHistory(fees, resolution), nil
+}
+
+// feeHistory buckets the block fee summaries by block time
+func feeHistory(fees []*types.BlockFees, resolution time.Duration) []*FeeHistoryBucket {
+	var (
+		buckets = make([]*FeeHistoryBucket, 0)
+		current []*types.BlockFees
+		start   time.Time
+	)
+
+	for _, blockFees := range fees {
+		blockStart := blockFees.Time.UTC().Truncate(resolution)
+
+		if len(current) > 0 && !blockStart.Equal(start) {
+			buckets = append(buckets, newFeeHistoryBucket(start, resolution, current))
+			current = nil
+		}
+
+		start = blockStart
+		current = append(current, blockFees)
+	}
+
+	if len(current) > 0 {
+		buckets = append(buckets, newFeeHistoryBucket(start, resolution, current))
+	}
+
+	return buckets
+}
+
+// newFeeHistoryBucket aggregates the block fee summaries of a single bucket
+func newFeeHistoryBucket(start time.Time, resolution time.Duration, fees []*types.BlockFees) *FeeHistoryBucket {
+	var (
+		bucket = &FeeHistoryBucket{
+			Start:      start,
+			End:        start.Add(resolution),
+			FromHeight: fees[0].Height,
+			ToHeight:   fees[len(fees)-1].Height,
+			Blocks:     int64(len(fees)),
+		}
+
+		denoms = make(map[string]*FeeHistoryDenom)
+		prices = make(map[string][]float64)
+	)
+
+	for _, blockFees := range fees {
+		for _, txFee := range blockFees.Txs {
+			bucket.TxCount++
+			bucket.TotalGasWanted += txFee.GasWanted
+			bucket.TotalGasUsed += txFee.GasUsed
+
+			denom, ok := denoms[txFee.Denom]
+			if !ok {
+				denom = &FeeHistoryDenom{
+					Denom: txFee.Denom,
+					Low:   txFee.Amount,
+				}
+
+				denoms[txFee.Denom] = denom
+			}
+
+			denom.TxCount++
+			denom.TotalAmount += txFee.Amount
+			denom.Low = min(denom.Low, txFee.Amount)
+			denom.High = max(denom.High, txFee.Amount)
+
+			if txFee.GasWanted > 0 {
+				prices[txFee.Denom] = append(prices[txFee.Denom], txFee.Price())
+			}
+		}
+	}
+
+	bucket.Denoms = make([]*FeeHistoryDenom, 0, len(denoms))
+
+	for _, denom := range denoms {
+		denom.Average = denom.TotalAmount / denom.TxCount
+
+		denomPrices := prices[denom.Denom]
+		slices.Sort(denomPrices)
+
+		denom.MedianPrice = percentile(denomPrices, 50)
+
+		bucket.Denoms = append(bucket.Denoms, denom)
+	}
+
+	slices.SortFunc(bucket.Denoms, func(a, b *FeeHistoryDenom) int {
+		return strings.Compare(a.Denom, b.Denom)
+	})
+
+	return bucket
+}
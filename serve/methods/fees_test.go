@@ -0,0 +1,91 @@
+package methods
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/tx-indexer/types"
+)
+
+func TestFeeHistory(t *testing.T) {
+	t.Parallel()
+
+	var (
+		base = time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+		fees = []*types.BlockFees{
+			{
+				Height: 1,
+				Time:   base.Add(10 * time.Minute),
+				Txs: []types.TxFee{
+					{Denom: "ugnot", Amount: 100, GasWanted: 100, GasUsed: 50},
+					{Denom: "foo", Amount: 10, GasWanted: 100, GasUsed: 50},
+				},
+			},
+			{
+				Height: 2,
+				Time:   base.Add(50 * time.Minute),
+				Txs: []types.TxFee{
+					{Denom: "ugnot", Amount: 300, GasWanted: 100, GasUsed: 80},
+					{Denom: "ugnot", Amount: 500, GasWanted: 100, GasUsed: 80},
+				},
+			},
+			// No transactions within the 11:00 bucket
+			{
+				Height: 10,
+				Time:   base.Add(2*time.Hour + time.Minute),
+				Txs: []types.TxFee{
+					{Denom: "ugnot", Amount: 1000, GasWanted: 0, GasUsed: 10},
+				},
+			},
+		}
+	)
+
+	buckets := feeHistory(fees, time.Hour)
+	require.Len(t, buckets, 2)
+
+	assert.Equal(t, &FeeHistoryBucket{
+		Start:          base,
+		End:            base.Add(time.Hour),
+		FromHeight:     1,
+		ToHeight:       2,
+		Blocks:         2,
+		TxCount:        4,
+		TotalGasWanted: 400,
+		TotalGasUsed:   260,
+		Denoms: []*FeeHistoryDenom{
+			{
+				Denom:       "foo",
+				TxCount:     1,
+				TotalAmount: 10,
+				Low:         10,
+				Average:     10,
+				High:        10,
+				MedianPrice: 0.1,
+			},
+			{
+				Denom:       "ugnot",
+				TxCount:     3,
+				TotalAmount: 900,
+				Low:         100,
+				Average:     300,
+				High:        500,
+				MedianPrice: 3,
+			},
+		},
+	}, buckets[0])
+
+	// Make sure transactions without a gas limit don't have a price
+	assert.Equal(t, base.Add(2*time.Hour), buckets[1].Start)
+	assert.Equal(t, int64(1), buckets[1].TxCount)
+	assert.Zero(t, buckets[1].Denoms[0].MedianPrice)
+}
+
+func TestFeeHistory_Empty(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, feeHistory(nil, time.Minute))
+}
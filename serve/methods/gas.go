@@ -6,6 +6,8 @@ import (
 	"github.com/gnolang/gno/tm2/pkg/amino"
 	"github.com/gnolang/gno/tm2/pkg/bft/types"
 	"github.com/gnolang/gno/tm2/pkg/std"
+
+	indexerTypes "github.com/gnolang/tx-indexer/types"
 )
 
 type gasFeeTotalInfo struct {
@@ -26,10 +28,33 @@ func GetGasPricesByBlock(block *types.Block) ([]*GasPrice, error) {
 // GetGasPricesByBlocks calculates the gas price statistics (low, high, average)
 // for multiple blocks.
 func GetGasPricesByBlocks(blocks []*types.Block) ([]*GasPrice, error) {
-	gasFeeInfoMap := make(map[string]*gasFeeTotalInfo)
+	fees := make([]*indexerTypes.BlockFees, 0, len(blocks))
 
 	for _, block := range blocks {
-		blockGasFeeInfo := calculateGasFeePerBlock(block)
+		fees = append(fees, blockFees(block))
+	}
+
+	return GetGasPricesByFees(fees)
+}
+
+// GetGasPrices calculates the gas price statistics (low, high, average)
+// for the blocks between the provided block numbers, using their fee summaries
+func GetGasPrices(store FeeStorage, fromBlockNum, toBlockNum uint64) ([]*GasPrice, error) {
+	fees, err := GetBlockFees(store, fromBlockNum, toBlockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetGasPricesByFees(fees)
+}
+
+// GetGasPricesByFees calculates the gas price statistics (low, high, average)
+// for multiple block fee summaries.
+func GetGasPricesByFees(fees []*indexerTypes.BlockFees) ([]*GasPrice, error) {
+	gasFeeInfoMap := make(map[string]*gasFeeTotalInfo)
+
+	for _, blockFees := range fees {
+		blockGasFeeInfo := calculateGasFeePerBlock(blockFees)
 
 		for denom, gasFeeInfo := range blockGasFeeInfo {
 			_, exists := gasFeeInfoMap[denom]
@@ -47,19 +72,39 @@ func GetGasPricesByBlocks(blocks []*types.Block) ([]*GasPrice, error) {
 	return calculateGasPrices(gasFeeInfoMap), nil
 }
 
-// calculateGasFeePerBlock processes all transactions in a single block to compute
-// gas fee statistics (low, high, total amount, total count) for each gas fee denomination.
-func calculateGasFeePerBlock(block *types.Block) map[string]*gasFeeTotalInfo {
-	gasFeeInfo := make(map[string]*gasFeeTotalInfo)
+// blockFees builds the fee summary of the block, out of its decodable transactions
+func blockFees(block *types.Block) *indexerTypes.BlockFees {
+	fees := &indexerTypes.BlockFees{
+		Height: block.Height,
+		Time:   block.Time,
+		Txs:    make([]indexerTypes.TxFee, 0, len(block.Txs)),
+	}
 
-	for _, t := range block.Txs {
+	for index, t := range block.Txs {
 		var stdTx std.Tx
 		if err := amino.Unmarshal(t, &stdTx); err != nil {
 			continue
 		}
 
-		denom := stdTx.Fee.GasFee.Denom
-		amount := stdTx.Fee.GasFee.Amount
+		fees.Txs = append(fees.Txs, indexerTypes.TxFee{
+			Denom:     stdTx.Fee.GasFee.Denom,
+			Amount:    stdTx.Fee.GasFee.Amount,
+			GasWanted: stdTx.Fee.GasWanted,
+			Index:     uint32(index),
+		})
+	}
+
+	return fees
+}
+
+// calculateGasFeePerBlock processes all transactions in a single block to compute
+// gas fee statistics (low, high, total amount, total count) for each gas fee denomination.
+func calculateGasFeePerBlock(fees *indexerTypes.BlockFees) map[string]*gasFeeTotalInfo {
+	gasFeeInfo := make(map[string]*gasFeeTotalInfo)
+
+	for _, txFee := range fees.Txs {
+		denom := txFee.Denom
+		amount := txFee.Amount
 
 		info := gasFeeInfo[denom]
 		if info == nil {
@@ -0,0 +1,145 @@
+package methods
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/gnolang/tx-indexer/storage"
+	"github.com/gnolang/tx-indexer/types"
+)
+
+const (
+	// DefaultGasStatsWindow is the default number of most recent blocks
+	// the realm function gas statistics are computed over
+	DefaultGasStatsWindow = 10_000
+
+	// MaxGasStatsWindow is the maximum number of most recent blocks
+	// the realm function gas statistics can be computed over
+	MaxGasStatsWindow = 100_000
+)
+
+// GasStatsStorage is the storage the realm function gas statistics are read from
+type GasStatsStorage interface {
+	// GetLatestHeight returns the latest block height from the storage
+	GetLatestHeight() (uint64, error)
+
+	// FuncGasStatsIterator iterates over the per-block gas usage summaries of the given realm function,
+	// limiting the results to be between the provided block numbers
+	FuncGasStatsIterator(
+		pkgPath,
+		fn string,
+		fromBlockNum,
+		toBlockNum uint64,
+	) (storage.Iterator[*types.FuncGasStats], error)
+}
+
+// GetGasStats computes the gas usage statistics of the given realm function,
+// over the calls made in the given number of most recent blocks (the default window if 0).
+// Only transactions made of a single vm.MsgCall are accounted for
+func GetGasStats(store GasStatsStorage, pkgPath, fn string, window uint64) (*GasStats, error) {
+	if window == 0 {
+		window = DefaultGasStatsWindow
+	}
+
+	window = min(window, MaxGasStatsWindow)
+
+	latestHeight, err := store.GetLatestHeight()
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch latest height, %w", err)
+	}
+
+	var fromBlockNum uint64
+
+	if latestHeight >= window {
+		fromBlockNum = latestHeight - window + 1
+	}
+
+	it, err := store.FuncGasStatsIterator(pkgPath, fn, fromBlockNum, latestHeight)
+	if err != nil {
+		return nil, fmt.Errorf("unable to iterate gas stats, %w", err)
+	}
+
+	defer it.Close()
+
+	total := &types.FuncGasStats{
+		PkgPath: pkgPath,
+		Func:    fn,
+	}
+
+	for it.Next() {
+		stats, err := it.Value()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read gas stats, %w", err)
+		}
+
+		total.Merge(stats)
+	}
+
+	if err := it.Error(); err != nil {
+		return nil, fmt.Errorf("unable to iterate gas stats, %w", err)
+	}
+
+	return newGasStats(total), nil
+}
+
+// newGasStats computes the gas usage statistics out of the aggregated summary
+func newGasStats(total *types.FuncGasStats) *GasStats {
+	stats := &GasStats{
+		PkgPath:    total.PkgPath,
+		Func:       total.Func,
+		Calls:      total.Calls,
+		Failures:   total.Failures,
+		MinGasUsed: total.MinGasUsed,
+		MaxGasUsed: total.MaxGasUsed,
+		Histogram:  make([]*GasBucket, 0, len(total.GasUsedBuckets)),
+	}
+
+	if total.Calls == 0 {
+		return stats
+	}
+
+	stats.FailureRate = float64(total.Failures) / float64(total.Calls)
+	stats.AverageGasUsed = total.TotalGasUsed / int64(total.Calls)
+	stats.AverageGasWanted = total.TotalGasWanted / int64(total.Calls)
+
+	if total.TotalGasUsed > 0 {
+		stats.OverProvisioningRatio = float64(total.TotalGasWanted) / float64(total.TotalGasUsed)
+	}
+
+	for bucket, count := range total.GasUsedBuckets {
+		if count == 0 {
+			continue
+		}
+
+		from, to := types.GasBucketBounds(bucket)
+
+		stats.Histogram = append(stats.Histogram, &GasBucket{
+			From:  from,
+			To:    to,
+			Count: count,
+		})
+	}
+
+	stats.P50GasUsed = histogramPercentile(stats.Histogram, total.Calls, 50, total.MaxGasUsed)
+	stats.P90GasUsed = histogramPercentile(stats.Histogram, total.Calls, 90, total.MaxGasUsed)
+
+	return stats
+}
+
+// histogramPercentile estimates the nearest-rank percentile of the histogram,
+// as the upper bound of the bucket containing it (capped to the highest value)
+func histogramPercentile(histogram []*GasBucket, count uint64, p float64, highest int64) int64 {
+	rank := uint64(math.Ceil(p / 100 * float64(count)))
+
+	var seen uint64
+
+	for _, bucket := range histogram {
+		seen += bucket.Count
+
+		if seen >= rank {
+			return min(bucket.To, highest)
+		}
+	}
+
+	return highest
+}
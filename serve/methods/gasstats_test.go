@@ -0,0 +1,58 @@
+package methods
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gnolang/tx-indexer/types"
+)
+
+func TestNewGasStats(t *testing.T) {
+	t.Parallel()
+
+	total := &types.FuncGasStats{
+		PkgPath: "gno.land/r/demo/users",
+		Func:    "Register",
+	}
+
+	// 9 cheap successful calls, and an expensive failed one
+	for i := 0; i < 9; i++ {
+		total.AddCall(2000, 1000, true)
+	}
+
+	total.AddCall(10000, 5000, false)
+
+	assert.Equal(t, &GasStats{
+		PkgPath: "gno.land/r/demo/users",
+		Func:    "Register",
+		Histogram: []*GasBucket{
+			{From: 512, To: 1023, Count: 9},
+			{From: 4096, To: 8191, Count: 1},
+		},
+		Calls:                 10,
+		Failures:              1,
+		FailureRate:           0.1,
+		AverageGasUsed:        1400,
+		AverageGasWanted:      2800,
+		MinGasUsed:            1000,
+		MaxGasUsed:            5000,
+		P50GasUsed:            1023,
+		P90GasUsed:            1023,
+		OverProvisioningRatio: 2,
+	}, newGasStats(total))
+}
+
+func TestNewGasStats_NoCalls(t *testing.T) {
+	t.Parallel()
+
+	stats := newGasStats(&types.FuncGasStats{
+		PkgPath: "gno.land/r/demo/users",
+		Func:    "Register",
+	})
+
+	assert.Zero(t, stats.Calls)
+	assert.Zero(t, stats.FailureRate)
+	assert.Zero(t, stats.OverProvisioningRatio)
+	assert.Empty(t, stats.Histogram)
+}
@@ -0,0 +1,157 @@
+package methods
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+
+	"github.com/gnolang/tx-indexer/types"
+)
+
+const (
+	// DefaultSuggestionWindow is the default number of most recent blocks
+	// the gas price suggestions are computed over
+	DefaultSuggestionWindow = 100
+
+	// MaxSuggestionWindow is the maximum number of most recent blocks
+	// the gas price suggestions can be computed over
+	MaxSuggestionWindow = 1_000
+)
+
+// InclusionSpeed is the target inclusion speed of a transaction
+type InclusionSpeed string
+
+const (
+	SpeedSlow     InclusionSpeed = "slow"     // the fee per gas paid by the cheapest 10% of transactions
+	SpeedStandard InclusionSpeed = "standard" // the median fee per gas
+	SpeedFast     InclusionSpeed = "fast"     // the fee per gas paid by the most expensive 10% of transactions
+)
+
+// percentile returns the fee per gas percentile matching the inclusion speed
+func (s InclusionSpeed) percentile() (float64, error) {
+	switch s {
+	case SpeedSlow:
+		return 10, nil
+	case SpeedStandard, "":
+		return 50, nil
+	case SpeedFast:
+		return 90, nil
+	default:
+		return 0, fmt.Errorf("invalid inclusion speed %q", s)
+	}
+}
+
+// ParseInclusionSpeed parses the inclusion speed,
+// defaulting to the standard speed if empty
+func ParseInclusionSpeed(speed string) (InclusionSpeed, error) {
+	parsed := InclusionSpeed(speed)
+	if parsed == "" {
+		parsed = SpeedStandard
+	}
+
+	if _, err := parsed.percentile(); err != nil {
+		return "", err
+	}
+
+	return parsed, nil
+}
+
+// SuggestGasPrice computes the gas price suggestions, per gas fee denomination,
+// over the fees paid in the given number of most recent blocks (the default window if 0).
+// If gasWanted is set, the suggestions also include the recommended total fee
+func SuggestGasPrice(
+	store FeeStorage,
+	window uint64,
+	speed InclusionSpeed,
+	gasWanted int64,
+) ([]*GasPriceSuggestion, error) {
+	if window == 0 {
+		window = DefaultSuggestionWindow
+	}
+
+	window = min(window, MaxSuggestionWindow)
+
+	latestHeight, err := store.GetLatestHeight()
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch latest height, %w", err)
+	}
+
+	var fromBlockNum uint64
+
+	if latestHeight >= window {
+		fromBlockNum = latestHeight - window + 1
+	}
+
+	fees, err := GetBlockFees(store, fromBlockNum, latestHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return suggestGasPrices(fees, speed, gasWanted)
+}
+
+// suggestGasPrices computes the fee per gas percentiles of the given block fees,
+// per gas fee denomination. Every transaction is weighted equally,
+// regardless of how busy its block was
+func suggestGasPrices(
+	fees []*types.BlockFees,
+	speed InclusionSpeed,
+	gasWanted int64,
+) ([]*GasPriceSuggestion, error) {
+	target, err := speed.percentile()
+	if err != nil {
+		return nil, err
+	}
+
+	prices := make(map[string][]float64)
+
+	for _, blockFees := range fees {
+		for _, txFee := range blockFees.Txs {
+			if txFee.GasWanted <= 0 {
+				// No fee per gas can be derived
+				continue
+			}
+
+			prices[txFee.Denom] = append(prices[txFee.Denom], txFee.Price())
+		}
+	}
+
+	suggestions := make([]*GasPriceSuggestion, 0, len(prices))
+
+	for denom, denomPrices := range prices {
+		slices.Sort(denomPrices)
+
+		suggestion := &GasPriceSuggestion{
+			Denom:            denom,
+			TxCount:          int64(len(denomPrices)),
+			P10:              percentile(denomPrices, 10),
+			P50:              percentile(denomPrices, 50),
+			P90:              percentile(denomPrices, 90),
+			RecommendedPrice: percentile(denomPrices, target),
+		}
+
+		if gasWanted > 0 {
+			suggestion.RecommendedFee = int64(math.Ceil(suggestion.RecommendedPrice * float64(gasWanted)))
+		}
+
+		suggestions = append(suggestions, suggestion)
+	}
+
+	sort.Slice(suggestions, func(i, j int) bool {
+		return suggestions[i].Denom < suggestions[j].Denom
+	})
+
+	return suggestions, nil
+}
+
+// percentile returns the nearest-rank percentile of the sorted values
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+
+	return sorted[max(rank-1, 0)]
+}
@@ -0,0 +1,123 @@
+package methods
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/tx-indexer/types"
+)
+
+func TestSuggestGasPrices(t *testing.T) {
+	t.Parallel()
+
+	var (
+		// A busy block, with 9 transactions paying 1 per gas
+		busy = &types.BlockFees{
+			Height: 1,
+			Txs:    make([]types.TxFee, 0, 9),
+		}
+
+		// A quiet block, with a single transaction paying 10 per gas
+		quiet = &types.BlockFees{
+			Height: 2,
+			Txs: []types.TxFee{
+				{Denom: "ugnot", Amount: 1000, GasWanted: 100},
+				{Denom: "foo", Amount: 5, GasWanted: 10},
+				{Denom: "ugnot", Amount: 1000, GasWanted: 0}, // no gas limit, skipped
+			},
+		}
+	)
+
+	for i := 0; i < 9; i++ {
+		busy.Txs = append(busy.Txs, types.TxFee{Denom: "ugnot", Amount: 100, GasWanted: 100})
+	}
+
+	suggestions, err := suggestGasPrices([]*types.BlockFees{busy, quiet}, SpeedFast, 1000)
+	require.NoError(t, err)
+
+	// Make sure transactions are weighted equally, regardless of their block
+	assert.Equal(t, []*GasPriceSuggestion{
+		{
+			Denom:            "foo",
+			TxCount:          1,
+			P10:              0.5,
+			P50:              0.5,
+			P90:              0.5,
+			RecommendedPrice: 0.5,
+			RecommendedFee:   500,
+		},
+		{
+			Denom:            "ugnot",
+			TxCount:          10,
+			P10:              1,
+			P50:              1,
+			P90:              1,
+			RecommendedPrice: 1,
+			RecommendedFee:   1000,
+		},
+	}, suggestions)
+}
+
+func TestSuggestGasPrices_Speed(t *testing.T) {
+	t.Parallel()
+
+	fees := &types.BlockFees{
+		Height: 1,
+		Txs:    make([]types.TxFee, 0, 100),
+	}
+
+	for i := int64(1); i <= 100; i++ {
+		fees.Txs = append(fees.Txs, types.TxFee{Denom: "ugnot", Amount: i, GasWanted: 1})
+	}
+
+	testTable := []struct {
+		name     string
+		speed    InclusionSpeed
+		expected float64
+	}{
+		{
+			"slow",
+			SpeedSlow,
+			10,
+		},
+		{
+			"standard",
+			SpeedStandard,
+			50,
+		},
+		{
+			"default",
+			"",
+			50,
+		},
+		{
+			"fast",
+			SpeedFast,
+			90,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			suggestions, err := suggestGasPrices([]*types.BlockFees{fees}, testCase.speed, 0)
+			require.NoError(t, err)
+			require.Len(t, suggestions, 1)
+
+			assert.Equal(t, testCase.expected, suggestions[0].RecommendedPrice)
+
+			// No gas wanted, no recommended fee
+			assert.Zero(t, suggestions[0].RecommendedFee)
+		})
+	}
+}
+
+func TestSuggestGasPrices_InvalidSpeed(t *testing.T) {
+	t.Parallel()
+
+	_, err := suggestGasPrices(nil, "instant", 0)
+	assert.Error(t, err)
+}
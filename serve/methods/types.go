@@ -1,8 +1,125 @@
 package methods
 
+import "time"
+
 type GasPrice struct {
 	Denom   string `json:"denom"`
 	Low     int64  `json:"low"`
 	Average int64  `json:"average"`
 	High    int64  `json:"high"`
 }
+
+// GasPriceSuggestion is the gas price suggestion for a single gas fee denomination.
+// Prices are expressed as fee per unit of gas wanted
+type GasPriceSuggestion struct {
+	Denom            string  `json:"denom"`
+	P10              float64 `json:"p10"`
+	P50              float64 `json:"p50"`
+	P90              float64 `json:"p90"`
+	RecommendedPrice float64 `json:"recommendedPrice"`
+	RecommendedFee   int64   `json:"recommendedFee,omitempty"`
+	TxCount          int64   `json:"txCount"`
+}
+
+// GasStats is the gas usage statistics of the calls made to a single realm function
+type GasStats struct {
+	PkgPath               string       `json:"pkgPath"`
+	Func                  string       `json:"func"`
+	Histogram             []*GasBucket `json:"histogram"`
+	Calls                 uint64       `json:"calls"`
+	Failures              uint64       `json:"failures"`
+	FailureRate           float64      `json:"failureRate"`
+	AverageGasUsed        int64        `json:"averageGasUsed"`
+	AverageGasWanted      int64        `json:"averageGasWanted"`
+	MinGasUsed            int64        `json:"minGasUsed"`
+	MaxGasUsed            int64        `json:"maxGasUsed"`
+	P50GasUsed            int64        `json:"p50GasUsed"`
+	P90GasUsed            int64        `json:"p90GasUsed"`
+	OverProvisioningRatio float64      `json:"overProvisioningRatio"` // gas wanted / gas used
+}
+
+// GasBucket is a single gas used histogram bucket, with inclusive bounds
+type GasBucket struct {
+	From  int64  `json:"from"`
+	To    int64  `json:"to"`
+	Count uint64 `json:"count"`
+}
+
+// RealmFailures is the aggregation of the failed calls made to a single realm
+type RealmFailures struct {
+	PkgPath       string           `json:"pkgPath"`
+	Reasons       []*FailureReason `json:"reasons"` // most frequent first
+	TotalFailures uint64           `json:"totalFailures"`
+}
+
+// FailureReason is a single reason calls to a realm function failed for
+type FailureReason struct {
+	Func        string `json:"func"`
+	Codespace   string `json:"codespace"`
+	Code        string `json:"code"`
+	Name        string `json:"name"`
+	Message     string `json:"message"`
+	Count       uint64 `json:"count"`
+	FirstHeight int64  `json:"firstHeight"`
+	LastHeight  int64  `json:"lastHeight"`
+}
+
+// FeeHistoryBucket is the fee summary of the blocks produced within a single time bucket
+type FeeHistoryBucket struct {
+	Start          time.Time          `json:"start"` // inclusive
+	End            time.Time          `json:"end"`   // exclusive
+	Denoms         []*FeeHistoryDenom `json:"denoms"`
+	FromHeight     int64              `json:"fromHeight"`
+	ToHeight       int64              `json:"toHeight"`
+	Blocks         int64              `json:"blocks"` // number of blocks with transactions
+	TxCount        int64              `json:"txCount"`
+	TotalGasWanted int64              `json:"totalGasWanted"`
+	TotalGasUsed   int64              `json:"totalGasUsed"`
+}
+
+// FeeHistoryDenom is the fee summary of a single gas fee denomination, within a time bucket.
+// Amounts are whole transaction gas fees, and the median price is expressed as fee per unit of gas wanted
+type FeeHistoryDenom struct {
+	Denom       string  `json:"denom"`
+	TxCount     int64   `json:"txCount"`
+	TotalAmount int64   `json:"totalAmount"`
+	Low         int64   `json:"low"`
+	Average     int64   `json:"average"`
+	High        int64   `json:"high"`
+	MedianPrice float64 `json:"medianPrice"`
+}
+
+// ValidatorUptime is the signing activity of a single validator, within the most recent blocks
+type ValidatorUptime struct {
+	Address    string  `json:"address"`
+	Signed     uint64  `json:"signed"` // number of blocks signed
+	Missed     uint64  `json:"missed"` // number of blocks of its validator set it didn't sign
+	Uptime     float64 `json:"uptime"` // signed / (signed + missed)
+	FromHeight int64   `json:"fromHeight"`
+	ToHeight   int64   `json:"toHeight"`
+}
+
+// ProposerStats is the proposing activity of a single validator, within the most recent blocks
+type ProposerStats struct {
+	Address    string  `json:"address"`
+	Proposed   uint64  `json:"proposed"`   // number of blocks proposed
+	Share      float64 `json:"share"`      // share of the proposed blocks
+	LastHeight int64   `json:"lastHeight"` // height of the latest proposed block
+}
+
+// Event is a single Gno event, emitted by a transaction
+type Event struct {
+	TxHash     string            `json:"txHash"`
+	Type       string            `json:"type"`
+	PkgPath    string            `json:"pkgPath"`
+	Attrs      []*EventAttribute `json:"attrs"`
+	Height     int64             `json:"height"`
+	Index      uint32            `json:"index"`
+	EventIndex uint32            `json:"eventIndex"`
+}
+
+// EventAttribute is a single key / value attribute of a Gno event
+type EventAttribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
@@ -0,0 +1,182 @@
+package methods
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	bft_types "github.com/gnolang/gno/tm2/pkg/bft/types"
+
+	"github.com/gnolang/tx-indexer/storage"
+	"github.com/gnolang/tx-indexer/types"
+)
+
+const (
+	// DefaultValidatorWindow is the default number of most recent blocks
+	// the validator statistics are aggregated over
+	DefaultValidatorWindow = 10_000
+
+	// MaxValidatorWindow is the maximum number of most recent blocks
+	// the validator statistics can be aggregated over
+	MaxValidatorWindow = 100_000
+)
+
+// UptimeStorage is the storage the block signatures are read from
+type UptimeStorage interface {
+	// GetLatestHeight returns the latest block height from the storage
+	GetLatestHeight() (uint64, error)
+
+	// BlockSignaturesIterator iterates over the validator signatures of the blocks,
+	// limiting the results to be between the provided block numbers
+	BlockSignaturesIterator(fromBlockNum, toBlockNum uint64) (storage.Iterator[*types.BlockSignatures], error)
+}
+
+// ProposerStorage is the storage the block proposers are read from
+type ProposerStorage interface {
+	// GetLatestHeight returns the latest block height from the storage
+	GetLatestHeight() (uint64, error)
+
+	// BlockIterator iterates over Blocks, limiting the results to be between the provided block numbers
+	BlockIterator(fromBlockNum, toBlockNum uint64) (storage.Iterator[*bft_types.Block], error)
+}
+
+// validatorWindow returns the block range of the given number of most recent blocks
+// (the default window if 0)
+func validatorWindow(latestHeight, window uint64) (uint64, uint64) {
+	if window == 0 {
+		window = DefaultValidatorWindow
+	}
+
+	window = min(window, MaxValidatorWindow)
+
+	var fromBlockNum uint64
+
+	if latestHeight >= window {
+		fromBlockNum = latestHeight - window + 1
+	}
+
+	return fromBlockNum, latestHeight
+}
+
+// GetValidatorUptime aggregates the signatures of the given validator
+// in the given number of most recent blocks (the default window if 0).
+// Blocks the validator wasn't part of the validator set of are not accounted for
+func GetValidatorUptime(store UptimeStorage, address string, window uint64) (*ValidatorUptime, error) {
+	latestHeight, err := store.GetLatestHeight()
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch latest height, %w", err)
+	}
+
+	fromBlockNum, toBlockNum := validatorWindow(latestHeight, window)
+
+	it, err := store.BlockSignaturesIterator(fromBlockNum, toBlockNum)
+	if err != nil {
+		return nil, fmt.Errorf("unable to iterate block signatures, %w", err)
+	}
+
+	defer it.Close()
+
+	uptime := &ValidatorUptime{
+		Address:    address,
+		FromHeight: int64(fromBlockNum),
+		ToHeight:   int64(toBlockNum),
+	}
+
+	for it.Next() {
+		signatures, err := it.Value()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read block signatures, %w", err)
+		}
+
+		switch {
+		case slices.Contains(signatures.Signers, address):
+			uptime.Signed++
+		case slices.Contains(signatures.Missed, address):
+			uptime.Missed++
+		}
+	}
+
+	if err := it.Error(); err != nil {
+		return nil, fmt.Errorf("unable to iterate block signatures, %w", err)
+	}
+
+	if total := uptime.Signed + uptime.Missed; total > 0 {
+		uptime.Uptime = float64(uptime.Signed) / float64(total)
+	}
+
+	return uptime, nil
+}
+
+// GetProposerStats aggregates the proposers of the blocks
+// in the given number of most recent blocks (the default window if 0),
+// most active proposers first
+func GetProposerStats(store ProposerStorage, window uint64) ([]*ProposerStats, error) {
+	latestHeight, err := store.GetLatestHeight()
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch latest height, %w", err)
+	}
+
+	fromBlockNum, toBlockNum := validatorWindow(latestHeight, window)
+
+	it, err := store.BlockIterator(fromBlockNum, toBlockNum)
+	if err != nil {
+		return nil, fmt.Errorf("unable to iterate blocks, %w", err)
+	}
+
+	defer it.Close()
+
+	var (
+		stats     = make([]*ProposerStats, 0)
+		proposers = make(map[string]*ProposerStats)
+
+		total uint64
+	)
+
+	for it.Next() {
+		block, err := it.Value()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read block, %w", err)
+		}
+
+		// The genesis block has no proposer
+		if block.ProposerAddress.IsZero() {
+			continue
+		}
+
+		address := block.ProposerAddress.String()
+
+		proposer, ok := proposers[address]
+		if !ok {
+			proposer = &ProposerStats{
+				Address: address,
+			}
+
+			proposers[address] = proposer
+			stats = append(stats, proposer)
+		}
+
+		proposer.Proposed++
+		proposer.LastHeight = block.Height
+
+		total++
+	}
+
+	if err := it.Error(); err != nil {
+		return nil, fmt.Errorf("unable to iterate blocks, %w", err)
+	}
+
+	for _, proposer := range stats {
+		proposer.Share = float64(proposer.Proposed) / float64(total)
+	}
+
+	// Most active proposers first, by address on ties
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Proposed != stats[j].Proposed {
+			return stats[i].Proposed > stats[j].Proposed
+		}
+
+		return stats[i].Address < stats[j].Address
+	})
+
+	return stats, nil
+}
@@ -0,0 +1,171 @@
+package methods
+
+import (
+	"testing"
+
+	bft_types "github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/tx-indexer/storage"
+	"github.com/gnolang/tx-indexer/types"
+)
+
+// sliceIterator is an in-memory iterator over the given values
+type sliceIterator[T any] struct {
+	values []T
+	index  int
+}
+
+func newSliceIterator[T any](values []T) *sliceIterator[T] {
+	return &sliceIterator[T]{
+		values: values,
+		index:  -1,
+	}
+}
+
+func (i *sliceIterator[T]) Next() bool {
+	i.index++
+
+	return i.index < len(i.values)
+}
+
+func (i *sliceIterator[T]) Error() error {
+	return nil
+}
+
+func (i *sliceIterator[T]) Value() (T, error) {
+	return i.values[i.index], nil
+}
+
+func (i *sliceIterator[T]) Close() error {
+	return nil
+}
+
+// validatorStore is an in-memory block and block signatures storage
+type validatorStore struct {
+	blocks       []*bft_types.Block
+	signatures   []*types.BlockSignatures
+	latestHeight uint64
+}
+
+func (s *validatorStore) GetLatestHeight() (uint64, error) {
+	return s.latestHeight, nil
+}
+
+func (s *validatorStore) BlockSignaturesIterator(
+	fromBlockNum,
+	toBlockNum uint64,
+) (storage.Iterator[*types.BlockSignatures], error) {
+	signatures := make([]*types.BlockSignatures, 0)
+
+	for _, signature := range s.signatures {
+		height := uint64(signature.Height)
+
+		if height >= fromBlockNum && height <= toBlockNum {
+			signatures = append(signatures, signature)
+		}
+	}
+
+	return newSliceIterator(signatures), nil
+}
+
+func (s *validatorStore) BlockIterator(fromBlockNum, toBlockNum uint64) (storage.Iterator[*bft_types.Block], error) {
+	blocks := make([]*bft_types.Block, 0)
+
+	for _, block := range s.blocks {
+		height := uint64(block.Height)
+
+		if height >= fromBlockNum && height <= toBlockNum {
+			blocks = append(blocks, block)
+		}
+	}
+
+	return newSliceIterator(blocks), nil
+}
+
+func TestGetValidatorUptime(t *testing.T) {
+	t.Parallel()
+
+	store := &validatorStore{
+		signatures: []*types.BlockSignatures{
+			// Out of the window
+			{Missed: []string{"g1alice"}, Height: 1},
+			{Signers: []string{"g1alice"}, Height: 2},
+			{Signers: []string{"g1alice", "g1bob"}, Height: 3},
+			{Signers: []string{"g1bob"}, Missed: []string{"g1alice"}, Height: 4},
+			{Signers: []string{"g1alice"}, Missed: []string{"g1bob"}, Height: 5},
+		},
+		latestHeight: 5,
+	}
+
+	uptime, err := GetValidatorUptime(store, "g1alice", 4)
+	require.NoError(t, err)
+
+	assert.Equal(t, &ValidatorUptime{
+		Address:    "g1alice",
+		Signed:     3,
+		Missed:     1,
+		Uptime:     0.75,
+		FromHeight: 2,
+		ToHeight:   5,
+	}, uptime)
+
+	// Blocks the validator isn't part of the set of are not accounted for
+	uptime, err = GetValidatorUptime(store, "g1carol", 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, &ValidatorUptime{
+		Address:  "g1carol",
+		ToHeight: 5,
+	}, uptime)
+}
+
+func TestGetProposerStats(t *testing.T) {
+	t.Parallel()
+
+	var (
+		alice = crypto.AddressFromPreimage([]byte("alice"))
+		bob   = crypto.AddressFromPreimage([]byte("bob"))
+	)
+
+	newBlock := func(height int64, proposer crypto.Address) *bft_types.Block {
+		return &bft_types.Block{
+			Header: bft_types.Header{
+				Height:          height,
+				ProposerAddress: proposer,
+			},
+		}
+	}
+
+	store := &validatorStore{
+		blocks: []*bft_types.Block{
+			// The genesis block has no proposer
+			newBlock(0, crypto.Address{}),
+			newBlock(1, bob),
+			newBlock(2, alice),
+			newBlock(3, alice),
+			newBlock(4, bob),
+			newBlock(5, alice),
+		},
+		latestHeight: 5,
+	}
+
+	stats, err := GetProposerStats(store, 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, []*ProposerStats{
+		{Address: alice.String(), Proposed: 3, Share: 0.6, LastHeight: 5},
+		{Address: bob.String(), Proposed: 2, Share: 0.4, LastHeight: 4},
+	}, stats)
+
+	// Make sure the window is respected
+	stats, err = GetProposerStats(store, 2)
+	require.NoError(t, err)
+
+	assert.Equal(t, []*ProposerStats{
+		{Address: alice.String(), Proposed: 1, Share: 0.5, LastHeight: 5},
+		{Address: bob.String(), Proposed: 1, Share: 0.5, LastHeight: 4},
+	}, stats)
+}
@@ -1,6 +1,7 @@
 package spec
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 )
@@ -168,3 +169,17 @@ func ParseObjectParameter[T any](param any, data *T) error {
 
 	return nil
 }
+
+// ParseStrictObjectParameter parses the object parameter,
+// rejecting the fields unknown to the parsed type
+func ParseStrictObjectParameter[T any](param any, data *T) error {
+	marshaled, err := json.Marshal(param)
+	if err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(marshaled))
+	decoder.DisallowUnknownFields()
+
+	return decoder.Decode(data)
+}
@@ -0,0 +1,261 @@
+package sse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gnolang/tx-indexer/serve/conns"
+)
+
+// writeTimeout is the time a single event write can take,
+// before the client is considered gone
+const writeTimeout = 10 * time.Second
+
+var (
+	errConnClosed     = errors.New("SSE connection closed")
+	errInvalidEventID = errors.New("invalid event ID")
+)
+
+// cursor is the position of an event in the chain, parsed from
+// its event ID (`height`, `height-index` or `height-index-eventIndex`)
+type cursor struct {
+	height     uint64
+	index      uint32
+	eventIndex uint32
+}
+
+// parseCursor parses the cursor from the event ID
+func parseCursor(eventID string) (cursor, error) {
+	parts := strings.Split(eventID, "-")
+	if len(parts) > 3 {
+		return cursor{}, fmt.Errorf("%w, %q", errInvalidEventID, eventID)
+	}
+
+	height, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return cursor{}, fmt.Errorf("%w, %q", errInvalidEventID, eventID)
+	}
+
+	indexes := make([]uint32, 2)
+
+	for i, part := range parts[1:] {
+		index, err := strconv.ParseUint(part, 10, 32)
+		if err != nil {
+			return cursor{}, fmt.Errorf("%w, %q", errInvalidEventID, eventID)
+		}
+
+		indexes[i] = uint32(index)
+	}
+
+	return cursor{
+		height:     height,
+		index:      indexes[0],
+		eventIndex: indexes[1],
+	}, nil
+}
+
+// after returns a flag indicating if the cursor is past the given one
+func (c cursor) after(other cursor) bool {
+	if c.height != other.height {
+		return c.height > other.height
+	}
+
+	if c.index != other.index {
+		return c.index > other.index
+	}
+
+	return c.eventIndex > other.eventIndex
+}
+
+// pendingEvent is a live event, received while resuming
+type pendingEvent struct {
+	data    any
+	eventID string
+}
+
+// conn is a single SSE connection, streaming the events of one type
+type conn struct {
+	ctx context.Context
+
+	w  http.ResponseWriter
+	rc *http.ResponseController
+
+	// last is the cursor of the last written event, if any.
+	// Events that are not past it are skipped, so the events
+	// replayed from storage and the live ones are never duplicated
+	last *cursor
+
+	eventType string
+
+	// pending are the live events received while resuming
+	pending []pendingEvent
+
+	mu sync.Mutex
+
+	resuming bool
+	closed   bool
+}
+
+func newConn(ctx context.Context, w http.ResponseWriter, eventType string) *conn {
+	return &conn{
+		ctx:       ctx,
+		w:         w,
+		rc:        http.NewResponseController(w),
+		eventType: eventType,
+	}
+}
+
+// WriteData writes the data as an event without an ID
+func (c *conn) WriteData(data any) error {
+	return c.WriteEvent("", data)
+}
+
+// WriteEvent writes the data as an event with the given ID.
+// Live events received while resuming are written once resumed
+func (c *conn) WriteEvent(eventID string, data any) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.resuming {
+		if c.closed || c.ctx.Err() != nil {
+			return errConnClosed
+		}
+
+		c.pending = append(c.pending, pendingEvent{eventID: eventID, data: data})
+
+		return nil
+	}
+
+	return c.write(eventID, data)
+}
+
+// resume writes the events replayed past the given cursor,
+// followed by the live events received in the meantime.
+// The replayed events are written through the given connection
+func (c *conn) resume(from cursor, replay func(conns.WSConnection) error) error {
+	c.mu.Lock()
+	c.last = &from
+	c.resuming = true
+	c.mu.Unlock()
+
+	replayErr := replay(&directConn{c: c})
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.resuming = false
+
+	pending := c.pending
+	c.pending = nil
+
+	if replayErr != nil {
+		return replayErr
+	}
+
+	for _, event := range pending {
+		if err := c.write(event.eventID, event.data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// keepAlive writes a comment, so proxies don't close the idle connection
+func (c *conn) keepAlive() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.writeRaw(": keep-alive\n\n")
+}
+
+// close marks the connection as closed, so nothing is written
+// to the response once the request is handled
+func (c *conn) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.closed = true
+}
+
+// write writes the event, unless it's not past the last written one.
+// Must be called with the lock held
+func (c *conn) write(eventID string, data any) error {
+	var eventCursor *cursor
+
+	if eventID != "" {
+		parsed, err := parseCursor(eventID)
+		if err != nil {
+			return err
+		}
+
+		if c.last != nil && !parsed.after(*c.last) {
+			return nil
+		}
+
+		eventCursor = &parsed
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("unable to encode event, %w", err)
+	}
+
+	var event strings.Builder
+
+	if eventID != "" {
+		fmt.Fprintf(&event, "id: %s\n", eventID)
+	}
+
+	fmt.Fprintf(&event, "event: %s\ndata: %s\n\n", c.eventType, payload)
+
+	if err := c.writeRaw(event.String()); err != nil {
+		return err
+	}
+
+	if eventCursor != nil {
+		c.last = eventCursor
+	}
+
+	return nil
+}
+
+// writeRaw writes and flushes the raw event stream data.
+// Must be called with the lock held
+func (c *conn) writeRaw(data string) error {
+	if c.closed || c.ctx.Err() != nil {
+		return errConnClosed
+	}
+
+	//nolint:errcheck // Not all response writers support deadlines
+	c.rc.SetWriteDeadline(time.Now().Add(writeTimeout))
+
+	if _, err := c.w.Write([]byte(data)); err != nil {
+		return err
+	}
+
+	return c.rc.Flush()
+}
+
+// directConn writes the events right away, even while resuming
+type directConn struct {
+	c *conn
+}
+
+func (d *directConn) WriteData(data any) error {
+	return d.WriteEvent("", data)
+}
+
+func (d *directConn) WriteEvent(eventID string, data any) error {
+	d.c.mu.Lock()
+	defer d.c.mu.Unlock()
+
+	return d.c.write(eventID, data)
+}
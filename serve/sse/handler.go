@@ -0,0 +1,380 @@
+package sse
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"go.uber.org/zap"
+
+	"github.com/gnolang/tx-indexer/serve/conns"
+	"github.com/gnolang/tx-indexer/serve/filters"
+	"github.com/gnolang/tx-indexer/serve/filters/filter"
+	filterSubscription "github.com/gnolang/tx-indexer/serve/filters/subscription"
+	"github.com/gnolang/tx-indexer/serve/methods"
+	commonTypes "github.com/gnolang/tx-indexer/types"
+)
+
+const (
+	// DefaultKeepAliveInterval is the default interval
+	// for the keep-alive comments sent on idle streams
+	DefaultKeepAliveInterval = 15 * time.Second
+
+	// DefaultMaxResumeBlocks is the default maximum amount
+	// of blocks replayed from storage when a stream is resumed
+	DefaultMaxResumeBlocks uint64 = 1000
+
+	// lastEventIDHeader is the header set by SSE clients when reconnecting
+	lastEventIDHeader = "Last-Event-ID"
+)
+
+// subscription writes the notifications of a single subscription
+type subscription interface {
+	Matches(data any) bool
+	WriteResponse(id string, data any) error
+}
+
+// stream defines how the events of a single type are streamed
+type stream struct {
+	// subscribe creates the live subscription, returning its ID
+	subscribe func(conns.WSConnection) string
+
+	// newSubscription creates the subscription the replayed events are written with
+	newSubscription func(conns.WSConnection) subscription
+
+	// replay replays the subscription data past the cursor from storage
+	replay func(from cursor, writeFn func(data any) error) error
+}
+
+// Handler serves the subscription events as Server-Sent Events,
+// using the same notification payloads as the WS subscriptions
+type Handler struct {
+	filterManager *filters.Manager
+	storage       Storage
+	logger        *zap.Logger
+
+	keepAliveInterval time.Duration
+	maxResumeBlocks   uint64
+}
+
+// NewHandler creates a new SSE handler
+func NewHandler(filterManager *filters.Manager, storage Storage, opts ...Option) *Handler {
+	h := &Handler{
+		filterManager:     filterManager,
+		storage:           storage,
+		logger:            zap.NewNop(),
+		keepAliveInterval: DefaultKeepAliveInterval,
+		maxResumeBlocks:   DefaultMaxResumeBlocks,
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+// ServeHTTP streams the events of the requested type (`type` query parameter),
+// narrowed down by the JSON subscription filter (`filter` query parameter), if any.
+// The stream resumes past the Last-Event-ID header, if set
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var (
+		eventType = r.URL.Query().Get("type")
+		rawFilter = r.URL.Query().Get("filter")
+	)
+
+	var subscriptionFilter *filter.SubscriptionFilter
+
+	if rawFilter != "" {
+		parsed, err := parseFilter(rawFilter)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+
+		subscriptionFilter = parsed
+	}
+
+	s, err := h.streamFor(eventType, subscriptionFilter)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	var from *cursor
+
+	if lastEventID := r.Header.Get(lastEventIDHeader); lastEventID != "" {
+		parsed, err := parseCursor(lastEventID)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+
+		from = &parsed
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	c := newConn(r.Context(), w, eventType)
+	defer c.close()
+
+	// Subscribe before replaying, so no live event is missed in between
+	id := s.subscribe(c)
+	defer h.filterManager.UninstallSubscription(id)
+
+	if from != nil {
+		err := c.resume(*from, func(direct conns.WSConnection) error {
+			sub := s.newSubscription(direct)
+
+			return s.replay(*from, func(data any) error {
+				if !sub.Matches(data) {
+					return nil
+				}
+
+				return sub.WriteResponse(id, data)
+			})
+		})
+		if err != nil {
+			h.logger.Debug("unable to resume SSE stream", zap.Error(err))
+
+			return
+		}
+	}
+
+	// Flush the headers, signaling the stream is live
+	if err := c.keepAlive(); err != nil {
+		return
+	}
+
+	ticker := time.NewTicker(h.keepAliveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-ticker.C:
+			if err := c.keepAlive(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// streamFor returns the stream definition for the event type.
+// Only transaction and event streams can be filtered
+func (h *Handler) streamFor(eventType string, subscriptionFilter *filter.SubscriptionFilter) (*stream, error) {
+	switch eventType {
+	case filterSubscription.NewTransactionsEvent:
+		return &stream{
+			subscribe: func(conn conns.WSConnection) string {
+				return h.filterManager.NewTransactionSubscription(conn, subscriptionFilter)
+			},
+			newSubscription: func(conn conns.WSConnection) subscription {
+				return filterSubscription.NewTransactionSubscription(conn, subscriptionFilter)
+			},
+			replay: h.replayTxs,
+		}, nil
+	case filterSubscription.NewEventsEvent:
+		return &stream{
+			subscribe: func(conn conns.WSConnection) string {
+				return h.filterManager.NewEventSubscription(conn, subscriptionFilter)
+			},
+			newSubscription: func(conn conns.WSConnection) subscription {
+				return filterSubscription.NewEventSubscription(conn, subscriptionFilter)
+			},
+			replay: h.replayEvents,
+		}, nil
+	}
+
+	if subscriptionFilter != nil {
+		return nil, fmt.Errorf("filters are not supported for %q events", eventType)
+	}
+
+	switch eventType {
+	case filterSubscription.NewHeadsEvent:
+		return &stream{
+			subscribe: h.filterManager.NewBlockSubscription,
+			newSubscription: func(conn conns.WSConnection) subscription {
+				return filterSubscription.NewBlockSubscription(conn)
+			},
+			replay: h.replayBlocks,
+		}, nil
+	case filterSubscription.NewGasPriceEvent:
+		return &stream{
+			subscribe: h.filterManager.NewGasPriceSubscription,
+			newSubscription: func(conn conns.WSConnection) subscription {
+				return filterSubscription.NewGasPriceSubscription(conn)
+			},
+			replay: h.replayGasPrices,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported event type %q", eventType)
+	}
+}
+
+// replayBlocks replays the blocks past the cursor
+func (h *Handler) replayBlocks(from cursor, writeFn func(any) error) error {
+	fromHeight, err := h.resumeHeight(from.height + 1)
+	if err != nil {
+		return err
+	}
+
+	it, err := h.storage.BlockIterator(fromHeight, 0)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for it.Next() {
+		block, err := it.Value()
+		if err != nil {
+			return err
+		}
+
+		if err := writeFn(block); err != nil {
+			return err
+		}
+	}
+
+	return it.Error()
+}
+
+// replayGasPrices replays the gas prices of the blocks
+// with transactions past the cursor, using their fee summaries
+func (h *Handler) replayGasPrices(from cursor, writeFn func(any) error) error {
+	fromHeight, err := h.resumeHeight(from.height + 1)
+	if err != nil {
+		return err
+	}
+
+	fees, err := methods.GetBlockFees(h.storage, fromHeight, 0)
+	if err != nil {
+		return err
+	}
+
+	for _, blockFees := range fees {
+		gasPrices, err := methods.GetGasPricesByFees([]*commonTypes.BlockFees{blockFees})
+		if err != nil {
+			// Same as live events, blocks without gas prices are skipped
+			continue
+		}
+
+		if err := writeFn(&filterSubscription.BlockGasPrices{
+			Height:    blockFees.Height,
+			GasPrices: gasPrices,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// replayTxs replays the transactions past the cursor
+func (h *Handler) replayTxs(from cursor, writeFn func(any) error) error {
+	return h.replayTxsFrom(from, from.index+1, writeFn)
+}
+
+// replayEvents replays the Gno events past the cursor
+func (h *Handler) replayEvents(from cursor, writeFn func(any) error) error {
+	// The transaction of the cursor is replayed as well,
+	// since its events past the cursor were not delivered
+	return h.replayTxsFrom(from, from.index, func(data any) error {
+		tx, ok := data.(*types.TxResult)
+		if !ok {
+			return nil
+		}
+
+		for _, event := range methods.GetEventsByTx(tx) {
+			if err := writeFn(&filterSubscription.TxEvent{Tx: tx, Event: event}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// replayTxsFrom replays the transactions starting from
+// the given index of the cursor height
+func (h *Handler) replayTxsFrom(from cursor, fromIndex uint32, writeFn func(any) error) error {
+	fromHeight, err := h.resumeHeight(from.height)
+	if err != nil {
+		return err
+	}
+
+	if fromHeight != from.height {
+		fromIndex = 0
+	}
+
+	// The index bounds of the iterator apply to every block,
+	// so the transactions of the cursor height are skipped manually
+	it, err := h.storage.TxIterator(fromHeight, 0, 0, 0)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for it.Next() {
+		tx, err := it.Value()
+		if err != nil {
+			return err
+		}
+
+		if uint64(tx.Height) == fromHeight && tx.Index < fromIndex {
+			continue
+		}
+
+		if err := writeFn(tx); err != nil {
+			return err
+		}
+	}
+
+	return it.Error()
+}
+
+// resumeHeight returns the height the replay starts from,
+// limited to the latest maxResumeBlocks blocks
+func (h *Handler) resumeHeight(from uint64) (uint64, error) {
+	latest, err := h.storage.GetLatestHeight()
+	if err != nil {
+		return 0, err
+	}
+
+	if latest > h.maxResumeBlocks && from < latest-h.maxResumeBlocks {
+		return latest - h.maxResumeBlocks, nil
+	}
+
+	return from, nil
+}
+
+// parseFilter parses the JSON subscription filter
+func parseFilter(rawFilter string) (*filter.SubscriptionFilter, error) {
+	var options filter.SubscriptionFilterOption
+
+	// Unknown (misspelled) fields are rejected, instead of silently matching everything
+	decoder := json.NewDecoder(strings.NewReader(rawFilter))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&options); err != nil {
+		return nil, fmt.Errorf("invalid filter options, %w", err)
+	}
+
+	parsed, err := filter.NewSubscriptionFilter(options)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter options, %w", err)
+	}
+
+	return parsed, nil
+}
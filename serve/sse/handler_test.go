@@ -0,0 +1,530 @@
+package sse
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gnolang/gno/gnovm/stdlibs/chain"
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnolang/tx-indexer/events"
+	"github.com/gnolang/tx-indexer/internal/mock"
+	"github.com/gnolang/tx-indexer/serve/filters"
+	"github.com/gnolang/tx-indexer/serve/spec"
+	commonTypes "github.com/gnolang/tx-indexer/types"
+)
+
+// sseEvent is a single parsed SSE event
+type sseEvent struct {
+	id    string
+	event string
+	data  string
+}
+
+// newTestServer creates a new SSE test server, returning the
+// channel the new block events are pushed to
+func newTestServer(t *testing.T, storage Storage) (*httptest.Server, chan events.Event) {
+	t.Helper()
+
+	var (
+		blockCh = make(chan events.Event)
+
+		mockEvents = &mock.Events{
+			SubscribeFn: func(_ []events.Type) *events.Subscription {
+				return &events.Subscription{
+					SubCh: blockCh,
+				}
+			},
+		}
+	)
+
+	ctx, cancelFn := context.WithCancel(context.Background())
+	t.Cleanup(cancelFn)
+
+	fm := filters.NewFilterManager(ctx, &mock.Storage{}, mockEvents)
+
+	server := httptest.NewServer(NewHandler(fm, storage))
+	t.Cleanup(server.Close)
+
+	return server, blockCh
+}
+
+// openStream opens the event stream, resuming past the given event ID, if any
+func openStream(t *testing.T, url, lastEventID string) *bufio.Reader {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, url, nil)
+	require.NoError(t, err)
+
+	if lastEventID != "" {
+		req.Header.Set(lastEventIDHeader, lastEventID)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = resp.Body.Close()
+	})
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	return bufio.NewReader(resp.Body)
+}
+
+// readEvent reads the next event from the stream, skipping comments
+func readEvent(t *testing.T, r *bufio.Reader) sseEvent {
+	t.Helper()
+
+	var event sseEvent
+
+	for {
+		line, err := r.ReadString('\n')
+		require.NoError(t, err)
+
+		line = strings.TrimSuffix(line, "\n")
+
+		switch {
+		case line == "":
+			if event.event != "" {
+				return event
+			}
+		case strings.HasPrefix(line, ":"):
+			// Comment
+		case strings.HasPrefix(line, "id: "):
+			event.id = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "event: "):
+			event.event = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			event.data = strings.TrimPrefix(line, "data: ")
+		}
+	}
+}
+
+// readKeepAlive reads the keep-alive comment, signaling the stream is live
+func readKeepAlive(t *testing.T, r *bufio.Reader) {
+	t.Helper()
+
+	line, err := r.ReadString('\n')
+	require.NoError(t, err)
+
+	require.Equal(t, ": keep-alive\n", line)
+}
+
+func TestParseCursor(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name     string
+		eventID  string
+		expected cursor
+		valid    bool
+	}{
+		{
+			"block event",
+			"10",
+			cursor{height: 10},
+			true,
+		},
+		{
+			"tx event",
+			"10-2",
+			cursor{height: 10, index: 2},
+			true,
+		},
+		{
+			"Gno event",
+			"10-2-1",
+			cursor{height: 10, index: 2, eventIndex: 1},
+			true,
+		},
+		{
+			"invalid height",
+			"ten",
+			cursor{},
+			false,
+		},
+		{
+			"invalid index",
+			"10-two",
+			cursor{},
+			false,
+		},
+		{
+			"invalid event index",
+			"10-2-one",
+			cursor{},
+			false,
+		},
+		{
+			"too many parts",
+			"10-2-1-0",
+			cursor{},
+			false,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			parsed, err := parseCursor(testCase.eventID)
+			if !testCase.valid {
+				assert.ErrorIs(t, err, errInvalidEventID)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, parsed)
+		})
+	}
+}
+
+func TestParseFilter(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name     string
+		filter   string
+		expected string
+	}{
+		{
+			"malformed JSON",
+			`{"success":`,
+			"invalid filter options, unexpected EOF",
+		},
+		{
+			"misspelled field",
+			`{"package":"gno.land/r/demo/foo20"}`,
+			`invalid filter options, json: unknown field "package"`,
+		},
+		{
+			"invalid field type",
+			`{"success":"yes"}`,
+			"invalid filter options, json: cannot unmarshal string",
+		},
+		{
+			"invalid range",
+			`{"gasUsed":{"min":10,"max":1}}`,
+			"invalid filter options, range min is greater than max for gasUsed",
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			parsed, err := parseFilter(testCase.filter)
+			assert.Nil(t, parsed)
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), testCase.expected)
+		})
+	}
+}
+
+func TestHandler_InvalidRequest(t *testing.T) {
+	t.Parallel()
+
+	server, _ := newTestServer(t, &mockStorage{})
+
+	testTable := []struct {
+		name        string
+		query       string
+		lastEventID string
+	}{
+		{
+			"missing type",
+			"",
+			"",
+		},
+		{
+			"unsupported type",
+			"?type=newEverything",
+			"",
+		},
+		{
+			"invalid last event ID",
+			"?type=newHeads",
+			"latest",
+		},
+		{
+			"invalid filter",
+			"?type=newTransactions&filter=" + url.QueryEscape(`{"success":"yes"}`),
+			"",
+		},
+		{
+			"misspelled filter field",
+			"?type=newTransactions&filter=" + url.QueryEscape(`{"package":"gno.land/r/demo/foo20"}`),
+			"",
+		},
+		{
+			"invalid filter range",
+			"?type=newTransactions&filter=" + url.QueryEscape(`{"gasUsed":{"min":10,"max":1}}`),
+			"",
+		},
+		{
+			"unsupported filter",
+			"?type=newHeads&filter=" + url.QueryEscape(`{"pkgPath":"gno.land/r/demo/foo20"}`),
+			"",
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			req, err := http.NewRequestWithContext(
+				context.Background(),
+				http.MethodGet,
+				server.URL+testCase.query,
+				nil,
+			)
+			require.NoError(t, err)
+
+			if testCase.lastEventID != "" {
+				req.Header.Set(lastEventIDHeader, testCase.lastEventID)
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		})
+	}
+}
+
+func TestHandler_LiveEvents(t *testing.T) {
+	t.Parallel()
+
+	server, blockCh := newTestServer(t, &mockStorage{})
+
+	stream := openStream(t, server.URL+"?type=newTransactions", "")
+	readKeepAlive(t, stream)
+
+	tx := &types.TxResult{
+		Height: 5,
+		Index:  1,
+		Tx:     types.Tx("tx"),
+	}
+
+	blockCh <- &commonTypes.NewBlock{
+		Block: &types.Block{
+			Header: types.Header{Height: 5},
+		},
+		Results: []*types.TxResult{tx},
+	}
+
+	event := readEvent(t, stream)
+
+	assert.Equal(t, "5-1", event.id)
+	assert.Equal(t, "newTransactions", event.event)
+
+	// Make sure the payload matches the WS notifications
+	var response spec.BaseJSONSubscribeResponse
+
+	require.NoError(t, json.Unmarshal([]byte(event.data), &response))
+
+	assert.Equal(t, spec.SubscriptionMethod, response.Method)
+	assert.NotEmpty(t, response.Params.Subscription)
+	assert.NotEmpty(t, response.Params.Result)
+}
+
+func TestHandler_Resume(t *testing.T) {
+	t.Parallel()
+
+	blocks := make([]*types.Block, 0, 3)
+
+	for height := int64(1); height <= 3; height++ {
+		blocks = append(blocks, &types.Block{
+			Header: types.Header{Height: height},
+		})
+	}
+
+	server, blockCh := newTestServer(t, &mockStorage{blocks: blocks})
+
+	// Resume past the first block
+	stream := openStream(t, server.URL+"?type=newHeads", "1")
+
+	// Make sure the missed blocks are replayed from storage
+	assert.Equal(t, "2", readEvent(t, stream).id)
+	assert.Equal(t, "3", readEvent(t, stream).id)
+
+	readKeepAlive(t, stream)
+
+	// Make sure already delivered blocks are not sent again
+	for height := int64(3); height <= 4; height++ {
+		blockCh <- &commonTypes.NewBlock{
+			Block: &types.Block{
+				Header: types.Header{Height: height},
+			},
+		}
+	}
+
+	assert.Equal(t, "4", readEvent(t, stream).id)
+}
+
+func TestHandler_ResumeGasPrices(t *testing.T) {
+	t.Parallel()
+
+	newFees := func(height int64) *commonTypes.BlockFees {
+		return &commonTypes.BlockFees{
+			Height: height,
+			Txs: []commonTypes.TxFee{
+				{Denom: "ugnot", Amount: 10, GasWanted: 100},
+			},
+		}
+	}
+
+	var (
+		blocks = []*types.Block{
+			{Header: types.Header{Height: 1}},
+			{Header: types.Header{Height: 2}},
+			{Header: types.Header{Height: 3}},
+		}
+
+		// The first block has no transactions, so no fee summary
+		fees = []*commonTypes.BlockFees{
+			newFees(2),
+			newFees(3),
+		}
+	)
+
+	server, blockCh := newTestServer(t, &mockStorage{blocks: blocks, fees: fees})
+
+	// Resume past the first block
+	stream := openStream(t, server.URL+"?type=newGasPrice", "1")
+
+	// Make sure the missed gas prices are replayed from the fee summaries
+	assert.Equal(t, "2", readEvent(t, stream).id)
+	assert.Equal(t, "3", readEvent(t, stream).id)
+
+	readKeepAlive(t, stream)
+
+	// Make sure live gas prices are computed from the indexed fee summary,
+	// and blocks without one are skipped
+	blockCh <- &commonTypes.NewBlock{
+		Block: &types.Block{
+			Header: types.Header{Height: 4},
+		},
+	}
+
+	blockCh <- &commonTypes.NewBlock{
+		Block: &types.Block{
+			Header: types.Header{Height: 5},
+		},
+		Fees: newFees(5),
+	}
+
+	event := readEvent(t, stream)
+
+	assert.Equal(t, "5", event.id)
+	assert.Contains(t, event.data, "ugnot")
+}
+
+func TestHandler_ResumeTransactions(t *testing.T) {
+	t.Parallel()
+
+	var (
+		blocks = []*types.Block{
+			{Header: types.Header{Height: 1}},
+			{Header: types.Header{Height: 2}},
+		}
+
+		txs = []*types.TxResult{
+			{Height: 1, Index: 0, Tx: types.Tx("tx")},
+			{Height: 1, Index: 1, Tx: types.Tx("tx")},
+			{Height: 1, Index: 2, Tx: types.Tx("tx")},
+			{Height: 2, Index: 0, Tx: types.Tx("tx")},
+		}
+	)
+
+	server, _ := newTestServer(t, &mockStorage{blocks: blocks, txs: txs})
+
+	// Resume past the second transaction of the first block
+	stream := openStream(t, server.URL+"?type=newTransactions", "1-1")
+
+	// Make sure the transactions of the following blocks are replayed in full
+	assert.Equal(t, "1-2", readEvent(t, stream).id)
+	assert.Equal(t, "2-0", readEvent(t, stream).id)
+
+	readKeepAlive(t, stream)
+}
+
+func TestHandler_ResumeFilteredEvents(t *testing.T) {
+	t.Parallel()
+
+	newTx := func(height int64, index uint32, eventTypes ...string) *types.TxResult {
+		txEvents := make([]abci.Event, 0, len(eventTypes))
+
+		for _, eventType := range eventTypes {
+			txEvents = append(txEvents, chain.Event{
+				Type:    eventType,
+				PkgPath: "gno.land/r/demo/foo20",
+			})
+		}
+
+		return &types.TxResult{
+			Height: height,
+			Index:  index,
+			Tx:     types.Tx("tx"),
+			Response: abci.ResponseDeliverTx{
+				ResponseBase: abci.ResponseBase{
+					Events: txEvents,
+				},
+			},
+		}
+	}
+
+	var (
+		blocks = []*types.Block{
+			{Header: types.Header{Height: 1}},
+			{Header: types.Header{Height: 2}},
+		}
+
+		txs = []*types.TxResult{
+			newTx(1, 0, "Transfer", "Approval", "Transfer"),
+			newTx(2, 0, "Approval", "Transfer"),
+		}
+	)
+
+	server, blockCh := newTestServer(t, &mockStorage{blocks: blocks, txs: txs})
+
+	// Resume past the first transfer event
+	stream := openStream(
+		t,
+		server.URL+"?type=newEvents&filter="+url.QueryEscape(`{"eventType":"Transfer"}`),
+		"1-0-0",
+	)
+
+	// Make sure only the missed matching events are replayed
+	assert.Equal(t, "1-0-2", readEvent(t, stream).id)
+	assert.Equal(t, "2-0-1", readEvent(t, stream).id)
+
+	readKeepAlive(t, stream)
+
+	blockCh <- &commonTypes.NewBlock{
+		Block: &types.Block{
+			Header: types.Header{Height: 3},
+		},
+		Results: []*types.TxResult{newTx(3, 0, "Approval", "Transfer")},
+	}
+
+	event := readEvent(t, stream)
+
+	assert.Equal(t, "3-0-1", event.id)
+	assert.Equal(t, "newEvents", event.event)
+}
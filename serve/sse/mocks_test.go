@@ -0,0 +1,92 @@
+package sse
+
+import (
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+
+	"github.com/gnolang/tx-indexer/storage"
+	commonTypes "github.com/gnolang/tx-indexer/types"
+)
+
+type mockStorage struct {
+	blocks []*types.Block
+	txs    []*types.TxResult
+	fees   []*commonTypes.BlockFees
+}
+
+func (m *mockStorage) GetLatestHeight() (uint64, error) {
+	if len(m.blocks) == 0 {
+		return 0, nil
+	}
+
+	return uint64(m.blocks[len(m.blocks)-1].Height), nil
+}
+
+func (m *mockStorage) BlockIterator(fromBlockNum, _ uint64) (storage.Iterator[*types.Block], error) {
+	values := make([]*types.Block, 0, len(m.blocks))
+
+	for _, block := range m.blocks {
+		if uint64(block.Height) >= fromBlockNum {
+			values = append(values, block)
+		}
+	}
+
+	return &mockIterator[*types.Block]{values: values}, nil
+}
+
+func (m *mockStorage) BlockFeesIterator(fromBlockNum, _ uint64) (storage.Iterator[*commonTypes.BlockFees], error) {
+	values := make([]*commonTypes.BlockFees, 0, len(m.fees))
+
+	for _, fees := range m.fees {
+		if uint64(fees.Height) >= fromBlockNum {
+			values = append(values, fees)
+		}
+	}
+
+	return &mockIterator[*commonTypes.BlockFees]{values: values}, nil
+}
+
+func (m *mockStorage) TxIterator(
+	fromBlockNum,
+	_ uint64,
+	fromTxIndex,
+	_ uint32,
+) (storage.Iterator[*types.TxResult], error) {
+	values := make([]*types.TxResult, 0, len(m.txs))
+
+	// The index bounds apply to every block, as in the storage
+	for _, tx := range m.txs {
+		if uint64(tx.Height) >= fromBlockNum && tx.Index >= fromTxIndex {
+			values = append(values, tx)
+		}
+	}
+
+	return &mockIterator[*types.TxResult]{values: values}, nil
+}
+
+// mockIterator iterates over the given values
+type mockIterator[T any] struct {
+	values []T
+	index  int
+}
+
+func (m *mockIterator[T]) Next() bool {
+	if m.index >= len(m.values) {
+		return false
+	}
+
+	m.index++
+
+	return true
+}
+
+func (m *mockIterator[T]) Error() error {
+	return nil
+}
+
+func (m *mockIterator[T]) Value() (T, error) {
+	return m.values[m.index-1], nil
+}
+
+func (m *mockIterator[T]) Close() error {
+	return nil
+}
@@ -0,0 +1,33 @@
+package sse
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type Option func(h *Handler)
+
+// WithLogger sets the logger to be used
+// with the SSE handler
+func WithLogger(logger *zap.Logger) Option {
+	return func(h *Handler) {
+		h.logger = logger
+	}
+}
+
+// WithKeepAliveInterval sets the interval for
+// the keep-alive comments sent on idle streams
+func WithKeepAliveInterval(interval time.Duration) Option {
+	return func(h *Handler) {
+		h.keepAliveInterval = interval
+	}
+}
+
+// WithMaxResumeBlocks sets the maximum amount of
+// blocks replayed from storage when a stream is resumed
+func WithMaxResumeBlocks(maxBlocks uint64) Option {
+	return func(h *Handler) {
+		h.maxResumeBlocks = maxBlocks
+	}
+}
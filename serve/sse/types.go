@@ -0,0 +1,25 @@
+package sse
+
+import (
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+
+	"github.com/gnolang/tx-indexer/storage"
+	commonTypes "github.com/gnolang/tx-indexer/types"
+)
+
+// Storage is the storage the missed events are replayed from
+type Storage interface {
+	// GetLatestHeight returns the latest block height from the storage
+	GetLatestHeight() (uint64, error)
+
+	// BlockIterator iterates over Blocks, limiting the results to be between the provided block numbers
+	BlockIterator(fromBlockNum, toBlockNum uint64) (storage.Iterator[*types.Block], error)
+
+	// BlockFeesIterator iterates over the block fee summaries,
+	// limiting the results to be between the provided block numbers
+	BlockFeesIterator(fromBlockNum, toBlockNum uint64) (storage.Iterator[*commonTypes.BlockFees], error)
+
+	// TxIterator iterates over transactions, limiting the results to be between the provided block numbers
+	// and transaction indexes
+	TxIterator(fromBlockNum, toBlockNum uint64, fromTxIndex, toTxIndex uint32) (storage.Iterator[*types.TxResult], error)
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/gnolang/gno/tm2/pkg/amino"
 	"github.com/gnolang/gno/tm2/pkg/bft/types"
 	"github.com/pkg/errors"
+
+	indexerTypes "github.com/gnolang/tx-indexer/types"
 )
 
 const (
@@ -83,6 +85,15 @@ func encodeStringAscending(b []byte, s string) []byte {
 	return encodeStringAscendingWithTerminatorAndPrefix(b, s, ascendingBytesEscapes.escapedTerm, bytesMarker)
 }
 
+// encodeStringPrefixAscending encodes the string value like encodeStringAscending,
+// but without the terminator, so the result is a prefix of
+// all the encoded strings starting with the given value
+func encodeStringPrefixAscending(b []byte, s string) []byte {
+	b = append(b, bytesMarker)
+
+	return encodeBytesAscendingWithoutTerminatorOrPrefix(b, unsafeConvertStringToBytes(s))
+}
+
 // encodeStringAscendingWithTerminatorAndPrefix encodes the string value using an escape-based encoding. See
 // EncodeBytes for details. The encoded bytes are append to the supplied buffer
 // and the resulting buffer is returned. We can also pass a terminator byte to be used with
@@ -229,6 +240,23 @@ func decodeBytesInternal(
 	}
 }
 
+// prefixUpperBound returns the smallest key that is greater
+// than all the keys starting with the given prefix
+func prefixUpperBound(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+
+		if end[i] != 0 {
+			return end[:i+1]
+		}
+	}
+
+	return nil // no upper bound
+}
+
 // encodeBlock encodes the block in Amino binary
 func encodeBlock(block *types.Block) ([]byte, error) {
 	return amino.Marshal(block)
@@ -260,3 +288,307 @@ func decodeTx(encodedTx []byte) (*types.TxResult, error) {
 
 	return &tx, nil
 }
+
+// encodeAccount encodes the account summary in Amino binary
+func encodeAccount(account *indexerTypes.Account) ([]byte, error) {
+	return amino.Marshal(account)
+}
+
+// decodeAccount decodes the Amino encoded account summary
+func decodeAccount(encodedAccount []byte) (*indexerTypes.Account, error) {
+	var account indexerTypes.Account
+
+	if err := amino.Unmarshal(encodedAccount, &account); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal Amino account, %w", err)
+	}
+
+	return &account, nil
+}
+
+// encodePackage encodes the package deployment in Amino binary
+func encodePackage(pkg *indexerTypes.Package) ([]byte, error) {
+	return amino.Marshal(pkg)
+}
+
+// decodePackage decodes the Amino encoded package deployment
+func decodePackage(encodedPackage []byte) (*indexerTypes.Package, error) {
+	var pkg indexerTypes.Package
+
+	if err := amino.Unmarshal(encodedPackage, &pkg); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal Amino package, %w", err)
+	}
+
+	return &pkg, nil
+}
+
+// encodeSearchDocument encodes the search document in Amino binary
+func encodeSearchDocument(doc *indexerTypes.SearchDocument) ([]byte, error) {
+	return amino.Marshal(doc)
+}
+
+// decodeSearchDocument decodes the Amino encoded search document
+func decodeSearchDocument(encodedDoc []byte) (*indexerTypes.SearchDocument, error) {
+	var doc indexerTypes.SearchDocument
+
+	if err := amino.Unmarshal(encodedDoc, &doc); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal Amino search document, %w", err)
+	}
+
+	return &doc, nil
+}
+
+// encodeSearchPosting encodes the search posting in Amino binary
+func encodeSearchPosting(posting *indexerTypes.SearchPosting) ([]byte, error) {
+	return amino.Marshal(posting)
+}
+
+// decodeSearchPosting decodes the Amino encoded search posting
+func decodeSearchPosting(encodedPosting []byte) (*indexerTypes.SearchPosting, error) {
+	var posting indexerTypes.SearchPosting
+
+	if err := amino.Unmarshal(encodedPosting, &posting); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal Amino search posting, %w", err)
+	}
+
+	return &posting, nil
+}
+
+// encodeBlockFees encodes the block fee summary in Amino binary
+func encodeBlockFees(fees *indexerTypes.BlockFees) ([]byte, error) {
+	return amino.Marshal(fees)
+}
+
+// decodeBlockFees decodes the Amino encoded block fee summary
+func decodeBlockFees(encodedFees []byte) (*indexerTypes.BlockFees, error) {
+	var fees indexerTypes.BlockFees
+
+	if err := amino.Unmarshal(encodedFees, &fees); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal Amino block fees, %w", err)
+	}
+
+	return &fees, nil
+}
+
+// encodeFuncGasStats encodes the realm function gas usage summary in Amino binary
+func encodeFuncGasStats(stats *indexerTypes.FuncGasStats) ([]byte, error) {
+	return amino.Marshal(stats)
+}
+
+// decodeFuncGasStats decodes the Amino encoded realm function gas usage summary
+func decodeFuncGasStats(encodedStats []byte) (*indexerTypes.FuncGasStats, error) {
+	var stats indexerTypes.FuncGasStats
+
+	if err := amino.Unmarshal(encodedStats, &stats); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal Amino function gas stats, %w", err)
+	}
+
+	return &stats, nil
+}
+
+// encodeRealmFailure encodes the failed realm call in Amino binary
+func encodeRealmFailure(failure *indexerTypes.RealmFailure) ([]byte, error) {
+	return amino.Marshal(failure)
+}
+
+// decodeRealmFailure decodes the Amino encoded failed realm call
+func decodeRealmFailure(encodedFailure []byte) (*indexerTypes.RealmFailure, error) {
+	var failure indexerTypes.RealmFailure
+
+	if err := amino.Unmarshal(encodedFailure, &failure); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal Amino realm failure, %w", err)
+	}
+
+	return &failure, nil
+}
+
+// encodeTransfer encodes the native coin movement in Amino binary
+func encodeTransfer(transfer *indexerTypes.Transfer) ([]byte, error) {
+	return amino.Marshal(transfer)
+}
+
+// decodeTransfer decodes the Amino encoded native coin movement
+func decodeTransfer(encodedTransfer []byte) (*indexerTypes.Transfer, error) {
+	var transfer indexerTypes.Transfer
+
+	if err := amino.Unmarshal(encodedTransfer, &transfer); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal Amino transfer, %w", err)
+	}
+
+	return &transfer, nil
+}
+
+// encodeBalanceDelta encodes the balance change in Amino binary
+func encodeBalanceDelta(delta *indexerTypes.BalanceDelta) ([]byte, error) {
+	return amino.Marshal(delta)
+}
+
+// decodeBalanceDelta decodes the Amino encoded balance change
+func decodeBalanceDelta(encodedDelta []byte) (*indexerTypes.BalanceDelta, error) {
+	var delta indexerTypes.BalanceDelta
+
+	if err := amino.Unmarshal(encodedDelta, &delta); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal Amino balance delta, %w", err)
+	}
+
+	return &delta, nil
+}
+
+// encodeToken encodes the GRC20 token in Amino binary
+func encodeToken(token *indexerTypes.Token) ([]byte, error) {
+	return amino.Marshal(token)
+}
+
+// decodeToken decodes the Amino encoded GRC20 token
+func decodeToken(encodedToken []byte) (*indexerTypes.Token, error) {
+	var token indexerTypes.Token
+
+	if err := amino.Unmarshal(encodedToken, &token); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal Amino GRC20 token, %w", err)
+	}
+
+	return &token, nil
+}
+
+// encodeTokenTransfer encodes the GRC20 token movement in Amino binary
+func encodeTokenTransfer(transfer *indexerTypes.TokenTransfer) ([]byte, error) {
+	return amino.Marshal(transfer)
+}
+
+// decodeTokenTransfer decodes the Amino encoded GRC20 token movement
+func decodeTokenTransfer(encodedTransfer []byte) (*indexerTypes.TokenTransfer, error) {
+	var transfer indexerTypes.TokenTransfer
+
+	if err := amino.Unmarshal(encodedTransfer, &transfer); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal Amino GRC20 token movement, %w", err)
+	}
+
+	return &transfer, nil
+}
+
+// encodeTokenBalance encodes the GRC20 token balance in Amino binary
+func encodeTokenBalance(balance *indexerTypes.TokenBalance) ([]byte, error) {
+	return amino.Marshal(balance)
+}
+
+// decodeTokenBalance decodes the Amino encoded GRC20 token balance
+func decodeTokenBalance(encodedBalance []byte) (*indexerTypes.TokenBalance, error) {
+	var balance indexerTypes.TokenBalance
+
+	if err := amino.Unmarshal(encodedBalance, &balance); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal Amino GRC20 token balance, %w", err)
+	}
+
+	return &balance, nil
+}
+
+// encodeNFTCollection encodes the GRC721 collection in Amino binary
+func encodeNFTCollection(collection *indexerTypes.NFTCollection) ([]byte, error) {
+	return amino.Marshal(collection)
+}
+
+// decodeNFTCollection decodes the Amino encoded GRC721 collection
+func decodeNFTCollection(encodedCollection []byte) (*indexerTypes.NFTCollection, error) {
+	var collection indexerTypes.NFTCollection
+
+	if err := amino.Unmarshal(encodedCollection, &collection); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal Amino GRC721 collection, %w", err)
+	}
+
+	return &collection, nil
+}
+
+// encodeNFT encodes the GRC721 token in Amino binary
+func encodeNFT(nft *indexerTypes.NFT) ([]byte, error) {
+	return amino.Marshal(nft)
+}
+
+// decodeNFT decodes the Amino encoded GRC721 token
+func decodeNFT(encodedNFT []byte) (*indexerTypes.NFT, error) {
+	var nft indexerTypes.NFT
+
+	if err := amino.Unmarshal(encodedNFT, &nft); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal Amino GRC721 token, %w", err)
+	}
+
+	return &nft, nil
+}
+
+// encodeNFTEvent encodes the GRC721 ownership change in Amino binary
+func encodeNFTEvent(event *indexerTypes.NFTEvent) ([]byte, error) {
+	return amino.Marshal(event)
+}
+
+// decodeNFTEvent decodes the Amino encoded GRC721 ownership change
+func decodeNFTEvent(encodedEvent []byte) (*indexerTypes.NFTEvent, error) {
+	var event indexerTypes.NFTEvent
+
+	if err := amino.Unmarshal(encodedEvent, &event); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal Amino GRC721 ownership change, %w", err)
+	}
+
+	return &event, nil
+}
+
+// encodeGenesis encodes the genesis state in Amino binary
+func encodeGenesis(genesis *indexerTypes.Genesis) ([]byte, error) {
+	return amino.Marshal(genesis)
+}
+
+// decodeGenesis decodes the Amino encoded genesis state
+func decodeGenesis(encodedGenesis []byte) (*indexerTypes.Genesis, error) {
+	var genesis indexerTypes.Genesis
+
+	if err := amino.Unmarshal(encodedGenesis, &genesis); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal Amino genesis state, %w", err)
+	}
+
+	return &genesis, nil
+}
+
+// encodeValidatorSet encodes the validator set in Amino binary
+func encodeValidatorSet(set *indexerTypes.ValidatorSet) ([]byte, error) {
+	return amino.Marshal(set)
+}
+
+// decodeValidatorSet decodes the Amino encoded validator set
+func decodeValidatorSet(encodedSet []byte) (*indexerTypes.ValidatorSet, error) {
+	var set indexerTypes.ValidatorSet
+
+	if err := amino.Unmarshal(encodedSet, &set); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal Amino validator set, %w", err)
+	}
+
+	return &set, nil
+}
+
+// encodeBlockSignatures encodes the block signatures in Amino binary
+func encodeBlockSignatures(signatures *indexerTypes.BlockSignatures) ([]byte, error) {
+	return amino.Marshal(signatures)
+}
+
+// decodeBlockSignatures decodes the Amino encoded block signatures
+func decodeBlockSignatures(encodedSignatures []byte) (*indexerTypes.BlockSignatures, error) {
+	var signatures indexerTypes.BlockSignatures
+
+	if err := amino.Unmarshal(encodedSignatures, &signatures); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal Amino block signatures, %w", err)
+	}
+
+	return &signatures, nil
+}
+
+// encodeBlockResults encodes the block results in Amino binary
+func encodeBlockResults(results *indexerTypes.BlockResults) ([]byte, error) {
+	return amino.Marshal(results)
+}
+
+// decodeBlockResults decodes the Amino encoded block results
+func decodeBlockResults(encodedResults []byte) (*indexerTypes.BlockResults, error) {
+	var results indexerTypes.BlockResults
+
+	if err := amino.Unmarshal(encodedResults, &results); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal Amino block results, %w", err)
+	}
+
+	return &results, nil
+}
@@ -5,12 +5,14 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"slices"
 
 	"github.com/cockroachdb/pebble"
 	"github.com/gnolang/gno/tm2/pkg/bft/types"
 	"go.uber.org/multierr"
 
 	storageErrors "github.com/gnolang/tx-indexer/storage/errors"
+	indexerTypes "github.com/gnolang/tx-indexer/types"
 )
 
 const (
@@ -18,6 +20,10 @@ const (
 	// for the latest height saved in the DB
 	keyLatestHeight = "/meta/lh"
 
+	// keyGenesis is the quick lookup key
+	// for the genesis state saved in the DB
+	keyGenesis = "/meta/genesis"
+
 	// prefixKeyBlocks is the key for each block saved. They are stored by height
 	prefixKeyBlocks = "/data/blocks/"
 
@@ -26,6 +32,87 @@ const (
 
 	// prefixKeyTxByHash is a secondary index to query transaction by hash
 	prefixKeyTxByHash = "/index/txh/"
+
+	// prefixKeyAccounts is the prefix for each account activity summary saved
+	prefixKeyAccounts = "/data/accounts/"
+
+	// prefixKeyAccountTxs is a secondary index to query transactions by address
+	prefixKeyAccountTxs = "/index/acctx/"
+
+	// prefixKeyPackages is the prefix for each package deployment saved. They are stored by path
+	prefixKeyPackages = "/data/packages/"
+
+	// prefixKeyPackageImporters is a secondary index to query packages by their imports
+	prefixKeyPackageImporters = "/index/pkgimp/"
+
+	// prefixKeySearchDocs is the prefix for each full-text search document saved
+	prefixKeySearchDocs = "/data/search/"
+
+	// prefixKeySearchPostings is the full-text search inverted index. Postings are stored by term
+	prefixKeySearchPostings = "/index/fts/"
+
+	// prefixKeyBlockFees is the prefix for each block fee summary saved. They are stored by height
+	prefixKeyBlockFees = "/data/fees/"
+
+	// prefixKeyFuncGasStats is the prefix for each realm function gas usage summary saved.
+	// They are stored by package path, function name and height
+	prefixKeyFuncGasStats = "/data/gasstats/"
+
+	// prefixKeyRealmFailures is the prefix for each failed realm call saved.
+	// They are stored by package path, height, transaction index and message index
+	prefixKeyRealmFailures = "/data/failures/"
+
+	// prefixKeyTransfers is the prefix for each native coin movement saved.
+	// They are stored by height, transaction index and position within the transaction
+	prefixKeyTransfers = "/data/transfers/"
+
+	// prefixKeyAddressTransfers is a secondary index to query native coin movements
+	// by sender or recipient address
+	prefixKeyAddressTransfers = "/index/addrtransfers/"
+
+	// prefixKeyBalanceDeltas is the prefix for each per-block balance change saved.
+	// They are stored by address, denomination and height
+	prefixKeyBalanceDeltas = "/data/balances/"
+
+	// prefixKeyTokens is the prefix for each GRC20 token saved. They are stored by identifier
+	prefixKeyTokens = "/data/tokens/"
+
+	// prefixKeyTokenTransfers is the prefix for each GRC20 token movement saved.
+	// They are stored by height, transaction index and event index
+	prefixKeyTokenTransfers = "/data/tokentransfers/"
+
+	// prefixKeyAddressTokenTransfers is a secondary index to query GRC20 token movements
+	// by sender or recipient address
+	prefixKeyAddressTokenTransfers = "/index/addrtokentransfers/"
+
+	// prefixKeyTokenBalances is the prefix for each GRC20 token balance saved.
+	// They are stored by holder address and token identifier
+	prefixKeyTokenBalances = "/data/tokenbalances/"
+
+	// prefixKeyNFTCollections is the prefix for each GRC721 collection saved. They are stored by realm path
+	prefixKeyNFTCollections = "/data/nftcollections/"
+
+	// prefixKeyNFTs is the prefix for each GRC721 token saved. They are stored by collection and token ID
+	prefixKeyNFTs = "/data/nfts/"
+
+	// prefixKeyNFTOwners is the prefix for each GRC721 token saved, indexed by owner.
+	// They are stored by owner address, collection and token ID
+	prefixKeyNFTOwners = "/data/nftowners/"
+
+	// prefixKeyNFTEvents is the prefix for each GRC721 ownership change saved.
+	// They are stored by collection, token ID, height, transaction index and event index
+	prefixKeyNFTEvents = "/data/nftevents/"
+
+	// prefixKeyValidatorSets is the prefix for each validator set change saved.
+	// They are stored by the height the set applies from
+	prefixKeyValidatorSets = "/data/validatorsets/"
+
+	// prefixKeyBlockSignatures is the prefix for each block signatures saved. They are stored by height
+	prefixKeyBlockSignatures = "/data/blocksignatures/"
+
+	// prefixKeyBlockResults is the prefix for each block BeginBlock / EndBlock results saved.
+	// They are stored by height, only for the blocks that have any
+	prefixKeyBlockResults = "/data/blockresults/"
 )
 
 func keyTx(blockNum uint64, txIndex uint32) []byte {
@@ -47,6 +134,64 @@ func keyHashTx(hash string) []byte {
 	return key
 }
 
+func keyAccount(address string) []byte {
+	var key []byte
+
+	key = encodeStringAscending(key, prefixKeyAccounts)
+	key = encodeStringAscending(key, address)
+
+	return key
+}
+
+func keyAccountTx(address string, blockNum uint64, txIndex uint32) []byte {
+	var key []byte
+
+	key = encodeStringAscending(key, prefixKeyAccountTxs)
+	key = encodeStringAscending(key, address)
+	key = encodeUint64Ascending(key, blockNum)
+	key = encodeUint32Ascending(key, txIndex)
+
+	return key
+}
+
+func keyPackage(path string) []byte {
+	var key []byte
+
+	key = encodeStringAscending(key, prefixKeyPackages)
+	key = encodeStringAscending(key, path)
+
+	return key
+}
+
+func keyPackageImporter(importedPath, importerPath string) []byte {
+	var key []byte
+
+	key = encodeStringAscending(key, prefixKeyPackageImporters)
+	key = encodeStringAscending(key, importedPath)
+	key = encodeStringAscending(key, importerPath)
+
+	return key
+}
+
+func keySearchDocument(id string) []byte {
+	var key []byte
+
+	key = encodeStringAscending(key, prefixKeySearchDocs)
+	key = encodeStringAscending(key, id)
+
+	return key
+}
+
+func keySearchPosting(term, docID string) []byte {
+	var key []byte
+
+	key = encodeStringAscending(key, prefixKeySearchPostings)
+	key = encodeStringAscending(key, term)
+	key = encodeStringAscending(key, docID)
+
+	return key
+}
+
 func keyBlock(blockNum uint64) []byte {
 	var key []byte
 
@@ -56,6 +201,184 @@ func keyBlock(blockNum uint64) []byte {
 	return key
 }
 
+func keyBlockFees(blockNum uint64) []byte {
+	var key []byte
+
+	key = encodeStringAscending(key, prefixKeyBlockFees)
+	key = encodeUint64Ascending(key, blockNum)
+
+	return key
+}
+
+func keyFuncGasStats(pkgPath, fn string, blockNum uint64) []byte {
+	var key []byte
+
+	key = encodeStringAscending(key, prefixKeyFuncGasStats)
+	key = encodeStringAscending(key, pkgPath)
+	key = encodeStringAscending(key, fn)
+	key = encodeUint64Ascending(key, blockNum)
+
+	return key
+}
+
+func keyRealmFailure(pkgPath string, blockNum uint64, txIndex, msgIndex uint32) []byte {
+	var key []byte
+
+	key = encodeStringAscending(key, prefixKeyRealmFailures)
+	key = encodeStringAscending(key, pkgPath)
+	key = encodeUint64Ascending(key, blockNum)
+	key = encodeUint32Ascending(key, txIndex)
+	key = encodeUint32Ascending(key, msgIndex)
+
+	return key
+}
+
+func keyTransfer(blockNum uint64, txIndex, position uint32) []byte {
+	var key []byte
+
+	key = encodeStringAscending(key, prefixKeyTransfers)
+	key = encodeUint64Ascending(key, blockNum)
+	key = encodeUint32Ascending(key, txIndex)
+	key = encodeUint32Ascending(key, position)
+
+	return key
+}
+
+func keyAddressTransfer(address string, blockNum uint64, txIndex, position uint32) []byte {
+	var key []byte
+
+	key = encodeStringAscending(key, prefixKeyAddressTransfers)
+	key = encodeStringAscending(key, address)
+	key = encodeUint64Ascending(key, blockNum)
+	key = encodeUint32Ascending(key, txIndex)
+	key = encodeUint32Ascending(key, position)
+
+	return key
+}
+
+func keyBalanceDelta(address, denom string, blockNum uint64) []byte {
+	var key []byte
+
+	key = encodeStringAscending(key, prefixKeyBalanceDeltas)
+	key = encodeStringAscending(key, address)
+	key = encodeStringAscending(key, denom)
+	key = encodeUint64Ascending(key, blockNum)
+
+	return key
+}
+
+func keyToken(id string) []byte {
+	var key []byte
+
+	key = encodeStringAscending(key, prefixKeyTokens)
+	key = encodeStringAscending(key, id)
+
+	return key
+}
+
+func keyTokenTransfer(blockNum uint64, txIndex, eventIndex uint32) []byte {
+	var key []byte
+
+	key = encodeStringAscending(key, prefixKeyTokenTransfers)
+	key = encodeUint64Ascending(key, blockNum)
+	key = encodeUint32Ascending(key, txIndex)
+	key = encodeUint32Ascending(key, eventIndex)
+
+	return key
+}
+
+func keyAddressTokenTransfer(address string, blockNum uint64, txIndex, eventIndex uint32) []byte {
+	var key []byte
+
+	key = encodeStringAscending(key, prefixKeyAddressTokenTransfers)
+	key = encodeStringAscending(key, address)
+	key = encodeUint64Ascending(key, blockNum)
+	key = encodeUint32Ascending(key, txIndex)
+	key = encodeUint32Ascending(key, eventIndex)
+
+	return key
+}
+
+func keyTokenBalance(address, token string) []byte {
+	var key []byte
+
+	key = encodeStringAscending(key, prefixKeyTokenBalances)
+	key = encodeStringAscending(key, address)
+	key = encodeStringAscending(key, token)
+
+	return key
+}
+
+func keyNFTCollection(pkgPath string) []byte {
+	var key []byte
+
+	key = encodeStringAscending(key, prefixKeyNFTCollections)
+	key = encodeStringAscending(key, pkgPath)
+
+	return key
+}
+
+func keyNFT(collection, tokenID string) []byte {
+	var key []byte
+
+	key = encodeStringAscending(key, prefixKeyNFTs)
+	key = encodeStringAscending(key, collection)
+	key = encodeStringAscending(key, tokenID)
+
+	return key
+}
+
+func keyNFTOwner(owner, collection, tokenID string) []byte {
+	var key []byte
+
+	key = encodeStringAscending(key, prefixKeyNFTOwners)
+	key = encodeStringAscending(key, owner)
+	key = encodeStringAscending(key, collection)
+	key = encodeStringAscending(key, tokenID)
+
+	return key
+}
+
+func keyNFTEvent(collection, tokenID string, blockNum uint64, txIndex, eventIndex uint32) []byte {
+	var key []byte
+
+	key = encodeStringAscending(key, prefixKeyNFTEvents)
+	key = encodeStringAscending(key, collection)
+	key = encodeStringAscending(key, tokenID)
+	key = encodeUint64Ascending(key, blockNum)
+	key = encodeUint32Ascending(key, txIndex)
+	key = encodeUint32Ascending(key, eventIndex)
+
+	return key
+}
+
+func keyValidatorSet(blockNum uint64) []byte {
+	var key []byte
+
+	key = encodeStringAscending(key, prefixKeyValidatorSets)
+	key = encodeUint64Ascending(key, blockNum)
+
+	return key
+}
+
+func keyBlockSignatures(blockNum uint64) []byte {
+	var key []byte
+
+	key = encodeStringAscending(key, prefixKeyBlockSignatures)
+	key = encodeUint64Ascending(key, blockNum)
+
+	return key
+}
+
+func keyBlockResults(blockNum uint64) []byte {
+	var key []byte
+
+	key = encodeStringAscending(key, prefixKeyBlockResults)
+	key = encodeUint64Ascending(key, blockNum)
+
+	return key
+}
+
 var _ Storage = &Pebble{}
 
 // Pebble is the instance of an embedded storage
@@ -96,6 +419,22 @@ func (s *Pebble) GetLatestHeight() (uint64, error) {
 	return val, err
 }
 
+// GetGenesis fetches the genesis state from storage, if any
+func (s *Pebble) GetGenesis() (*indexerTypes.Genesis, error) {
+	genesis, c, err := s.db.Get([]byte(keyGenesis))
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil, storageErrors.ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer c.Close()
+
+	return decodeGenesis(genesis)
+}
+
 // GetBlock fetches the specified block from storage, if any
 func (s *Pebble) GetBlock(blockNum uint64) (*types.Block, error) {
 	block, c, err := s.db.Get(keyBlock(blockNum))
@@ -129,7 +468,8 @@ func (s *Pebble) GetTx(blockNum uint64, index uint32) (*types.TxResult, error) {
 }
 
 func (s *Pebble) GetTxByHash(txHash string) (*types.TxResult, error) {
-	txKey, ch, err := s.db.Get(keyHashTx(txHash))
+	// The hash index is keyed by standard base64 hashes
+	txKey, ch, err := s.db.Get(keyHashTx(indexerTypes.NormalizeHash(txHash)))
 	if errors.Is(err, pebble.ErrNotFound) {
 		return nil, storageErrors.ErrNotFound
 	}
@@ -156,16 +496,31 @@ func (s *Pebble) GetTxByHash(txHash string) (*types.TxResult, error) {
 	return decodeTx(tx)
 }
 
-func (s *Pebble) loadBlockIterator(fromBlockNum, toBlockNum uint64) (*pebble.Iterator, *pebble.Snapshot, error) {
-	fromKey := keyBlock(fromBlockNum)
+// GetAccount fetches the activity summary of the given address, if any
+func (s *Pebble) GetAccount(address string) (*indexerTypes.Account, error) {
+	account, c, err := s.db.Get(keyAccount(address))
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil, storageErrors.ErrNotFound
+	}
 
-	if toBlockNum == 0 {
-		toBlockNum = math.MaxInt64
-	} else {
-		toBlockNum++ // adding one to the range because the UpperBound is exclusive
+	if err != nil {
+		return nil, err
 	}
 
-	toKey := keyBlock(toBlockNum)
+	defer c.Close()
+
+	return decodeAccount(account)
+}
+
+// AccountTxIterator iterates over the transactions the given address took part in,
+// in ascending order, starting from the provided block number and transaction index
+func (s *Pebble) AccountTxIterator(
+	address string,
+	fromBlockNum uint64,
+	fromTxIndex uint32,
+) (Iterator[*types.TxResult], error) {
+	fromKey := keyAccountTx(address, fromBlockNum, fromTxIndex)
+	toKey := keyAccountTx(address, math.MaxInt64, math.MaxUint32)
 
 	snap := s.db.NewSnapshot()
 
@@ -174,362 +529,1865 @@ func (s *Pebble) loadBlockIterator(fromBlockNum, toBlockNum uint64) (*pebble.Ite
 		UpperBound: toKey,
 	})
 	if err != nil {
-		return nil, nil, multierr.Append(snap.Close(), err)
+		return nil, multierr.Append(snap.Close(), err)
 	}
 
-	return it, snap, nil
+	return &PebbleIndexedTxIter{i: it, s: snap}, nil
 }
 
-func (s *Pebble) BlockIterator(fromBlockNum, toBlockNum uint64) (Iterator[*types.Block], error) {
-	it, snap, err := s.loadBlockIterator(fromBlockNum, toBlockNum)
+// GetPackage fetches the package deployed at the given path, if any
+func (s *Pebble) GetPackage(path string) (*indexerTypes.Package, error) {
+	pkg, c, err := s.db.Get(keyPackage(path))
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil, storageErrors.ErrNotFound
+	}
+
 	if err != nil {
 		return nil, err
 	}
 
-	return &PebbleBlockIter{pebbleBaseBlockIter: pebbleBaseBlockIter{i: it, s: snap}}, nil
-}
-
-func (s *Pebble) BlockReverseIterator(fromBlockNum, toBlockNum uint64) (Iterator[*types.Block], error) {
-	fromKey := keyBlock(fromBlockNum)
+	defer c.Close()
 
-	if toBlockNum == 0 {
-		toBlockNum = math.MaxInt64
-	} else {
-		toBlockNum++ // adding one to the range because the UpperBound is exclusive
-	}
+	return decodePackage(pkg)
+}
 
-	toKey := keyBlock(toBlockNum)
+// PackageIterator iterates over all the deployed packages, ordered by path
+func (s *Pebble) PackageIterator() (Iterator[*indexerTypes.Package], error) {
+	prefix := encodeStringAscending(nil, prefixKeyPackages)
 
 	snap := s.db.NewSnapshot()
 
 	it, err := snap.NewIter(&pebble.IterOptions{
-		LowerBound: fromKey,
-		UpperBound: toKey,
+		LowerBound: prefix,
+		UpperBound: prefixUpperBound(prefix),
 	})
 	if err != nil {
 		return nil, multierr.Append(snap.Close(), err)
 	}
 
-	return &PebbleReverseBlockIter{pebbleBaseBlockIter: pebbleBaseBlockIter{i: it, s: snap}}, nil
+	return &PebblePackageIter{i: it, s: snap}, nil
 }
 
-func (s *Pebble) loadTxIterator(
-	fromBlockNum,
-	toBlockNum uint64,
-	fromTxIndex,
-	toTxIndex uint32,
-) (*pebble.Iterator, *pebble.Snapshot, error) {
-	fromKey := keyTx(fromBlockNum, fromTxIndex)
+// PackageImporterIterator iterates over the paths of the packages
+// importing the given package path, ordered by path
+func (s *Pebble) PackageImporterIterator(path string) (Iterator[string], error) {
+	var prefix []byte
 
-	toKey := keyTx(toBlockNum, toTxIndex)
+	prefix = encodeStringAscending(prefix, prefixKeyPackageImporters)
+	prefix = encodeStringAscending(prefix, path)
 
 	snap := s.db.NewSnapshot()
 
 	it, err := snap.NewIter(&pebble.IterOptions{
-		LowerBound: fromKey,
-		UpperBound: toKey,
+		LowerBound: prefix,
+		UpperBound: prefixUpperBound(prefix),
 	})
 	if err != nil {
-		return nil, nil, multierr.Append(snap.Close(), err)
+		return nil, multierr.Append(snap.Close(), err)
 	}
 
-	return it, snap, nil
+	return &PebblePackageImporterIter{i: it, s: snap}, nil
 }
 
-func (s *Pebble) TxIterator(
-	fromBlockNum,
-	toBlockNum uint64,
-	fromTxIndex,
-	toTxIndex uint32,
-) (Iterator[*types.TxResult], error) {
-	if toBlockNum == 0 {
+// GetSearchDocument fetches the full-text search document using its ID
+func (s *Pebble) GetSearchDocument(id string) (*indexerTypes.SearchDocument, error) {
+	doc, c, err := s.db.Get(keySearchDocument(id))
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil, storageErrors.ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer c.Close()
+
+	return decodeSearchDocument(doc)
+}
+
+// SearchPostingIterator iterates over the full-text search postings of the given term,
+// or of all the terms starting with it if prefix is set
+func (s *Pebble) SearchPostingIterator(term string, prefix bool) (Iterator[*indexerTypes.SearchPosting], error) {
+	var from []byte
+
+	from = encodeStringAscending(from, prefixKeySearchPostings)
+
+	if prefix {
+		from = encodeStringPrefixAscending(from, term)
+	} else {
+		from = encodeStringAscending(from, term)
+	}
+
+	snap := s.db.NewSnapshot()
+
+	it, err := snap.NewIter(&pebble.IterOptions{
+		LowerBound: from,
+		UpperBound: prefixUpperBound(from),
+	})
+	if err != nil {
+		return nil, multierr.Append(snap.Close(), err)
+	}
+
+	return &PebbleSearchPostingIter{i: it, s: snap}, nil
+}
+
+// BlockFeesIterator iterates over the block fee summaries,
+// limiting the results to be between the provided block numbers
+func (s *Pebble) BlockFeesIterator(fromBlockNum, toBlockNum uint64) (Iterator[*indexerTypes.BlockFees], error) {
+	fromKey := keyBlockFees(fromBlockNum)
+
+	if toBlockNum == 0 {
 		toBlockNum = math.MaxInt64
 	} else {
 		toBlockNum++ // adding one to the range because the UpperBound is exclusive
 	}
 
-	if toTxIndex == 0 {
-		toTxIndex = math.MaxUint32
+	toKey := keyBlockFees(toBlockNum)
+
+	snap := s.db.NewSnapshot()
+
+	it, err := snap.NewIter(&pebble.IterOptions{
+		LowerBound: fromKey,
+		UpperBound: toKey,
+	})
+	if err != nil {
+		return nil, multierr.Append(snap.Close(), err)
+	}
+
+	return &PebbleBlockFeesIter{i: it, s: snap}, nil
+}
+
+// FuncGasStatsIterator iterates over the gas usage summaries of the given realm function,
+// limiting the results to be between the provided block numbers
+func (s *Pebble) FuncGasStatsIterator(
+	pkgPath,
+	fn string,
+	fromBlockNum,
+	toBlockNum uint64,
+) (Iterator[*indexerTypes.FuncGasStats], error) {
+	fromKey := keyFuncGasStats(pkgPath, fn, fromBlockNum)
+
+	if toBlockNum == 0 {
+		toBlockNum = math.MaxInt64
 	} else {
-		toTxIndex++ // adding one to the range because the UpperBound is exclusive
+		toBlockNum++ // adding one to the range because the UpperBound is exclusive
 	}
 
-	it, snap, err := s.loadTxIterator(fromBlockNum, toBlockNum, fromTxIndex, toTxIndex)
+	toKey := keyFuncGasStats(pkgPath, fn, toBlockNum)
+
+	snap := s.db.NewSnapshot()
+
+	it, err := snap.NewIter(&pebble.IterOptions{
+		LowerBound: fromKey,
+		UpperBound: toKey,
+	})
+	if err != nil {
+		return nil, multierr.Append(snap.Close(), err)
+	}
+
+	return &PebbleFuncGasStatsIter{i: it, s: snap}, nil
+}
+
+// RealmFailureIterator iterates over the failed calls to the given realm,
+// limiting the results to be between the provided block numbers
+func (s *Pebble) RealmFailureIterator(
+	pkgPath string,
+	fromBlockNum,
+	toBlockNum uint64,
+) (Iterator[*indexerTypes.RealmFailure], error) {
+	fromKey := keyRealmFailure(pkgPath, fromBlockNum, 0, 0)
+
+	if toBlockNum == 0 {
+		toBlockNum = math.MaxInt64
+	} else {
+		toBlockNum++ // adding one to the range because the UpperBound is exclusive
+	}
+
+	toKey := keyRealmFailure(pkgPath, toBlockNum, 0, 0)
+
+	snap := s.db.NewSnapshot()
+
+	it, err := snap.NewIter(&pebble.IterOptions{
+		LowerBound: fromKey,
+		UpperBound: toKey,
+	})
+	if err != nil {
+		return nil, multierr.Append(snap.Close(), err)
+	}
+
+	return &PebbleRealmFailureIter{i: it, s: snap}, nil
+}
+
+// TransferIterator iterates over the native coin movements,
+// limiting the results to be between the provided block numbers
+func (s *Pebble) TransferIterator(fromBlockNum, toBlockNum uint64) (Iterator[*indexerTypes.Transfer], error) {
+	fromKey := keyTransfer(fromBlockNum, 0, 0)
+
+	if toBlockNum == 0 {
+		toBlockNum = math.MaxInt64
+	} else {
+		toBlockNum++ // adding one to the range because the UpperBound is exclusive
+	}
+
+	toKey := keyTransfer(toBlockNum, 0, 0)
+
+	snap := s.db.NewSnapshot()
+
+	it, err := snap.NewIter(&pebble.IterOptions{
+		LowerBound: fromKey,
+		UpperBound: toKey,
+	})
+	if err != nil {
+		return nil, multierr.Append(snap.Close(), err)
+	}
+
+	return &PebbleTransferIter{i: it, s: snap}, nil
+}
+
+// AddressTransferIterator iterates over the native coin movements
+// sent or received by the given address,
+// limiting the results to be between the provided block numbers
+func (s *Pebble) AddressTransferIterator(
+	address string,
+	fromBlockNum,
+	toBlockNum uint64,
+) (Iterator[*indexerTypes.Transfer], error) {
+	fromKey := keyAddressTransfer(address, fromBlockNum, 0, 0)
+
+	if toBlockNum == 0 {
+		toBlockNum = math.MaxInt64
+	} else {
+		toBlockNum++ // adding one to the range because the UpperBound is exclusive
+	}
+
+	toKey := keyAddressTransfer(address, toBlockNum, 0, 0)
+
+	snap := s.db.NewSnapshot()
+
+	it, err := snap.NewIter(&pebble.IterOptions{
+		LowerBound: fromKey,
+		UpperBound: toKey,
+	})
+	if err != nil {
+		return nil, multierr.Append(snap.Close(), err)
+	}
+
+	return &PebbleIndexedTransferIter{i: it, s: snap}, nil
+}
+
+// GetLatestBalance fetches the most recent balance change
+// of the given address in the given denomination, if any
+func (s *Pebble) GetLatestBalance(address, denom string) (*indexerTypes.BalanceDelta, error) {
+	it, err := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: keyBalanceDelta(address, denom, 0),
+		UpperBound: keyBalanceDelta(address, denom, math.MaxInt64),
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &PebbleTxIter{
-		pebbleBaseTxIter: pebbleBaseTxIter{
-			i:         it,
-			s:         snap,
-			fromIndex: fromTxIndex,
-			toIndex:   toTxIndex,
-		},
-	}, nil
+	defer it.Close()
+
+	if !it.Last() {
+		if err := it.Error(); err != nil {
+			return nil, err
+		}
+
+		return nil, storageErrors.ErrNotFound
+	}
+
+	return decodeBalanceDelta(it.Value())
 }
 
-func (s *Pebble) TxReverseIterator(
+// BalanceHistoryIterator iterates over the per-block balance changes
+// of the given address in the given denomination,
+// limiting the results to be between the provided block numbers
+func (s *Pebble) BalanceHistoryIterator(
+	address,
+	denom string,
 	fromBlockNum,
 	toBlockNum uint64,
-	fromTxIndex,
-	toTxIndex uint32,
-) (Iterator[*types.TxResult], error) {
+) (Iterator[*indexerTypes.BalanceDelta], error) {
+	fromKey := keyBalanceDelta(address, denom, fromBlockNum)
+
 	if toBlockNum == 0 {
 		toBlockNum = math.MaxInt64
 	} else {
 		toBlockNum++ // adding one to the range because the UpperBound is exclusive
 	}
 
-	if toTxIndex == 0 {
-		toTxIndex = math.MaxUint32
+	toKey := keyBalanceDelta(address, denom, toBlockNum)
+
+	snap := s.db.NewSnapshot()
+
+	it, err := snap.NewIter(&pebble.IterOptions{
+		LowerBound: fromKey,
+		UpperBound: toKey,
+	})
+	if err != nil {
+		return nil, multierr.Append(snap.Close(), err)
+	}
+
+	return &PebbleBalanceDeltaIter{i: it, s: snap}, nil
+}
+
+// GetToken fetches the GRC20 token with the given identifier, if any
+func (s *Pebble) GetToken(id string) (*indexerTypes.Token, error) {
+	token, c, err := s.db.Get(keyToken(id))
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil, storageErrors.ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer c.Close()
+
+	return decodeToken(token)
+}
+
+// TokenIterator iterates over all the known GRC20 tokens, ordered by identifier
+func (s *Pebble) TokenIterator() (Iterator[*indexerTypes.Token], error) {
+	prefix := encodeStringAscending(nil, prefixKeyTokens)
+
+	snap := s.db.NewSnapshot()
+
+	it, err := snap.NewIter(&pebble.IterOptions{
+		LowerBound: prefix,
+		UpperBound: prefixUpperBound(prefix),
+	})
+	if err != nil {
+		return nil, multierr.Append(snap.Close(), err)
+	}
+
+	return &PebbleTokenIter{i: it, s: snap}, nil
+}
+
+// TokenTransferIterator iterates over the GRC20 token movements,
+// limiting the results to be between the provided block numbers
+func (s *Pebble) TokenTransferIterator(fromBlockNum, toBlockNum uint64) (Iterator[*indexerTypes.TokenTransfer], error) {
+	fromKey := keyTokenTransfer(fromBlockNum, 0, 0)
+
+	if toBlockNum == 0 {
+		toBlockNum = math.MaxInt64
 	} else {
-		toTxIndex++ // adding one to the range because the UpperBound is exclusive
+		toBlockNum++ // adding one to the range because the UpperBound is exclusive
+	}
+
+	toKey := keyTokenTransfer(toBlockNum, 0, 0)
+
+	snap := s.db.NewSnapshot()
+
+	it, err := snap.NewIter(&pebble.IterOptions{
+		LowerBound: fromKey,
+		UpperBound: toKey,
+	})
+	if err != nil {
+		return nil, multierr.Append(snap.Close(), err)
+	}
+
+	return &PebbleTokenTransferIter{i: it, s: snap}, nil
+}
+
+// AddressTokenTransferIterator iterates over the GRC20 token movements
+// sent or received by the given address,
+// limiting the results to be between the provided block numbers
+func (s *Pebble) AddressTokenTransferIterator(
+	address string,
+	fromBlockNum,
+	toBlockNum uint64,
+) (Iterator[*indexerTypes.TokenTransfer], error) {
+	fromKey := keyAddressTokenTransfer(address, fromBlockNum, 0, 0)
+
+	if toBlockNum == 0 {
+		toBlockNum = math.MaxInt64
+	} else {
+		toBlockNum++ // adding one to the range because the UpperBound is exclusive
+	}
+
+	toKey := keyAddressTokenTransfer(address, toBlockNum, 0, 0)
+
+	snap := s.db.NewSnapshot()
+
+	it, err := snap.NewIter(&pebble.IterOptions{
+		LowerBound: fromKey,
+		UpperBound: toKey,
+	})
+	if err != nil {
+		return nil, multierr.Append(snap.Close(), err)
+	}
+
+	return &PebbleIndexedTokenTransferIter{i: it, s: snap}, nil
+}
+
+// GetTokenBalance fetches the balance of the given address in the given GRC20 token, if any
+func (s *Pebble) GetTokenBalance(address, token string) (*indexerTypes.TokenBalance, error) {
+	balance, c, err := s.db.Get(keyTokenBalance(address, token))
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil, storageErrors.ErrNotFound
 	}
 
-	it, snap, err := s.loadTxIterator(fromBlockNum, toBlockNum, fromTxIndex, toTxIndex)
 	if err != nil {
 		return nil, err
 	}
 
-	return &PebbleReverseTxIter{
-		pebbleBaseTxIter: pebbleBaseTxIter{
-			i:         it,
-			s:         snap,
-			fromIndex: fromTxIndex,
-			toIndex:   toTxIndex,
-		},
-	}, nil
+	defer c.Close()
+
+	return decodeTokenBalance(balance)
+}
+
+// TokenBalanceIterator iterates over the GRC20 token balances
+// of the given address, ordered by token identifier
+func (s *Pebble) TokenBalanceIterator(address string) (Iterator[*indexerTypes.TokenBalance], error) {
+	var prefix []byte
+
+	prefix = encodeStringAscending(prefix, prefixKeyTokenBalances)
+	prefix = encodeStringAscending(prefix, address)
+
+	snap := s.db.NewSnapshot()
+
+	it, err := snap.NewIter(&pebble.IterOptions{
+		LowerBound: prefix,
+		UpperBound: prefixUpperBound(prefix),
+	})
+	if err != nil {
+		return nil, multierr.Append(snap.Close(), err)
+	}
+
+	return &PebbleTokenBalanceIter{i: it, s: snap}, nil
+}
+
+// GetNFTCollection fetches the GRC721 collection of the given realm, if any
+func (s *Pebble) GetNFTCollection(pkgPath string) (*indexerTypes.NFTCollection, error) {
+	collection, c, err := s.db.Get(keyNFTCollection(pkgPath))
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil, storageErrors.ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer c.Close()
+
+	return decodeNFTCollection(collection)
+}
+
+// NFTCollectionIterator iterates over all the known GRC721 collections, ordered by realm path
+func (s *Pebble) NFTCollectionIterator() (Iterator[*indexerTypes.NFTCollection], error) {
+	prefix := encodeStringAscending(nil, prefixKeyNFTCollections)
+
+	snap := s.db.NewSnapshot()
+
+	it, err := snap.NewIter(&pebble.IterOptions{
+		LowerBound: prefix,
+		UpperBound: prefixUpperBound(prefix),
+	})
+	if err != nil {
+		return nil, multierr.Append(snap.Close(), err)
+	}
+
+	return &PebbleNFTCollectionIter{i: it, s: snap}, nil
+}
+
+// GetNFT fetches the ownership state of the given GRC721 token, if any
+func (s *Pebble) GetNFT(collection, tokenID string) (*indexerTypes.NFT, error) {
+	nft, c, err := s.db.Get(keyNFT(collection, tokenID))
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil, storageErrors.ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer c.Close()
+
+	return decodeNFT(nft)
+}
+
+// NFTOwnerIterator iterates over the GRC721 tokens currently
+// owned by the given address, ordered by collection and token ID
+func (s *Pebble) NFTOwnerIterator(owner string) (Iterator[*indexerTypes.NFT], error) {
+	var prefix []byte
+
+	prefix = encodeStringAscending(prefix, prefixKeyNFTOwners)
+	prefix = encodeStringAscending(prefix, owner)
+
+	snap := s.db.NewSnapshot()
+
+	it, err := snap.NewIter(&pebble.IterOptions{
+		LowerBound: prefix,
+		UpperBound: prefixUpperBound(prefix),
+	})
+	if err != nil {
+		return nil, multierr.Append(snap.Close(), err)
+	}
+
+	return &PebbleNFTIter{i: it, s: snap}, nil
+}
+
+// NFTHistoryIterator iterates over the ownership changes
+// of the given GRC721 token, from the oldest to the newest
+func (s *Pebble) NFTHistoryIterator(collection, tokenID string) (Iterator[*indexerTypes.NFTEvent], error) {
+	var prefix []byte
+
+	prefix = encodeStringAscending(prefix, prefixKeyNFTEvents)
+	prefix = encodeStringAscending(prefix, collection)
+	prefix = encodeStringAscending(prefix, tokenID)
+
+	snap := s.db.NewSnapshot()
+
+	it, err := snap.NewIter(&pebble.IterOptions{
+		LowerBound: prefix,
+		UpperBound: prefixUpperBound(prefix),
+	})
+	if err != nil {
+		return nil, multierr.Append(snap.Close(), err)
+	}
+
+	return &PebbleNFTEventIter{i: it, s: snap}, nil
+}
+
+// GetValidatorSet fetches the validator set of the given block,
+// the latest set change at or before its height, if any
+func (s *Pebble) GetValidatorSet(blockNum uint64) (*indexerTypes.ValidatorSet, error) {
+	it, err := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: keyValidatorSet(0),
+		UpperBound: keyValidatorSet(blockNum + 1),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	defer it.Close()
+
+	if !it.Last() {
+		if err := it.Error(); err != nil {
+			return nil, err
+		}
+
+		return nil, storageErrors.ErrNotFound
+	}
+
+	return decodeValidatorSet(it.Value())
+}
+
+// GetBlockSignatures fetches the validator signatures of the given block, if any
+func (s *Pebble) GetBlockSignatures(blockNum uint64) (*indexerTypes.BlockSignatures, error) {
+	signatures, c, err := s.db.Get(keyBlockSignatures(blockNum))
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil, storageErrors.ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer c.Close()
+
+	return decodeBlockSignatures(signatures)
+}
+
+// BlockSignaturesIterator iterates over the validator signatures of the blocks,
+// limiting the results to be between the provided block numbers
+func (s *Pebble) BlockSignaturesIterator(
+	fromBlockNum,
+	toBlockNum uint64,
+) (Iterator[*indexerTypes.BlockSignatures], error) {
+	fromKey := keyBlockSignatures(fromBlockNum)
+
+	if toBlockNum == 0 {
+		toBlockNum = math.MaxInt64
+	} else {
+		toBlockNum++ // adding one to the range because the UpperBound is exclusive
+	}
+
+	toKey := keyBlockSignatures(toBlockNum)
+
+	snap := s.db.NewSnapshot()
+
+	it, err := snap.NewIter(&pebble.IterOptions{
+		LowerBound: fromKey,
+		UpperBound: toKey,
+	})
+	if err != nil {
+		return nil, multierr.Append(snap.Close(), err)
+	}
+
+	return &PebbleBlockSignaturesIter{i: it, s: snap}, nil
+}
+
+// GetBlockResults fetches the BeginBlock / EndBlock results of the given block, if any
+func (s *Pebble) GetBlockResults(blockNum uint64) (*indexerTypes.BlockResults, error) {
+	results, c, err := s.db.Get(keyBlockResults(blockNum))
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil, storageErrors.ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer c.Close()
+
+	return decodeBlockResults(results)
+}
+
+func (s *Pebble) loadBlockIterator(fromBlockNum, toBlockNum uint64) (*pebble.Iterator, *pebble.Snapshot, error) {
+	fromKey := keyBlock(fromBlockNum)
+
+	if toBlockNum == 0 {
+		toBlockNum = math.MaxInt64
+	} else {
+		toBlockNum++ // adding one to the range because the UpperBound is exclusive
+	}
+
+	toKey := keyBlock(toBlockNum)
+
+	snap := s.db.NewSnapshot()
+
+	it, err := snap.NewIter(&pebble.IterOptions{
+		LowerBound: fromKey,
+		UpperBound: toKey,
+	})
+	if err != nil {
+		return nil, nil, multierr.Append(snap.Close(), err)
+	}
+
+	return it, snap, nil
+}
+
+func (s *Pebble) BlockIterator(fromBlockNum, toBlockNum uint64) (Iterator[*types.Block], error) {
+	it, snap, err := s.loadBlockIterator(fromBlockNum, toBlockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PebbleBlockIter{pebbleBaseBlockIter: pebbleBaseBlockIter{i: it, s: snap}}, nil
+}
+
+func (s *Pebble) BlockReverseIterator(fromBlockNum, toBlockNum uint64) (Iterator[*types.Block], error) {
+	fromKey := keyBlock(fromBlockNum)
+
+	if toBlockNum == 0 {
+		toBlockNum = math.MaxInt64
+	} else {
+		toBlockNum++ // adding one to the range because the UpperBound is exclusive
+	}
+
+	toKey := keyBlock(toBlockNum)
+
+	snap := s.db.NewSnapshot()
+
+	it, err := snap.NewIter(&pebble.IterOptions{
+		LowerBound: fromKey,
+		UpperBound: toKey,
+	})
+	if err != nil {
+		return nil, multierr.Append(snap.Close(), err)
+	}
+
+	return &PebbleReverseBlockIter{pebbleBaseBlockIter: pebbleBaseBlockIter{i: it, s: snap}}, nil
+}
+
+func (s *Pebble) loadTxIterator(
+	fromBlockNum,
+	toBlockNum uint64,
+	fromTxIndex,
+	toTxIndex uint32,
+) (*pebble.Iterator, *pebble.Snapshot, error) {
+	fromKey := keyTx(fromBlockNum, fromTxIndex)
+
+	toKey := keyTx(toBlockNum, toTxIndex)
+
+	snap := s.db.NewSnapshot()
+
+	it, err := snap.NewIter(&pebble.IterOptions{
+		LowerBound: fromKey,
+		UpperBound: toKey,
+	})
+	if err != nil {
+		return nil, nil, multierr.Append(snap.Close(), err)
+	}
+
+	return it, snap, nil
+}
+
+func (s *Pebble) TxIterator(
+	fromBlockNum,
+	toBlockNum uint64,
+	fromTxIndex,
+	toTxIndex uint32,
+) (Iterator[*types.TxResult], error) {
+	if toBlockNum == 0 {
+		toBlockNum = math.MaxInt64
+	} else {
+		toBlockNum++ // adding one to the range because the UpperBound is exclusive
+	}
+
+	if toTxIndex == 0 {
+		toTxIndex = math.MaxUint32
+	} else {
+		toTxIndex++ // adding one to the range because the UpperBound is exclusive
+	}
+
+	it, snap, err := s.loadTxIterator(fromBlockNum, toBlockNum, fromTxIndex, toTxIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PebbleTxIter{
+		pebbleBaseTxIter: pebbleBaseTxIter{
+			i:         it,
+			s:         snap,
+			fromIndex: fromTxIndex,
+			toIndex:   toTxIndex,
+		},
+	}, nil
+}
+
+func (s *Pebble) TxReverseIterator(
+	fromBlockNum,
+	toBlockNum uint64,
+	fromTxIndex,
+	toTxIndex uint32,
+) (Iterator[*types.TxResult], error) {
+	if toBlockNum == 0 {
+		toBlockNum = math.MaxInt64
+	} else {
+		toBlockNum++ // adding one to the range because the UpperBound is exclusive
+	}
+
+	if toTxIndex == 0 {
+		toTxIndex = math.MaxUint32
+	} else {
+		toTxIndex++ // adding one to the range because the UpperBound is exclusive
+	}
+
+	it, snap, err := s.loadTxIterator(fromBlockNum, toBlockNum, fromTxIndex, toTxIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PebbleReverseTxIter{
+		pebbleBaseTxIter: pebbleBaseTxIter{
+			i:         it,
+			s:         snap,
+			fromIndex: fromTxIndex,
+			toIndex:   toTxIndex,
+		},
+	}, nil
+}
+
+func (s *Pebble) WriteBatch() Batch {
+	return &PebbleBatch{
+		b: s.db.NewBatch(),
+	}
+}
+
+func (s *Pebble) Close() error {
+	return s.db.Close()
+}
+
+type pebbleBaseBlockIter struct {
+	i *pebble.Iterator
+	s *pebble.Snapshot
+
+	init bool
+}
+
+func (pi *pebbleBaseBlockIter) Error() error {
+	return pi.i.Error()
+}
+
+func (pi *pebbleBaseBlockIter) Value() (*types.Block, error) {
+	return decodeBlock(pi.i.Value())
+}
+
+func (pi *pebbleBaseBlockIter) Close() error {
+	return multierr.Append(pi.i.Close(), pi.s.Close())
+}
+
+var _ Iterator[*types.Block] = &PebbleBlockIter{}
+
+type PebbleBlockIter struct {
+	pebbleBaseBlockIter
+}
+
+func (pi *PebbleBlockIter) Next() bool {
+	if !pi.init {
+		pi.init = true
+
+		return pi.i.First()
+	}
+
+	return pi.i.Valid() && pi.i.Next()
+}
+
+var _ Iterator[*types.Block] = &PebbleReverseBlockIter{}
+
+type PebbleReverseBlockIter struct {
+	pebbleBaseBlockIter
+}
+
+func (pi *PebbleReverseBlockIter) Next() bool {
+	if !pi.init {
+		pi.init = true
+
+		return pi.i.Last()
+	}
+
+	return pi.i.Valid() && pi.i.Prev()
+}
+
+type pebbleBaseTxIter struct {
+	nextError error
+	i         *pebble.Iterator
+	s         *pebble.Snapshot
+	fromIndex uint32
+	toIndex   uint32
+	init      bool
+}
+
+func (pi *pebbleBaseTxIter) Error() error {
+	if pi.nextError != nil {
+		return pi.nextError
+	}
+
+	return pi.i.Error()
+}
+
+func (pi *pebbleBaseTxIter) Value() (*types.TxResult, error) {
+	return decodeTx(pi.i.Value())
+}
+
+func (pi *pebbleBaseTxIter) Close() error {
+	return multierr.Append(pi.i.Close(), pi.s.Close())
+}
+
+var _ Iterator[*types.TxResult] = &PebbleTxIter{}
+
+type PebbleTxIter struct {
+	pebbleBaseTxIter
+}
+
+func (pi *PebbleTxIter) Next() bool {
+	for {
+		if !pi.init {
+			if !pi.i.First() {
+				return false
+			}
+
+			pi.init = true
+		} else if !pi.i.Next() {
+			return false
+		}
+
+		var buf []byte
+
+		key, _, err := decodeUnsafeStringAscending(pi.i.Key(), buf)
+		if err != nil {
+			pi.nextError = err
+
+			return false
+		}
+
+		key, _, err = decodeUint64Ascending(key)
+		if err != nil {
+			pi.nextError = err
+
+			return false
+		}
+
+		_, txIdx, err := decodeUint32Ascending(key)
+		if err != nil {
+			pi.nextError = err
+
+			return false
+		}
+
+		if txIdx >= pi.fromIndex && txIdx < pi.toIndex {
+			return true
+		}
+	}
+}
+
+var _ Iterator[*types.TxResult] = &PebbleReverseTxIter{}
+
+type PebbleReverseTxIter struct {
+	pebbleBaseTxIter
+}
+
+func (pi *PebbleReverseTxIter) Next() bool {
+	for {
+		if !pi.init {
+			if !pi.i.Last() {
+				return false
+			}
+
+			pi.init = true
+		} else if !pi.i.Prev() {
+			return false
+		}
+
+		var buf []byte
+
+		key, _, err := decodeUnsafeStringAscending(pi.i.Key(), buf)
+		if err != nil {
+			pi.nextError = err
+
+			return false
+		}
+
+		key, _, err = decodeUint64Ascending(key)
+		if err != nil {
+			pi.nextError = err
+
+			return false
+		}
+
+		_, txIdx, err := decodeUint32Ascending(key)
+		if err != nil {
+			pi.nextError = err
+
+			return false
+		}
+
+		if txIdx >= pi.fromIndex && txIdx < pi.toIndex {
+			return true
+		}
+	}
+}
+
+var _ Iterator[*types.TxResult] = &PebbleIndexedTxIter{}
+
+// PebbleIndexedTxIter iterates over a secondary index
+// whose values are primary transaction keys
+type PebbleIndexedTxIter struct {
+	i *pebble.Iterator
+	s *pebble.Snapshot
+
+	init bool
+}
+
+func (pi *PebbleIndexedTxIter) Next() bool {
+	if !pi.init {
+		pi.init = true
+
+		return pi.i.First()
+	}
+
+	return pi.i.Valid() && pi.i.Next()
+}
+
+func (pi *PebbleIndexedTxIter) Error() error {
+	return pi.i.Error()
+}
+
+func (pi *PebbleIndexedTxIter) Value() (*types.TxResult, error) {
+	tx, c, err := pi.s.Get(pi.i.Value())
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil, storageErrors.ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer c.Close()
+
+	return decodeTx(tx)
+}
+
+func (pi *PebbleIndexedTxIter) Close() error {
+	return multierr.Append(pi.i.Close(), pi.s.Close())
+}
+
+var _ Iterator[*indexerTypes.Package] = &PebblePackageIter{}
+
+type PebblePackageIter struct {
+	i *pebble.Iterator
+	s *pebble.Snapshot
+
+	init bool
+}
+
+func (pi *PebblePackageIter) Next() bool {
+	if !pi.init {
+		pi.init = true
+
+		return pi.i.First()
+	}
+
+	return pi.i.Valid() && pi.i.Next()
+}
+
+func (pi *PebblePackageIter) Error() error {
+	return pi.i.Error()
+}
+
+func (pi *PebblePackageIter) Value() (*indexerTypes.Package, error) {
+	return decodePackage(pi.i.Value())
+}
+
+func (pi *PebblePackageIter) Close() error {
+	return multierr.Append(pi.i.Close(), pi.s.Close())
+}
+
+var _ Iterator[string] = &PebblePackageImporterIter{}
+
+// PebblePackageImporterIter iterates over the package import index,
+// whose values are the importer package paths
+type PebblePackageImporterIter struct {
+	i *pebble.Iterator
+	s *pebble.Snapshot
+
+	init bool
+}
+
+func (pi *PebblePackageImporterIter) Next() bool {
+	if !pi.init {
+		pi.init = true
+
+		return pi.i.First()
+	}
+
+	return pi.i.Valid() && pi.i.Next()
+}
+
+func (pi *PebblePackageImporterIter) Error() error {
+	return pi.i.Error()
+}
+
+func (pi *PebblePackageImporterIter) Value() (string, error) {
+	return string(pi.i.Value()), nil
+}
+
+func (pi *PebblePackageImporterIter) Close() error {
+	return multierr.Append(pi.i.Close(), pi.s.Close())
+}
+
+var _ Iterator[*indexerTypes.SearchPosting] = &PebbleSearchPostingIter{}
+
+type PebbleSearchPostingIter struct {
+	i *pebble.Iterator
+	s *pebble.Snapshot
+
+	init bool
+}
+
+func (pi *PebbleSearchPostingIter) Next() bool {
+	if !pi.init {
+		pi.init = true
+
+		return pi.i.First()
+	}
+
+	return pi.i.Valid() && pi.i.Next()
+}
+
+func (pi *PebbleSearchPostingIter) Error() error {
+	return pi.i.Error()
+}
+
+func (pi *PebbleSearchPostingIter) Value() (*indexerTypes.SearchPosting, error) {
+	return decodeSearchPosting(pi.i.Value())
+}
+
+func (pi *PebbleSearchPostingIter) Close() error {
+	return multierr.Append(pi.i.Close(), pi.s.Close())
+}
+
+var _ Iterator[*indexerTypes.BlockFees] = &PebbleBlockFeesIter{}
+
+type PebbleBlockFeesIter struct {
+	i *pebble.Iterator
+	s *pebble.Snapshot
+
+	init bool
+}
+
+func (pi *PebbleBlockFeesIter) Next() bool {
+	if !pi.init {
+		pi.init = true
+
+		return pi.i.First()
+	}
+
+	return pi.i.Valid() && pi.i.Next()
+}
+
+func (pi *PebbleBlockFeesIter) Error() error {
+	return pi.i.Error()
+}
+
+func (pi *PebbleBlockFeesIter) Value() (*indexerTypes.BlockFees, error) {
+	return decodeBlockFees(pi.i.Value())
+}
+
+func (pi *PebbleBlockFeesIter) Close() error {
+	return multierr.Append(pi.i.Close(), pi.s.Close())
+}
+
+var _ Iterator[*indexerTypes.FuncGasStats] = &PebbleFuncGasStatsIter{}
+
+type PebbleFuncGasStatsIter struct {
+	i *pebble.Iterator
+	s *pebble.Snapshot
+
+	init bool
+}
+
+func (pi *PebbleFuncGasStatsIter) Next() bool {
+	if !pi.init {
+		pi.init = true
+
+		return pi.i.First()
+	}
+
+	return pi.i.Valid() && pi.i.Next()
+}
+
+func (pi *PebbleFuncGasStatsIter) Error() error {
+	return pi.i.Error()
+}
+
+func (pi *PebbleFuncGasStatsIter) Value() (*indexerTypes.FuncGasStats, error) {
+	return decodeFuncGasStats(pi.i.Value())
+}
+
+func (pi *PebbleFuncGasStatsIter) Close() error {
+	return multierr.Append(pi.i.Close(), pi.s.Close())
+}
+
+var _ Iterator[*indexerTypes.RealmFailure] = &PebbleRealmFailureIter{}
+
+type PebbleRealmFailureIter struct {
+	i *pebble.Iterator
+	s *pebble.Snapshot
+
+	init bool
+}
+
+func (pi *PebbleRealmFailureIter) Next() bool {
+	if !pi.init {
+		pi.init = true
+
+		return pi.i.First()
+	}
+
+	return pi.i.Valid() && pi.i.Next()
+}
+
+func (pi *PebbleRealmFailureIter) Error() error {
+	return pi.i.Error()
+}
+
+func (pi *PebbleRealmFailureIter) Value() (*indexerTypes.RealmFailure, error) {
+	return decodeRealmFailure(pi.i.Value())
+}
+
+func (pi *PebbleRealmFailureIter) Close() error {
+	return multierr.Append(pi.i.Close(), pi.s.Close())
+}
+
+var _ Iterator[*indexerTypes.Transfer] = &PebbleTransferIter{}
+
+type PebbleTransferIter struct {
+	i *pebble.Iterator
+	s *pebble.Snapshot
+
+	init bool
+}
+
+func (pi *PebbleTransferIter) Next() bool {
+	if !pi.init {
+		pi.init = true
+
+		return pi.i.First()
+	}
+
+	return pi.i.Valid() && pi.i.Next()
+}
+
+func (pi *PebbleTransferIter) Error() error {
+	return pi.i.Error()
+}
+
+func (pi *PebbleTransferIter) Value() (*indexerTypes.Transfer, error) {
+	return decodeTransfer(pi.i.Value())
+}
+
+func (pi *PebbleTransferIter) Close() error {
+	return multierr.Append(pi.i.Close(), pi.s.Close())
+}
+
+var _ Iterator[*indexerTypes.Transfer] = &PebbleIndexedTransferIter{}
+
+type PebbleIndexedTransferIter struct {
+	i *pebble.Iterator
+	s *pebble.Snapshot
+
+	init bool
+}
+
+func (pi *PebbleIndexedTransferIter) Next() bool {
+	if !pi.init {
+		pi.init = true
+
+		return pi.i.First()
+	}
+
+	return pi.i.Valid() && pi.i.Next()
+}
+
+func (pi *PebbleIndexedTransferIter) Error() error {
+	return pi.i.Error()
+}
+
+func (pi *PebbleIndexedTransferIter) Value() (*indexerTypes.Transfer, error) {
+	transfer, c, err := pi.s.Get(pi.i.Value())
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil, storageErrors.ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer c.Close()
+
+	return decodeTransfer(transfer)
+}
+
+func (pi *PebbleIndexedTransferIter) Close() error {
+	return multierr.Append(pi.i.Close(), pi.s.Close())
+}
+
+var _ Iterator[*indexerTypes.BalanceDelta] = &PebbleBalanceDeltaIter{}
+
+type PebbleBalanceDeltaIter struct {
+	i *pebble.Iterator
+	s *pebble.Snapshot
+
+	init bool
+}
+
+func (pi *PebbleBalanceDeltaIter) Next() bool {
+	if !pi.init {
+		pi.init = true
+
+		return pi.i.First()
+	}
+
+	return pi.i.Valid() && pi.i.Next()
+}
+
+func (pi *PebbleBalanceDeltaIter) Error() error {
+	return pi.i.Error()
+}
+
+func (pi *PebbleBalanceDeltaIter) Value() (*indexerTypes.BalanceDelta, error) {
+	return decodeBalanceDelta(pi.i.Value())
+}
+
+func (pi *PebbleBalanceDeltaIter) Close() error {
+	return multierr.Append(pi.i.Close(), pi.s.Close())
+}
+
+var _ Iterator[*indexerTypes.Token] = &PebbleTokenIter{}
+
+type PebbleTokenIter struct {
+	i *pebble.Iterator
+	s *pebble.Snapshot
+
+	init bool
+}
+
+func (pi *PebbleTokenIter) Next() bool {
+	if !pi.init {
+		pi.init = true
+
+		return pi.i.First()
+	}
+
+	return pi.i.Valid() && pi.i.Next()
+}
+
+func (pi *PebbleTokenIter) Error() error {
+	return pi.i.Error()
+}
+
+func (pi *PebbleTokenIter) Value() (*indexerTypes.Token, error) {
+	return decodeToken(pi.i.Value())
+}
+
+func (pi *PebbleTokenIter) Close() error {
+	return multierr.Append(pi.i.Close(), pi.s.Close())
+}
+
+var _ Iterator[*indexerTypes.TokenTransfer] = &PebbleTokenTransferIter{}
+
+type PebbleTokenTransferIter struct {
+	i *pebble.Iterator
+	s *pebble.Snapshot
+
+	init bool
+}
+
+func (pi *PebbleTokenTransferIter) Next() bool {
+	if !pi.init {
+		pi.init = true
+
+		return pi.i.First()
+	}
+
+	return pi.i.Valid() && pi.i.Next()
+}
+
+func (pi *PebbleTokenTransferIter) Error() error {
+	return pi.i.Error()
+}
+
+func (pi *PebbleTokenTransferIter) Value() (*indexerTypes.TokenTransfer, error) {
+	return decodeTokenTransfer(pi.i.Value())
+}
+
+func (pi *PebbleTokenTransferIter) Close() error {
+	return multierr.Append(pi.i.Close(), pi.s.Close())
+}
+
+var _ Iterator[*indexerTypes.TokenTransfer] = &PebbleIndexedTokenTransferIter{}
+
+type PebbleIndexedTokenTransferIter struct {
+	i *pebble.Iterator
+	s *pebble.Snapshot
+
+	init bool
+}
+
+func (pi *PebbleIndexedTokenTransferIter) Next() bool {
+	if !pi.init {
+		pi.init = true
+
+		return pi.i.First()
+	}
+
+	return pi.i.Valid() && pi.i.Next()
+}
+
+func (pi *PebbleIndexedTokenTransferIter) Error() error {
+	return pi.i.Error()
+}
+
+func (pi *PebbleIndexedTokenTransferIter) Value() (*indexerTypes.TokenTransfer, error) {
+	transfer, c, err := pi.s.Get(pi.i.Value())
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil, storageErrors.ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer c.Close()
+
+	return decodeTokenTransfer(transfer)
+}
+
+func (pi *PebbleIndexedTokenTransferIter) Close() error {
+	return multierr.Append(pi.i.Close(), pi.s.Close())
+}
+
+var _ Iterator[*indexerTypes.TokenBalance] = &PebbleTokenBalanceIter{}
+
+type PebbleTokenBalanceIter struct {
+	i *pebble.Iterator
+	s *pebble.Snapshot
+
+	init bool
+}
+
+func (pi *PebbleTokenBalanceIter) Next() bool {
+	if !pi.init {
+		pi.init = true
+
+		return pi.i.First()
+	}
+
+	return pi.i.Valid() && pi.i.Next()
+}
+
+func (pi *PebbleTokenBalanceIter) Error() error {
+	return pi.i.Error()
+}
+
+func (pi *PebbleTokenBalanceIter) Value() (*indexerTypes.TokenBalance, error) {
+	return decodeTokenBalance(pi.i.Value())
+}
+
+func (pi *PebbleTokenBalanceIter) Close() error {
+	return multierr.Append(pi.i.Close(), pi.s.Close())
+}
+
+var _ Iterator[*indexerTypes.NFTCollection] = &PebbleNFTCollectionIter{}
+
+type PebbleNFTCollectionIter struct {
+	i *pebble.Iterator
+	s *pebble.Snapshot
+
+	init bool
+}
+
+func (pi *PebbleNFTCollectionIter) Next() bool {
+	if !pi.init {
+		pi.init = true
+
+		return pi.i.First()
+	}
+
+	return pi.i.Valid() && pi.i.Next()
+}
+
+func (pi *PebbleNFTCollectionIter) Error() error {
+	return pi.i.Error()
+}
+
+func (pi *PebbleNFTCollectionIter) Value() (*indexerTypes.NFTCollection, error) {
+	return decodeNFTCollection(pi.i.Value())
+}
+
+func (pi *PebbleNFTCollectionIter) Close() error {
+	return multierr.Append(pi.i.Close(), pi.s.Close())
+}
+
+var _ Iterator[*indexerTypes.NFT] = &PebbleNFTIter{}
+
+type PebbleNFTIter struct {
+	i *pebble.Iterator
+	s *pebble.Snapshot
+
+	init bool
+}
+
+func (pi *PebbleNFTIter) Next() bool {
+	if !pi.init {
+		pi.init = true
+
+		return pi.i.First()
+	}
+
+	return pi.i.Valid() && pi.i.Next()
+}
+
+func (pi *PebbleNFTIter) Error() error {
+	return pi.i.Error()
+}
+
+func (pi *PebbleNFTIter) Value() (*indexerTypes.NFT, error) {
+	return decodeNFT(pi.i.Value())
+}
+
+func (pi *PebbleNFTIter) Close() error {
+	return multierr.Append(pi.i.Close(), pi.s.Close())
+}
+
+var _ Iterator[*indexerTypes.NFTEvent] = &PebbleNFTEventIter{}
+
+type PebbleNFTEventIter struct {
+	i *pebble.Iterator
+	s *pebble.Snapshot
+
+	init bool
+}
+
+func (pi *PebbleNFTEventIter) Next() bool {
+	if !pi.init {
+		pi.init = true
+
+		return pi.i.First()
+	}
+
+	return pi.i.Valid() && pi.i.Next()
+}
+
+func (pi *PebbleNFTEventIter) Error() error {
+	return pi.i.Error()
+}
+
+func (pi *PebbleNFTEventIter) Value() (*indexerTypes.NFTEvent, error) {
+	return decodeNFTEvent(pi.i.Value())
+}
+
+func (pi *PebbleNFTEventIter) Close() error {
+	return multierr.Append(pi.i.Close(), pi.s.Close())
+}
+
+var _ Iterator[*indexerTypes.BlockSignatures] = &PebbleBlockSignaturesIter{}
+
+type PebbleBlockSignaturesIter struct {
+	i *pebble.Iterator
+	s *pebble.Snapshot
+
+	init bool
+}
+
+func (pi *PebbleBlockSignaturesIter) Next() bool {
+	if !pi.init {
+		pi.init = true
+
+		return pi.i.First()
+	}
+
+	return pi.i.Valid() && pi.i.Next()
+}
+
+func (pi *PebbleBlockSignaturesIter) Error() error {
+	return pi.i.Error()
+}
+
+func (pi *PebbleBlockSignaturesIter) Value() (*indexerTypes.BlockSignatures, error) {
+	return decodeBlockSignatures(pi.i.Value())
+}
+
+func (pi *PebbleBlockSignaturesIter) Close() error {
+	return multierr.Append(pi.i.Close(), pi.s.Close())
+}
+
+var _ Batch = &PebbleBatch{}
+
+type PebbleBatch struct {
+	b *pebble.Batch
+}
+
+func (b *PebbleBatch) SetLatestHeight(h uint64) error {
+	var val []byte
+
+	val = encodeUint64Ascending(val, h)
+
+	return b.b.Set([]byte(keyLatestHeight), val, pebble.NoSync)
+}
+
+func (b *PebbleBatch) SetGenesis(genesis *indexerTypes.Genesis) error {
+	encodedGenesis, err := encodeGenesis(genesis)
+	if err != nil {
+		return err
+	}
+
+	return b.b.Set([]byte(keyGenesis), encodedGenesis, pebble.NoSync)
+}
+
+func (b *PebbleBatch) SetBlock(block *types.Block) error {
+	eb, err := encodeBlock(block)
+	if err != nil {
+		return err
+	}
+
+	key := keyBlock(uint64(block.Height))
+
+	return b.b.Set(
+		key,
+		eb,
+		pebble.NoSync,
+	)
 }
 
-func (s *Pebble) WriteBatch() Batch {
-	return &PebbleBatch{
-		b: s.db.NewBatch(),
+func (b *PebbleBatch) SetTx(tx *types.TxResult) error {
+	encodedTx, err := encodeTx(tx)
+	if err != nil {
+		return err
 	}
-}
 
-func (s *Pebble) Close() error {
-	return s.db.Close()
-}
+	key := keyTx(uint64(tx.Height), tx.Index)
 
-type pebbleBaseBlockIter struct {
-	i *pebble.Iterator
-	s *pebble.Snapshot
+	// write secondary index to be able to query by tx hash
+	hashIndexKey := keyHashTx(base64.StdEncoding.EncodeToString(tx.Tx.Hash()))
+	if err := b.b.Set(hashIndexKey, key, pebble.NoSync); err != nil {
+		return err
+	}
 
-	init bool
+	return b.b.Set(
+		key,
+		encodedTx,
+		pebble.NoSync,
+	)
 }
 
-func (pi *pebbleBaseBlockIter) Error() error {
-	return pi.i.Error()
-}
+func (b *PebbleBatch) SetAccount(account *indexerTypes.Account) error {
+	encodedAccount, err := encodeAccount(account)
+	if err != nil {
+		return err
+	}
 
-func (pi *pebbleBaseBlockIter) Value() (*types.Block, error) {
-	return decodeBlock(pi.i.Value())
+	return b.b.Set(
+		keyAccount(account.Address),
+		encodedAccount,
+		pebble.NoSync,
+	)
 }
 
-func (pi *pebbleBaseBlockIter) Close() error {
-	return multierr.Append(pi.i.Close(), pi.s.Close())
+func (b *PebbleBatch) SetAccountTx(address string, blockNum uint64, txIndex uint32) error {
+	return b.b.Set(
+		keyAccountTx(address, blockNum, txIndex),
+		keyTx(blockNum, txIndex),
+		pebble.NoSync,
+	)
 }
 
-var _ Iterator[*types.Block] = &PebbleBlockIter{}
+func (b *PebbleBatch) SetPackage(pkg *indexerTypes.Package) error {
+	encodedPackage, err := encodePackage(pkg)
+	if err != nil {
+		return err
+	}
 
-type PebbleBlockIter struct {
-	pebbleBaseBlockIter
+	return b.b.Set(
+		keyPackage(pkg.Path),
+		encodedPackage,
+		pebble.NoSync,
+	)
 }
 
-func (pi *PebbleBlockIter) Next() bool {
-	if !pi.init {
-		pi.init = true
+func (b *PebbleBatch) SetPackageImport(importedPath, importerPath string) error {
+	return b.b.Set(
+		keyPackageImporter(importedPath, importerPath),
+		[]byte(importerPath),
+		pebble.NoSync,
+	)
+}
 
-		return pi.i.First()
+func (b *PebbleBatch) SetSearchDocument(doc *indexerTypes.SearchDocument, terms map[string][]uint32) error {
+	encodedDoc, err := encodeSearchDocument(doc)
+	if err != nil {
+		return err
 	}
 
-	return pi.i.Valid() && pi.i.Next()
-}
-
-var _ Iterator[*types.Block] = &PebbleReverseBlockIter{}
+	id := doc.ID()
 
-type PebbleReverseBlockIter struct {
-	pebbleBaseBlockIter
-}
+	if err := b.b.Set(keySearchDocument(id), encodedDoc, pebble.NoSync); err != nil {
+		return err
+	}
 
-func (pi *PebbleReverseBlockIter) Next() bool {
-	if !pi.init {
-		pi.init = true
+	for term, positions := range terms {
+		encodedPosting, err := encodeSearchPosting(&indexerTypes.SearchPosting{
+			Term:       term,
+			DocumentID: id,
+			Kind:       doc.Kind,
+			Positions:  positions,
+		})
+		if err != nil {
+			return err
+		}
 
-		return pi.i.Last()
+		if err := b.b.Set(keySearchPosting(term, id), encodedPosting, pebble.NoSync); err != nil {
+			return err
+		}
 	}
 
-	return pi.i.Valid() && pi.i.Prev()
-}
-
-type pebbleBaseTxIter struct {
-	nextError error
-	i         *pebble.Iterator
-	s         *pebble.Snapshot
-	fromIndex uint32
-	toIndex   uint32
-	init      bool
+	return nil
 }
 
-func (pi *pebbleBaseTxIter) Error() error {
-	if pi.nextError != nil {
-		return pi.nextError
+func (b *PebbleBatch) SetBlockFees(fees *indexerTypes.BlockFees) error {
+	encodedFees, err := encodeBlockFees(fees)
+	if err != nil {
+		return err
 	}
 
-	return pi.i.Error()
+	return b.b.Set(
+		keyBlockFees(uint64(fees.Height)),
+		encodedFees,
+		pebble.NoSync,
+	)
 }
 
-func (pi *pebbleBaseTxIter) Value() (*types.TxResult, error) {
-	return decodeTx(pi.i.Value())
-}
+func (b *PebbleBatch) SetFuncGasStats(stats *indexerTypes.FuncGasStats) error {
+	encodedStats, err := encodeFuncGasStats(stats)
+	if err != nil {
+		return err
+	}
 
-func (pi *pebbleBaseTxIter) Close() error {
-	return multierr.Append(pi.i.Close(), pi.s.Close())
+	return b.b.Set(
+		keyFuncGasStats(stats.PkgPath, stats.Func, uint64(stats.Height)),
+		encodedStats,
+		pebble.NoSync,
+	)
 }
 
-var _ Iterator[*types.TxResult] = &PebbleTxIter{}
+func (b *PebbleBatch) SetRealmFailure(failure *indexerTypes.RealmFailure) error {
+	encodedFailure, err := encodeRealmFailure(failure)
+	if err != nil {
+		return err
+	}
 
-type PebbleTxIter struct {
-	pebbleBaseTxIter
+	return b.b.Set(
+		keyRealmFailure(failure.PkgPath, uint64(failure.Height), failure.Index, failure.MsgIndex),
+		encodedFailure,
+		pebble.NoSync,
+	)
 }
 
-func (pi *PebbleTxIter) Next() bool {
-	for {
-		if !pi.init {
-			if !pi.i.First() {
-				return false
-			}
-
-			pi.init = true
-		} else if !pi.i.Next() {
-			return false
-		}
-
-		var buf []byte
+func (b *PebbleBatch) SetTransfer(transfer *indexerTypes.Transfer) error {
+	encodedTransfer, err := encodeTransfer(transfer)
+	if err != nil {
+		return err
+	}
 
-		key, _, err := decodeUnsafeStringAscending(pi.i.Key(), buf)
-		if err != nil {
-			pi.nextError = err
+	key := keyTransfer(uint64(transfer.Height), transfer.Index, transfer.Position)
 
-			return false
+	// write secondary index to be able to query by sender and recipient
+	for _, address := range uniqueAddresses(transfer.From, transfer.To) {
+		indexKey := keyAddressTransfer(address, uint64(transfer.Height), transfer.Index, transfer.Position)
+		if err := b.b.Set(indexKey, key, pebble.NoSync); err != nil {
+			return err
 		}
+	}
 
-		key, _, err = decodeUint64Ascending(key)
-		if err != nil {
-			pi.nextError = err
-
-			return false
-		}
+	return b.b.Set(
+		key,
+		encodedTransfer,
+		pebble.NoSync,
+	)
+}
 
-		_, txIdx, err := decodeUint32Ascending(key)
-		if err != nil {
-			pi.nextError = err
+// uniqueAddresses returns the set, distinct addresses, in order
+func uniqueAddresses(addresses ...string) []string {
+	unique := make([]string, 0, len(addresses))
 
-			return false
+	for _, address := range addresses {
+		if address == "" || slices.Contains(unique, address) {
+			continue
 		}
 
-		if txIdx >= pi.fromIndex && txIdx < pi.toIndex {
-			return true
-		}
+		unique = append(unique, address)
 	}
+
+	return unique
 }
 
-var _ Iterator[*types.TxResult] = &PebbleReverseTxIter{}
+func (b *PebbleBatch) SetBalanceDelta(delta *indexerTypes.BalanceDelta) error {
+	encodedDelta, err := encodeBalanceDelta(delta)
+	if err != nil {
+		return err
+	}
 
-type PebbleReverseTxIter struct {
-	pebbleBaseTxIter
+	return b.b.Set(
+		keyBalanceDelta(delta.Address, delta.Denom, uint64(delta.Height)),
+		encodedDelta,
+		pebble.NoSync,
+	)
 }
 
-func (pi *PebbleReverseTxIter) Next() bool {
-	for {
-		if !pi.init {
-			if !pi.i.Last() {
-				return false
-			}
+func (b *PebbleBatch) SetToken(token *indexerTypes.Token) error {
+	encodedToken, err := encodeToken(token)
+	if err != nil {
+		return err
+	}
 
-			pi.init = true
-		} else if !pi.i.Prev() {
-			return false
-		}
+	return b.b.Set(keyToken(token.ID), encodedToken, pebble.NoSync)
+}
 
-		var buf []byte
+func (b *PebbleBatch) SetTokenTransfer(transfer *indexerTypes.TokenTransfer) error {
+	encodedTransfer, err := encodeTokenTransfer(transfer)
+	if err != nil {
+		return err
+	}
 
-		key, _, err := decodeUnsafeStringAscending(pi.i.Key(), buf)
-		if err != nil {
-			pi.nextError = err
+	key := keyTokenTransfer(uint64(transfer.Height), transfer.Index, transfer.EventIndex)
 
-			return false
+	// write secondary index to be able to query by sender and recipient.
+	// Mints have no sender, and burns have no recipient
+	for _, address := range uniqueAddresses(transfer.From, transfer.To) {
+		indexKey := keyAddressTokenTransfer(address, uint64(transfer.Height), transfer.Index, transfer.EventIndex)
+		if err := b.b.Set(indexKey, key, pebble.NoSync); err != nil {
+			return err
 		}
+	}
 
-		key, _, err = decodeUint64Ascending(key)
-		if err != nil {
-			pi.nextError = err
-
-			return false
-		}
+	return b.b.Set(
+		key,
+		encodedTransfer,
+		pebble.NoSync,
+	)
+}
 
-		_, txIdx, err := decodeUint32Ascending(key)
-		if err != nil {
-			pi.nextError = err
+func (b *PebbleBatch) SetTokenBalance(balance *indexerTypes.TokenBalance) error {
+	encodedBalance, err := encodeTokenBalance(balance)
+	if err != nil {
+		return err
+	}
 
-			return false
-		}
+	return b.b.Set(keyTokenBalance(balance.Address, balance.Token), encodedBalance, pebble.NoSync)
+}
 
-		if txIdx >= pi.fromIndex && txIdx < pi.toIndex {
-			return true
-		}
+func (b *PebbleBatch) SetNFTCollection(collection *indexerTypes.NFTCollection) error {
+	encodedCollection, err := encodeNFTCollection(collection)
+	if err != nil {
+		return err
 	}
+
+	return b.b.Set(keyNFTCollection(collection.PkgPath), encodedCollection, pebble.NoSync)
 }
 
-var _ Batch = &PebbleBatch{}
+func (b *PebbleBatch) SetNFT(nft *indexerTypes.NFT) error {
+	encodedNFT, err := encodeNFT(nft)
+	if err != nil {
+		return err
+	}
 
-type PebbleBatch struct {
-	b *pebble.Batch
-}
+	if err := b.b.Set(keyNFT(nft.Collection, nft.TokenID), encodedNFT, pebble.NoSync); err != nil {
+		return err
+	}
 
-func (b *PebbleBatch) SetLatestHeight(h uint64) error {
-	var val []byte
+	if nft.Owner == "" {
+		return nil
+	}
 
-	val = encodeUint64Ascending(val, h)
+	return b.b.Set(keyNFTOwner(nft.Owner, nft.Collection, nft.TokenID), encodedNFT, pebble.NoSync)
+}
 
-	return b.b.Set([]byte(keyLatestHeight), val, pebble.NoSync)
+func (b *PebbleBatch) RemoveNFTOwner(owner, collection, tokenID string) error {
+	return b.b.Delete(keyNFTOwner(owner, collection, tokenID), pebble.NoSync)
 }
 
-func (b *PebbleBatch) SetBlock(block *types.Block) error {
-	eb, err := encodeBlock(block)
+func (b *PebbleBatch) SetNFTEvent(event *indexerTypes.NFTEvent) error {
+	encodedEvent, err := encodeNFTEvent(event)
 	if err != nil {
 		return err
 	}
 
-	key := keyBlock(uint64(block.Height))
-
 	return b.b.Set(
-		key,
-		eb,
+		keyNFTEvent(event.Collection, event.TokenID, uint64(event.Height), event.Index, event.EventIndex),
+		encodedEvent,
 		pebble.NoSync,
 	)
 }
 
-func (b *PebbleBatch) SetTx(tx *types.TxResult) error {
-	encodedTx, err := encodeTx(tx)
+func (b *PebbleBatch) SetValidatorSet(set *indexerTypes.ValidatorSet) error {
+	encodedSet, err := encodeValidatorSet(set)
 	if err != nil {
 		return err
 	}
 
-	key := keyTx(uint64(tx.Height), tx.Index)
+	return b.b.Set(keyValidatorSet(uint64(set.Height)), encodedSet, pebble.NoSync)
+}
 
-	// write secondary index to be able to query by tx hash
-	hashIndexKey := keyHashTx(base64.StdEncoding.EncodeToString(tx.Tx.Hash()))
-	if err := b.b.Set(hashIndexKey, key, pebble.NoSync); err != nil {
+func (b *PebbleBatch) SetBlockSignatures(signatures *indexerTypes.BlockSignatures) error {
+	encodedSignatures, err := encodeBlockSignatures(signatures)
+	if err != nil {
 		return err
 	}
 
-	return b.b.Set(
-		key,
-		encodedTx,
-		pebble.NoSync,
-	)
+	return b.b.Set(keyBlockSignatures(uint64(signatures.Height)), encodedSignatures, pebble.NoSync)
+}
+
+func (b *PebbleBatch) SetBlockResults(results *indexerTypes.BlockResults) error {
+	encodedResults, err := encodeBlockResults(results)
+	if err != nil {
+		return err
+	}
+
+	return b.b.Set(keyBlockResults(uint64(results.Height)), encodedResults, pebble.NoSync)
 }
 
 func (b *PebbleBatch) Commit() error {
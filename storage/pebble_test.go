@@ -1,16 +1,23 @@
 package storage
 
 import (
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/gnolang/gno/gnovm/stdlibs/chain"
 	"github.com/gnolang/gno/tm2/pkg/amino"
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
 	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/crypto/ed25519"
 	"github.com/gnolang/gno/tm2/pkg/std"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	storageErrors "github.com/gnolang/tx-indexer/storage/errors"
+	indexerTypes "github.com/gnolang/tx-indexer/types"
 )
 
 func TestStorage_New(t *testing.T) {
@@ -107,6 +114,47 @@ func TestStorage_Tx(t *testing.T) {
 	}
 }
 
+func TestStorage_TxByHash(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewPebble(t.TempDir())
+	require.NoError(t, err)
+
+	defer func() {
+		assert.NoError(t, s.Close())
+	}()
+
+	txs := generateRandomTxs(t, 10)
+
+	wb := s.WriteBatch()
+
+	for _, tx := range txs {
+		assert.NoError(t, wb.SetTx(tx))
+	}
+
+	require.NoError(t, wb.Commit())
+
+	for _, tx := range txs {
+		hash := tx.Tx.Hash()
+
+		// Make sure all supported hash encodings resolve to the same tx
+		for _, encoded := range []string{
+			base64.StdEncoding.EncodeToString(hash),
+			base64.URLEncoding.EncodeToString(hash),
+			base64.RawURLEncoding.EncodeToString(hash),
+			hex.EncodeToString(hash),
+		} {
+			savedTx, err := s.GetTxByHash(encoded)
+			require.NoError(t, err)
+			assert.Equal(t, tx, savedTx)
+		}
+	}
+
+	// Make sure unknown hashes are not found
+	_, err = s.GetTxByHash(hex.EncodeToString(make([]byte, indexerTypes.HashLength)))
+	assert.ErrorIs(t, err, storageErrors.ErrNotFound)
+}
+
 func TestStorageIters(t *testing.T) {
 	t.Parallel()
 
@@ -257,3 +305,1191 @@ func generateRandomTxs(t *testing.T, count int) []*types.TxResult {
 
 	return txs
 }
+
+func TestStorage_Account(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewPebble(t.TempDir())
+	require.NoError(t, err)
+
+	defer func() {
+		assert.NoError(t, s.Close())
+	}()
+
+	// Make sure no account exists
+	_, err = s.GetAccount("g1unknown")
+	require.ErrorIs(t, err, storageErrors.ErrNotFound)
+
+	account := &indexerTypes.Account{
+		Address:          "g1account",
+		FirstSeenHeight:  1,
+		FirstSeenTime:    time.Unix(100, 0).UTC(),
+		LastSeenHeight:   10,
+		LastSeenTime:     time.Unix(200, 0).UTC(),
+		TxCount:          5,
+		Sent:             std.NewCoins(std.NewCoin("ugnot", 100)),
+		Received:         std.NewCoins(std.NewCoin("ugnot", 50)),
+		RealmsCalled:     []string{"gno.land/r/demo/users"},
+		PackagesDeployed: []string{"gno.land/p/demo/avl"},
+	}
+
+	b := s.WriteBatch()
+	require.NoError(t, b.SetAccount(account))
+	require.NoError(t, b.Commit())
+
+	savedAccount, err := s.GetAccount(account.Address)
+	require.NoError(t, err)
+	assert.Equal(t, account, savedAccount)
+}
+
+func TestStorage_AccountTxIterator(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewPebble(t.TempDir())
+	require.NoError(t, err)
+
+	defer func() {
+		assert.NoError(t, s.Close())
+	}()
+
+	var (
+		txs     = generateRandomTxs(t, 10)
+		address = "g1account"
+	)
+
+	b := s.WriteBatch()
+
+	for _, tx := range txs {
+		require.NoError(t, b.SetTx(tx))
+
+		// Link only the even transactions to the address
+		if tx.Index%2 == 0 {
+			require.NoError(t, b.SetAccountTx(address, uint64(tx.Height), tx.Index))
+		}
+
+		// Link all transactions to a different address
+		require.NoError(t, b.SetAccountTx(address+"2", uint64(tx.Height), tx.Index))
+	}
+
+	require.NoError(t, b.Commit())
+
+	collect := func(fromBlockNum uint64, fromTxIndex uint32) []*types.TxResult {
+		t.Helper()
+
+		it, err := s.AccountTxIterator(address, fromBlockNum, fromTxIndex)
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, it.Close())
+		}()
+
+		out := make([]*types.TxResult, 0)
+
+		for it.Next() {
+			tx, err := it.Value()
+			require.NoError(t, err)
+
+			out = append(out, tx)
+		}
+
+		require.NoError(t, it.Error())
+
+		return out
+	}
+
+	// Fetch all the linked transactions
+	linked := collect(0, 0)
+	require.Len(t, linked, 5)
+
+	for i, tx := range linked {
+		assert.Equal(t, txs[i*2], tx)
+	}
+
+	// Fetch the linked transactions after a specific position
+	linked = collect(0, 5)
+	require.Len(t, linked, 2)
+
+	assert.Equal(t, txs[6], linked[0])
+	assert.Equal(t, txs[8], linked[1])
+}
+
+func TestStorage_Package(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewPebble(t.TempDir())
+	require.NoError(t, err)
+
+	defer func() {
+		assert.NoError(t, s.Close())
+	}()
+
+	// Make sure unknown packages are not found
+	_, err = s.GetPackage("gno.land/p/demo/avl")
+	require.ErrorIs(t, err, storageErrors.ErrNotFound)
+
+	packages := []*indexerTypes.Package{
+		{
+			Path:         "gno.land/r/demo/users",
+			Name:         "users",
+			Creator:      "g1creator",
+			Files:        []string{"users.gno"},
+			DeployHeight: 2,
+			Success:      true,
+			RecentCallers: []indexerTypes.PackageCall{
+				{
+					Caller:  "g1caller",
+					Func:    "Register",
+					Height:  3,
+					Success: true,
+				},
+			},
+		},
+		{
+			Path:         "gno.land/p/demo/avl",
+			Name:         "avl",
+			Creator:      "g1creator",
+			Files:        []string{"avl.gno", "node.gno"},
+			DeployHeight: 1,
+			DeployIndex:  1,
+			Success:      true,
+		},
+	}
+
+	b := s.WriteBatch()
+
+	for _, pkg := range packages {
+		require.NoError(t, b.SetPackage(pkg))
+	}
+
+	require.NoError(t, b.Commit())
+
+	for _, pkg := range packages {
+		savedPkg, err := s.GetPackage(pkg.Path)
+		require.NoError(t, err)
+
+		assert.Equal(t, pkg, savedPkg)
+	}
+
+	// Make sure the packages are iterated in path order
+	it, err := s.PackageIterator()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, it.Close())
+	}()
+
+	paths := make([]string, 0, len(packages))
+
+	for it.Next() {
+		pkg, err := it.Value()
+		require.NoError(t, err)
+
+		paths = append(paths, pkg.Path)
+	}
+
+	require.NoError(t, it.Error())
+	assert.Equal(t, []string{"gno.land/p/demo/avl", "gno.land/r/demo/users"}, paths)
+}
+
+func TestStorage_PackageImporterIterator(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewPebble(t.TempDir())
+	require.NoError(t, err)
+
+	defer func() {
+		assert.NoError(t, s.Close())
+	}()
+
+	b := s.WriteBatch()
+
+	require.NoError(t, b.SetPackageImport("gno.land/p/demo/avl", "gno.land/r/demo/users"))
+	require.NoError(t, b.SetPackageImport("gno.land/p/demo/avl", "gno.land/p/demo/avl/pager"))
+	require.NoError(t, b.SetPackageImport("gno.land/p/demo/avl/pager", "gno.land/r/demo/boards"))
+
+	require.NoError(t, b.Commit())
+
+	collect := func(path string) []string {
+		t.Helper()
+
+		it, err := s.PackageImporterIterator(path)
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, it.Close())
+		}()
+
+		out := make([]string, 0)
+
+		for it.Next() {
+			importer, err := it.Value()
+			require.NoError(t, err)
+
+			out = append(out, importer)
+		}
+
+		require.NoError(t, it.Error())
+
+		return out
+	}
+
+	// Make sure only the direct importers are returned, ordered by path
+	assert.Equal(t, []string{"gno.land/p/demo/avl/pager", "gno.land/r/demo/users"}, collect("gno.land/p/demo/avl"))
+	assert.Equal(t, []string{"gno.land/r/demo/boards"}, collect("gno.land/p/demo/avl/pager"))
+	assert.Empty(t, collect("gno.land/p/demo/ufmt"))
+}
+
+func TestStorage_Search(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewPebble(t.TempDir())
+	require.NoError(t, err)
+
+	defer func() {
+		assert.NoError(t, s.Close())
+	}()
+
+	var (
+		memo = &indexerTypes.SearchDocument{
+			Kind:   indexerTypes.SearchKindMemo,
+			Height: 1,
+			Length: 3,
+		}
+		event = &indexerTypes.SearchDocument{
+			Kind:         indexerTypes.SearchKindEvent,
+			PackagePath:  "gno.land/r/demo/users",
+			AttributeKey: "name",
+			Height:       2,
+			EventIndex:   1,
+			Length:       1,
+		}
+	)
+
+	b := s.WriteBatch()
+
+	require.NoError(t, b.SetSearchDocument(memo, map[string][]uint32{
+		"ab":  {0, 2},
+		"abc": {1},
+	}))
+	require.NoError(t, b.SetSearchDocument(event, map[string][]uint32{
+		"abd": {0},
+	}))
+
+	require.NoError(t, b.Commit())
+
+	// Make sure the documents are saved
+	savedDoc, err := s.GetSearchDocument(event.ID())
+	require.NoError(t, err)
+	assert.Equal(t, event, savedDoc)
+
+	_, err = s.GetSearchDocument("unknown")
+	require.ErrorIs(t, err, storageErrors.ErrNotFound)
+
+	collect := func(term string, prefix bool) []*indexerTypes.SearchPosting {
+		t.Helper()
+
+		it, err := s.SearchPostingIterator(term, prefix)
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, it.Close())
+		}()
+
+		out := make([]*indexerTypes.SearchPosting, 0)
+
+		for it.Next() {
+			posting, err := it.Value()
+			require.NoError(t, err)
+
+			out = append(out, posting)
+		}
+
+		require.NoError(t, it.Error())
+
+		return out
+	}
+
+	// Make sure exact lookups only return the term postings
+	assert.Equal(t, []*indexerTypes.SearchPosting{
+		{
+			Term:       "ab",
+			DocumentID: memo.ID(),
+			Kind:       indexerTypes.SearchKindMemo,
+			Positions:  []uint32{0, 2},
+		},
+	}, collect("ab", false))
+
+	// Make sure prefix lookups return the postings of all the matching terms
+	postings := collect("ab", true)
+	require.Len(t, postings, 3)
+
+	assert.Equal(t, "ab", postings[0].Term)
+	assert.Equal(t, "abc", postings[1].Term)
+	assert.Equal(t, "abd", postings[2].Term)
+	assert.Equal(t, event.ID(), postings[2].DocumentID)
+
+	assert.Empty(t, collect("abe", true))
+}
+
+func TestStorage_BlockFeesIterator(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewPebble(t.TempDir())
+	require.NoError(t, err)
+
+	defer func() {
+		assert.NoError(t, s.Close())
+	}()
+
+	fees := make([]*indexerTypes.BlockFees, 0, 10)
+
+	b := s.WriteBatch()
+
+	for i := int64(1); i <= 10; i++ {
+		blockFees := &indexerTypes.BlockFees{
+			Height: i,
+			Time:   time.Unix(i, 0).UTC(),
+			Txs: []indexerTypes.TxFee{
+				{Denom: "ugnot", Amount: i, GasWanted: 10 * i, GasUsed: 5 * i},
+			},
+		}
+
+		require.NoError(t, b.SetBlockFees(blockFees))
+
+		fees = append(fees, blockFees)
+	}
+
+	require.NoError(t, b.Commit())
+
+	collect := func(from, to uint64) []*indexerTypes.BlockFees {
+		t.Helper()
+
+		it, err := s.BlockFeesIterator(from, to)
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, it.Close())
+		}()
+
+		out := make([]*indexerTypes.BlockFees, 0)
+
+		for it.Next() {
+			blockFees, err := it.Value()
+			require.NoError(t, err)
+
+			out = append(out, blockFees)
+		}
+
+		require.NoError(t, it.Error())
+
+		return out
+	}
+
+	// Make sure the range is inclusive, and open ended if no upper bound is set
+	assert.Equal(t, fees[2:5], collect(3, 5))
+	assert.Equal(t, fees[7:], collect(8, 0))
+	assert.Empty(t, collect(11, 20))
+}
+
+func TestStorage_FuncGasStatsIterator(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewPebble(t.TempDir())
+	require.NoError(t, err)
+
+	defer func() {
+		assert.NoError(t, s.Close())
+	}()
+
+	newStats := func(pkgPath, fn string, height int64) *indexerTypes.FuncGasStats {
+		stats := &indexerTypes.FuncGasStats{
+			PkgPath: pkgPath,
+			Func:    fn,
+			Height:  height,
+		}
+
+		stats.AddCall(1000, 100*height, true)
+
+		return stats
+	}
+
+	var (
+		render   = []*indexerTypes.FuncGasStats{newStats("gno.land/r/demo/users", "Render", 1), newStats("gno.land/r/demo/users", "Render", 5)}
+		register = newStats("gno.land/r/demo/users", "Register", 2)
+		other    = newStats("gno.land/r/demo/users2", "Render", 3)
+	)
+
+	b := s.WriteBatch()
+
+	for _, stats := range append(render, register, other) {
+		require.NoError(t, b.SetFuncGasStats(stats))
+	}
+
+	require.NoError(t, b.Commit())
+
+	collect := func(pkgPath, fn string, from, to uint64) []*indexerTypes.FuncGasStats {
+		t.Helper()
+
+		it, err := s.FuncGasStatsIterator(pkgPath, fn, from, to)
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, it.Close())
+		}()
+
+		out := make([]*indexerTypes.FuncGasStats, 0)
+
+		for it.Next() {
+			stats, err := it.Value()
+			require.NoError(t, err)
+
+			out = append(out, stats)
+		}
+
+		require.NoError(t, it.Error())
+
+		return out
+	}
+
+	// Make sure only the summaries of the given function are returned
+	assert.Equal(t, render, collect("gno.land/r/demo/users", "Render", 0, 0))
+	assert.Equal(t, render[1:], collect("gno.land/r/demo/users", "Render", 2, 5))
+	assert.Equal(t, []*indexerTypes.FuncGasStats{register}, collect("gno.land/r/demo/users", "Register", 0, 0))
+	assert.Equal(t, []*indexerTypes.FuncGasStats{other}, collect("gno.land/r/demo/users2", "Render", 0, 0))
+	assert.Empty(t, collect("gno.land/r/demo/users", "Render", 6, 0))
+}
+
+func TestStorage_RealmFailureIterator(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewPebble(t.TempDir())
+	require.NoError(t, err)
+
+	defer func() {
+		assert.NoError(t, s.Close())
+	}()
+
+	newFailure := func(pkgPath string, height int64, index, msgIndex uint32) *indexerTypes.RealmFailure {
+		return &indexerTypes.RealmFailure{
+			PkgPath: pkgPath,
+			Func:    "Register",
+			Error: indexerTypes.TxError{
+				Codespace: "abci",
+				Code:      "/abci.StringError",
+				Name:      "StringError",
+				Message:   "user already exists",
+			},
+			Height:   height,
+			Index:    index,
+			MsgIndex: msgIndex,
+		}
+	}
+
+	var (
+		users = []*indexerTypes.RealmFailure{
+			newFailure("gno.land/r/demo/users", 1, 0, 0),
+			newFailure("gno.land/r/demo/users", 1, 0, 1),
+			newFailure("gno.land/r/demo/users", 1, 2, 0),
+			newFailure("gno.land/r/demo/users", 5, 0, 0),
+		}
+		other = newFailure("gno.land/r/demo/users2", 3, 0, 0)
+	)
+
+	b := s.WriteBatch()
+
+	for _, failure := range append(users, other) {
+		require.NoError(t, b.SetRealmFailure(failure))
+	}
+
+	require.NoError(t, b.Commit())
+
+	collect := func(pkgPath string, from, to uint64) []*indexerTypes.RealmFailure {
+		t.Helper()
+
+		it, err := s.RealmFailureIterator(pkgPath, from, to)
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, it.Close())
+		}()
+
+		out := make([]*indexerTypes.RealmFailure, 0)
+
+		for it.Next() {
+			failure, err := it.Value()
+			require.NoError(t, err)
+
+			out = append(out, failure)
+		}
+
+		require.NoError(t, it.Error())
+
+		return out
+	}
+
+	// Make sure only the failures of the given realm are returned
+	assert.Equal(t, users, collect("gno.land/r/demo/users", 0, 0))
+	assert.Equal(t, users[:3], collect("gno.land/r/demo/users", 1, 1))
+	assert.Equal(t, users[3:], collect("gno.land/r/demo/users", 2, 5))
+	assert.Equal(t, []*indexerTypes.RealmFailure{other}, collect("gno.land/r/demo/users2", 0, 0))
+	assert.Empty(t, collect("gno.land/r/demo/users", 6, 0))
+}
+
+func TestStorage_TransferIterator(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewPebble(t.TempDir())
+	require.NoError(t, err)
+
+	defer func() {
+		assert.NoError(t, s.Close())
+	}()
+
+	newTransfer := func(height int64, index, position uint32) *indexerTypes.Transfer {
+		return &indexerTypes.Transfer{
+			From:     "g1from",
+			To:       "g1to",
+			Denom:    "ugnot",
+			Kind:     indexerTypes.TransferKindSend,
+			Amount:   100,
+			Height:   height,
+			Index:    index,
+			Position: position,
+		}
+	}
+
+	transfers := []*indexerTypes.Transfer{
+		newTransfer(1, 0, 0),
+		newTransfer(1, 0, 1),
+		newTransfer(1, 1, 0),
+		newTransfer(3, 0, 0),
+	}
+
+	b := s.WriteBatch()
+
+	for _, transfer := range transfers {
+		require.NoError(t, b.SetTransfer(transfer))
+	}
+
+	require.NoError(t, b.Commit())
+
+	collect := func(from, to uint64) []*indexerTypes.Transfer {
+		t.Helper()
+
+		it, err := s.TransferIterator(from, to)
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, it.Close())
+		}()
+
+		out := make([]*indexerTypes.Transfer, 0)
+
+		for it.Next() {
+			transfer, err := it.Value()
+			require.NoError(t, err)
+
+			out = append(out, transfer)
+		}
+
+		require.NoError(t, it.Error())
+
+		return out
+	}
+
+	assert.Equal(t, transfers, collect(0, 0))
+	assert.Equal(t, transfers[:3], collect(0, 2))
+	assert.Equal(t, transfers[3:], collect(2, 3))
+	assert.Empty(t, collect(4, 0))
+}
+
+func TestStorage_AddressTransferIterator(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewPebble(t.TempDir())
+	require.NoError(t, err)
+
+	defer func() {
+		assert.NoError(t, s.Close())
+	}()
+
+	newTransfer := func(from, to string, height int64, index uint32) *indexerTypes.Transfer {
+		return &indexerTypes.Transfer{
+			From:   from,
+			To:     to,
+			Denom:  "ugnot",
+			Kind:   indexerTypes.TransferKindSend,
+			Amount: 100,
+			Height: height,
+			Index:  index,
+		}
+	}
+
+	transfers := []*indexerTypes.Transfer{
+		newTransfer("g1alice", "g1bob", 1, 0),
+		newTransfer("g1bob", "g1carol", 1, 1),
+		newTransfer("g1carol", "g1alice", 2, 0),
+		newTransfer("g1alice", "g1alice", 3, 0), // sent to itself
+		newTransfer("g1bob", "g1carol", 4, 0),
+	}
+
+	b := s.WriteBatch()
+
+	for _, transfer := range transfers {
+		require.NoError(t, b.SetTransfer(transfer))
+	}
+
+	require.NoError(t, b.Commit())
+
+	collect := func(address string, from, to uint64) []*indexerTypes.Transfer {
+		t.Helper()
+
+		it, err := s.AddressTransferIterator(address, from, to)
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, it.Close())
+		}()
+
+		out := make([]*indexerTypes.Transfer, 0)
+
+		for it.Next() {
+			transfer, err := it.Value()
+			require.NoError(t, err)
+
+			out = append(out, transfer)
+		}
+
+		require.NoError(t, it.Error())
+
+		return out
+	}
+
+	// Make sure both sent and received movements are indexed, once
+	assert.Equal(
+		t,
+		[]*indexerTypes.Transfer{transfers[0], transfers[2], transfers[3]},
+		collect("g1alice", 0, 0),
+	)
+	assert.Equal(
+		t,
+		[]*indexerTypes.Transfer{transfers[0], transfers[1], transfers[4]},
+		collect("g1bob", 0, 0),
+	)
+
+	// Make sure the block range is applied
+	assert.Equal(t, []*indexerTypes.Transfer{transfers[1], transfers[2]}, collect("g1carol", 0, 2))
+	assert.Equal(t, []*indexerTypes.Transfer{transfers[4]}, collect("g1carol", 3, 0))
+	assert.Empty(t, collect("g1alice", 4, 0))
+	assert.Empty(t, collect("g1dave", 0, 0))
+}
+
+func TestStorage_BalanceHistory(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewPebble(t.TempDir())
+	require.NoError(t, err)
+
+	defer func() {
+		assert.NoError(t, s.Close())
+	}()
+
+	newDelta := func(address, denom string, height, delta, balance int64) *indexerTypes.BalanceDelta {
+		return &indexerTypes.BalanceDelta{
+			Address: address,
+			Denom:   denom,
+			Delta:   delta,
+			Balance: balance,
+			Height:  height,
+		}
+	}
+
+	var (
+		history = []*indexerTypes.BalanceDelta{
+			newDelta("g1alice", "ugnot", 1, 100, 100),
+			newDelta("g1alice", "ugnot", 4, -30, 70),
+			newDelta("g1alice", "ugnot", 7, 5, 75),
+		}
+		otherDenom   = newDelta("g1alice", "foo", 9, 1, 1)
+		otherAddress = newDelta("g1bob", "ugnot", 9, 1, 1)
+	)
+
+	b := s.WriteBatch()
+
+	for _, delta := range append(history, otherDenom, otherAddress) {
+		require.NoError(t, b.SetBalanceDelta(delta))
+	}
+
+	require.NoError(t, b.Commit())
+
+	// Make sure the latest balance of the given denomination is returned
+	latest, err := s.GetLatestBalance("g1alice", "ugnot")
+	require.NoError(t, err)
+
+	assert.Equal(t, history[2], latest)
+
+	_, err = s.GetLatestBalance("g1carol", "ugnot")
+	assert.ErrorIs(t, err, storageErrors.ErrNotFound)
+
+	collect := func(from, to uint64) []*indexerTypes.BalanceDelta {
+		t.Helper()
+
+		it, err := s.BalanceHistoryIterator("g1alice", "ugnot", from, to)
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, it.Close())
+		}()
+
+		out := make([]*indexerTypes.BalanceDelta, 0)
+
+		for it.Next() {
+			delta, err := it.Value()
+			require.NoError(t, err)
+
+			out = append(out, delta)
+		}
+
+		require.NoError(t, it.Error())
+
+		return out
+	}
+
+	assert.Equal(t, history, collect(0, 0))
+	assert.Equal(t, history[1:2], collect(2, 6))
+	assert.Empty(t, collect(8, 0))
+}
+
+// collectIterator reads all the iterator values, and closes it
+func collectIterator[T any](t *testing.T, it Iterator[T]) []T {
+	t.Helper()
+
+	defer func() {
+		require.NoError(t, it.Close())
+	}()
+
+	out := make([]T, 0)
+
+	for it.Next() {
+		value, err := it.Value()
+		require.NoError(t, err)
+
+		out = append(out, value)
+	}
+
+	require.NoError(t, it.Error())
+
+	return out
+}
+
+func TestStorage_Tokens(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewPebble(t.TempDir())
+	require.NoError(t, err)
+
+	defer func() {
+		assert.NoError(t, s.Close())
+	}()
+
+	var (
+		tokens = []*indexerTypes.Token{
+			{
+				ID:              "gno.land/r/demo/bar20.BAR",
+				PkgPath:         "gno.land/r/demo/bar20",
+				Symbol:          "BAR",
+				TotalSupply:     10,
+				TransferCount:   1,
+				FirstSeenHeight: 3,
+			},
+			{
+				ID:              "gno.land/r/demo/foo20.FOO",
+				PkgPath:         "gno.land/r/demo/foo20",
+				Name:            "Foo",
+				Symbol:          "FOO",
+				Decimals:        4,
+				TotalSupply:     100,
+				TransferCount:   2,
+				FirstSeenHeight: 1,
+				Announced:       true,
+			},
+		}
+
+		transfers = []*indexerTypes.TokenTransfer{
+			{Token: tokens[1].ID, To: "g1alice", Amount: 100, Height: 1},
+			{Token: tokens[1].ID, From: "g1alice", To: "g1bob", Amount: 40, Height: 2, EventIndex: 1},
+			{Token: tokens[0].ID, To: "g1alice", Amount: 10, Height: 3, Index: 1},
+		}
+
+		balances = []*indexerTypes.TokenBalance{
+			{Token: tokens[0].ID, Address: "g1alice", Balance: 10, Height: 3},
+			{Token: tokens[1].ID, Address: "g1alice", Balance: 60, Height: 2},
+			{Token: tokens[1].ID, Address: "g1bob", Balance: 40, Height: 2},
+		}
+	)
+
+	b := s.WriteBatch()
+
+	// Save the tokens in reverse, to make sure they are ordered by identifier
+	for i := len(tokens) - 1; i >= 0; i-- {
+		require.NoError(t, b.SetToken(tokens[i]))
+	}
+
+	for _, transfer := range transfers {
+		require.NoError(t, b.SetTokenTransfer(transfer))
+	}
+
+	for _, balance := range balances {
+		require.NoError(t, b.SetTokenBalance(balance))
+	}
+
+	require.NoError(t, b.Commit())
+
+	// Make sure the tokens are fetched by identifier
+	token, err := s.GetToken(tokens[1].ID)
+	require.NoError(t, err)
+
+	assert.Equal(t, tokens[1], token)
+
+	_, err = s.GetToken("gno.land/r/demo/baz20.BAZ")
+	assert.ErrorIs(t, err, storageErrors.ErrNotFound)
+
+	tokenIt, err := s.TokenIterator()
+	require.NoError(t, err)
+
+	assert.Equal(t, tokens, collectIterator(t, tokenIt))
+
+	// Make sure the transfers are limited to the given heights
+	transferIt, err := s.TokenTransferIterator(0, 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, transfers, collectIterator(t, transferIt))
+
+	transferIt, err = s.TokenTransferIterator(2, 2)
+	require.NoError(t, err)
+
+	assert.Equal(t, transfers[1:2], collectIterator(t, transferIt))
+
+	// Make sure the transfers are indexed by sender and recipient
+	transferIt, err = s.AddressTokenTransferIterator("g1alice", 0, 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, transfers, collectIterator(t, transferIt))
+
+	transferIt, err = s.AddressTokenTransferIterator("g1alice", 3, 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, transfers[2:], collectIterator(t, transferIt))
+
+	transferIt, err = s.AddressTokenTransferIterator("g1bob", 0, 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, transfers[1:2], collectIterator(t, transferIt))
+
+	// Mints have no sender, which isn't indexed
+	transferIt, err = s.AddressTokenTransferIterator("", 0, 0)
+	require.NoError(t, err)
+
+	assert.Empty(t, collectIterator(t, transferIt))
+
+	// Make sure the balances are limited to the given holder
+	balance, err := s.GetTokenBalance("g1bob", tokens[1].ID)
+	require.NoError(t, err)
+
+	assert.Equal(t, balances[2], balance)
+
+	_, err = s.GetTokenBalance("g1bob", tokens[0].ID)
+	assert.ErrorIs(t, err, storageErrors.ErrNotFound)
+
+	balanceIt, err := s.TokenBalanceIterator("g1alice")
+	require.NoError(t, err)
+
+	assert.Equal(t, balances[:2], collectIterator(t, balanceIt))
+}
+
+func TestStorage_NFTs(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewPebble(t.TempDir())
+	require.NoError(t, err)
+
+	defer func() {
+		assert.NoError(t, s.Close())
+	}()
+
+	var (
+		collection = &indexerTypes.NFTCollection{
+			PkgPath:         "gno.land/r/demo/foo721",
+			Name:            "FooNFT",
+			Symbol:          "FNFT",
+			Minted:          2,
+			Transfers:       1,
+			FirstSeenHeight: 1,
+		}
+
+		minted = &indexerTypes.NFT{
+			Collection: collection.PkgPath,
+			TokenID:    "1",
+			Owner:      "g1alice",
+			MintHeight: 1,
+			Height:     1,
+		}
+
+		transferred = &indexerTypes.NFT{
+			Collection: collection.PkgPath,
+			TokenID:    "1",
+			Owner:      "g1bob",
+			MintHeight: 1,
+			Height:     2,
+		}
+
+		other = &indexerTypes.NFT{
+			Collection: collection.PkgPath,
+			TokenID:    "2",
+			Owner:      "g1alice",
+			MintHeight: 2,
+			Height:     2,
+		}
+
+		history = []*indexerTypes.NFTEvent{
+			{
+				Collection: collection.PkgPath,
+				TokenID:    "1",
+				Kind:       indexerTypes.NFTEventMint,
+				To:         "g1alice",
+				Height:     1,
+			},
+			{
+				Collection: collection.PkgPath,
+				TokenID:    "1",
+				Kind:       indexerTypes.NFTEventTransfer,
+				From:       "g1alice",
+				To:         "g1bob",
+				Height:     2,
+				EventIndex: 1,
+			},
+		}
+	)
+
+	// Mint the first token
+	b := s.WriteBatch()
+
+	require.NoError(t, b.SetNFT(minted))
+	require.NoError(t, b.SetNFTEvent(history[0]))
+	require.NoError(t, b.Commit())
+
+	// Transfer it, and mint another one
+	b = s.WriteBatch()
+
+	require.NoError(t, b.SetNFTCollection(collection))
+	require.NoError(t, b.RemoveNFTOwner(minted.Owner, minted.Collection, minted.TokenID))
+	require.NoError(t, b.SetNFT(transferred))
+	require.NoError(t, b.SetNFT(other))
+	require.NoError(t, b.SetNFTEvent(history[1]))
+	require.NoError(t, b.Commit())
+
+	// Make sure the collection is stored
+	storedCollection, err := s.GetNFTCollection(collection.PkgPath)
+	require.NoError(t, err)
+
+	assert.Equal(t, collection, storedCollection)
+
+	_, err = s.GetNFTCollection("gno.land/r/demo/bar721")
+	assert.ErrorIs(t, err, storageErrors.ErrNotFound)
+
+	collectionIt, err := s.NFTCollectionIterator()
+	require.NoError(t, err)
+
+	assert.Equal(t, []*indexerTypes.NFTCollection{collection}, collectIterator(t, collectionIt))
+
+	// Make sure the current state replaces the previous one
+	nft, err := s.GetNFT(collection.PkgPath, "1")
+	require.NoError(t, err)
+
+	assert.Equal(t, transferred, nft)
+
+	// Make sure the owner index follows the ownership changes
+	aliceIt, err := s.NFTOwnerIterator("g1alice")
+	require.NoError(t, err)
+
+	assert.Equal(t, []*indexerTypes.NFT{other}, collectIterator(t, aliceIt))
+
+	bobIt, err := s.NFTOwnerIterator("g1bob")
+	require.NoError(t, err)
+
+	assert.Equal(t, []*indexerTypes.NFT{transferred}, collectIterator(t, bobIt))
+
+	// Make sure the full provenance is kept
+	historyIt, err := s.NFTHistoryIterator(collection.PkgPath, "1")
+	require.NoError(t, err)
+
+	assert.Equal(t, history, collectIterator(t, historyIt))
+}
+
+func TestStorage_Genesis(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewPebble(t.TempDir())
+	require.NoError(t, err)
+
+	defer func() {
+		assert.NoError(t, s.Close())
+	}()
+
+	_, err = s.GetGenesis()
+	assert.ErrorIs(t, err, storageErrors.ErrNotFound)
+
+	genesis := &indexerTypes.Genesis{
+		GenesisTime: time.Unix(1000, 0).UTC(),
+		ChainID:     "dev",
+		AuthParams:  `{"max_memo_bytes":"65536"}`,
+		Balances: []indexerTypes.GenesisBalance{
+			{
+				Address: "g1alice",
+				Coins:   std.NewCoins(std.NewCoin("ugnot", 100)),
+			},
+		},
+		Packages: []string{"gno.land/p/demo/avl"},
+	}
+
+	b := s.WriteBatch()
+
+	require.NoError(t, b.SetGenesis(genesis))
+	require.NoError(t, b.Commit())
+
+	stored, err := s.GetGenesis()
+	require.NoError(t, err)
+
+	assert.Equal(t, genesis, stored)
+}
+
+func TestStorage_Validators(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewPebble(t.TempDir())
+	require.NoError(t, err)
+
+	defer func() {
+		assert.NoError(t, s.Close())
+	}()
+
+	var (
+		initial = &indexerTypes.ValidatorSet{
+			Hash: []byte("hash1"),
+			Validators: []indexerTypes.Validator{
+				{Address: "g1alice", PubKey: "gpub1alice", VotingPower: 10},
+			},
+			Height: 1,
+		}
+
+		changed = &indexerTypes.ValidatorSet{
+			Hash: []byte("hash2"),
+			Validators: []indexerTypes.Validator{
+				{Address: "g1alice", PubKey: "gpub1alice", VotingPower: 10},
+				{Address: "g1bob", PubKey: "gpub1bob", VotingPower: 5},
+			},
+			Height: 5,
+		}
+
+		signatures = []*indexerTypes.BlockSignatures{
+			{Signers: []string{"g1alice"}, Height: 1},
+			{Signers: []string{"g1alice"}, Height: 5},
+			{Signers: []string{"g1alice"}, Missed: []string{"g1bob"}, Height: 6},
+		}
+	)
+
+	_, err = s.GetValidatorSet(10)
+	assert.ErrorIs(t, err, storageErrors.ErrNotFound)
+
+	b := s.WriteBatch()
+
+	require.NoError(t, b.SetValidatorSet(initial))
+	require.NoError(t, b.SetValidatorSet(changed))
+
+	for _, signature := range signatures {
+		require.NoError(t, b.SetBlockSignatures(signature))
+	}
+
+	require.NoError(t, b.Commit())
+
+	// Make sure the set in effect at each height is returned
+	for height, expected := range map[uint64]*indexerTypes.ValidatorSet{
+		1:  initial,
+		4:  initial,
+		5:  changed,
+		10: changed,
+	} {
+		set, err := s.GetValidatorSet(height)
+		require.NoError(t, err)
+
+		assert.Equal(t, expected, set)
+	}
+
+	_, err = s.GetValidatorSet(0)
+	assert.ErrorIs(t, err, storageErrors.ErrNotFound)
+
+	// Make sure the block signatures are fetched by height
+	stored, err := s.GetBlockSignatures(6)
+	require.NoError(t, err)
+
+	assert.Equal(t, signatures[2], stored)
+
+	_, err = s.GetBlockSignatures(2)
+	assert.ErrorIs(t, err, storageErrors.ErrNotFound)
+
+	it, err := s.BlockSignaturesIterator(2, 5)
+	require.NoError(t, err)
+
+	assert.Equal(t, signatures[1:2], collectIterator(t, it))
+
+	it, err = s.BlockSignaturesIterator(0, 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, signatures, collectIterator(t, it))
+}
+
+func TestStorage_BlockResults(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewPebble(t.TempDir())
+	require.NoError(t, err)
+
+	defer func() {
+		assert.NoError(t, s.Close())
+	}()
+
+	pubKey := ed25519.GenPrivKey().PubKey()
+
+	results := &indexerTypes.BlockResults{
+		EndBlockEvents: []abci.Event{
+			chain.Event{
+				Type:    "ValidatorAdded",
+				PkgPath: "gno.land/r/sys/validators/v2",
+				Attributes: []chain.EventAttribute{
+					{Key: "address", Value: pubKey.Address().String()},
+				},
+			},
+		},
+		ValidatorUpdates: []abci.ValidatorUpdate{
+			{
+				Address: pubKey.Address(),
+				PubKey:  pubKey,
+				Power:   1,
+			},
+		},
+		Height: 10,
+	}
+
+	_, err = s.GetBlockResults(10)
+	assert.ErrorIs(t, err, storageErrors.ErrNotFound)
+
+	b := s.WriteBatch()
+
+	require.NoError(t, b.SetBlockResults(results))
+	require.NoError(t, b.Commit())
+
+	stored, err := s.GetBlockResults(10)
+	require.NoError(t, err)
+
+	assert.Equal(t, results, stored)
+}
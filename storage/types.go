@@ -4,6 +4,8 @@ import (
 	"io"
 
 	"github.com/gnolang/gno/tm2/pkg/bft/types"
+
+	indexerTypes "github.com/gnolang/tx-indexer/types"
 )
 
 // Storage represents the permanent storage abstraction
@@ -19,13 +21,17 @@ type Reader interface {
 	// GetLatestHeight returns the latest block height from the storage
 	GetLatestHeight() (uint64, error)
 
+	// GetGenesis fetches the genesis state, beyond its transactions
+	GetGenesis() (*indexerTypes.Genesis, error)
+
 	// GetBlock fetches the block by its number
 	GetBlock(uint64) (*types.Block, error)
 
 	// GetTx fetches the tx using the block height and the transaction index
 	GetTx(blockNum uint64, index uint32) (*types.TxResult, error)
 
-	// GetTxByHash fetches the tx using the transaction hash
+	// GetTxByHash fetches the tx using the transaction hash,
+	// encoded as standard base64, URL-safe base64 or hex
 	GetTxByHash(txHash string) (*types.TxResult, error)
 
 	// BlockIterator iterates over Blocks, limiting the results to be between the provided block numbers
@@ -42,6 +48,128 @@ type Reader interface {
 	// TxReverseIterator iterates over transactions in reverse order,
 	// limiting the results to be between the provided block numbers and transaction indexes
 	TxReverseIterator(fromBlockNum, toBlockNum uint64, fromTxIndex, toTxIndex uint32) (Iterator[*types.TxResult], error)
+
+	// GetAccount fetches the activity summary of the given address
+	GetAccount(address string) (*indexerTypes.Account, error)
+
+	// AccountTxIterator iterates over the transactions the given address took part in,
+	// starting from the provided block number and transaction index (inclusive)
+	AccountTxIterator(address string, fromBlockNum uint64, fromTxIndex uint32) (Iterator[*types.TxResult], error)
+
+	// GetPackage fetches the package deployed at the given path
+	GetPackage(path string) (*indexerTypes.Package, error)
+
+	// PackageIterator iterates over all the deployed packages, ordered by path
+	PackageIterator() (Iterator[*indexerTypes.Package], error)
+
+	// PackageImporterIterator iterates over the paths of the packages
+	// importing the given package path, ordered by path
+	PackageImporterIterator(path string) (Iterator[string], error)
+
+	// GetSearchDocument fetches the full-text search document using its ID
+	GetSearchDocument(id string) (*indexerTypes.SearchDocument, error)
+
+	// SearchPostingIterator iterates over the full-text search postings of the given term,
+	// or of all the terms starting with it if prefix is set
+	SearchPostingIterator(term string, prefix bool) (Iterator[*indexerTypes.SearchPosting], error)
+
+	// BlockFeesIterator iterates over the block fee summaries,
+	// limiting the results to be between the provided block numbers.
+	// Blocks without transactions have no fee summary
+	BlockFeesIterator(fromBlockNum, toBlockNum uint64) (Iterator[*indexerTypes.BlockFees], error)
+
+	// FuncGasStatsIterator iterates over the per-block gas usage summaries of the given realm function,
+	// limiting the results to be between the provided block numbers
+	FuncGasStatsIterator(
+		pkgPath,
+		fn string,
+		fromBlockNum,
+		toBlockNum uint64,
+	) (Iterator[*indexerTypes.FuncGasStats], error)
+
+	// RealmFailureIterator iterates over the failed calls to the given realm,
+	// limiting the results to be between the provided block numbers
+	RealmFailureIterator(pkgPath string, fromBlockNum, toBlockNum uint64) (Iterator[*indexerTypes.RealmFailure], error)
+
+	// TransferIterator iterates over the native coin movements,
+	// limiting the results to be between the provided block numbers
+	TransferIterator(fromBlockNum, toBlockNum uint64) (Iterator[*indexerTypes.Transfer], error)
+
+	// AddressTransferIterator iterates over the native coin movements
+	// sent or received by the given address,
+	// limiting the results to be between the provided block numbers
+	AddressTransferIterator(address string, fromBlockNum, toBlockNum uint64) (Iterator[*indexerTypes.Transfer], error)
+
+	// GetLatestBalance fetches the most recent balance change
+	// of the given address in the given denomination
+	GetLatestBalance(address, denom string) (*indexerTypes.BalanceDelta, error)
+
+	// BalanceHistoryIterator iterates over the per-block balance changes
+	// of the given address in the given denomination,
+	// limiting the results to be between the provided block numbers
+	BalanceHistoryIterator(
+		address,
+		denom string,
+		fromBlockNum,
+		toBlockNum uint64,
+	) (Iterator[*indexerTypes.BalanceDelta], error)
+
+	// GetToken fetches the GRC20 token with the given identifier
+	GetToken(id string) (*indexerTypes.Token, error)
+
+	// TokenIterator iterates over all the known GRC20 tokens, ordered by identifier
+	TokenIterator() (Iterator[*indexerTypes.Token], error)
+
+	// TokenTransferIterator iterates over the GRC20 token movements,
+	// limiting the results to be between the provided block numbers
+	TokenTransferIterator(fromBlockNum, toBlockNum uint64) (Iterator[*indexerTypes.TokenTransfer], error)
+
+	// AddressTokenTransferIterator iterates over the GRC20 token movements
+	// sent or received by the given address,
+	// limiting the results to be between the provided block numbers
+	AddressTokenTransferIterator(
+		address string,
+		fromBlockNum,
+		toBlockNum uint64,
+	) (Iterator[*indexerTypes.TokenTransfer], error)
+
+	// GetTokenBalance fetches the balance of the given address in the given GRC20 token
+	GetTokenBalance(address, token string) (*indexerTypes.TokenBalance, error)
+
+	// TokenBalanceIterator iterates over the GRC20 token balances
+	// of the given address, ordered by token identifier
+	TokenBalanceIterator(address string) (Iterator[*indexerTypes.TokenBalance], error)
+
+	// GetNFTCollection fetches the GRC721 collection of the given realm
+	GetNFTCollection(pkgPath string) (*indexerTypes.NFTCollection, error)
+
+	// NFTCollectionIterator iterates over all the known GRC721 collections, ordered by realm path
+	NFTCollectionIterator() (Iterator[*indexerTypes.NFTCollection], error)
+
+	// GetNFT fetches the ownership state of the given GRC721 token
+	GetNFT(collection, tokenID string) (*indexerTypes.NFT, error)
+
+	// NFTOwnerIterator iterates over the GRC721 tokens currently
+	// owned by the given address, ordered by collection and token ID
+	NFTOwnerIterator(owner string) (Iterator[*indexerTypes.NFT], error)
+
+	// NFTHistoryIterator iterates over the ownership changes
+	// of the given GRC721 token, from the oldest to the newest
+	NFTHistoryIterator(collection, tokenID string) (Iterator[*indexerTypes.NFTEvent], error)
+
+	// GetValidatorSet fetches the validator set of the given block,
+	// the latest set change at or before its height
+	GetValidatorSet(blockNum uint64) (*indexerTypes.ValidatorSet, error)
+
+	// GetBlockSignatures fetches the validator signatures of the given block
+	GetBlockSignatures(blockNum uint64) (*indexerTypes.BlockSignatures, error)
+
+	// BlockSignaturesIterator iterates over the validator signatures of the blocks,
+	// limiting the results to be between the provided block numbers
+	BlockSignaturesIterator(fromBlockNum, toBlockNum uint64) (Iterator[*indexerTypes.BlockSignatures], error)
+
+	// GetBlockResults fetches the BeginBlock / EndBlock results of the given block
+	GetBlockResults(blockNum uint64) (*indexerTypes.BlockResults, error)
 }
 
 type Iterator[T any] interface {
@@ -62,10 +190,53 @@ type Writer interface {
 type Batch interface {
 	// SetLatestHeight saves the latest block height to the storage
 	SetLatestHeight(uint64) error
+	// SetGenesis saves the genesis state to the storage
+	SetGenesis(genesis *indexerTypes.Genesis) error
 	// SetBlock saves the block to the permanent storage
 	SetBlock(block *types.Block) error
 	// SetTx saves the transaction to the permanent storage
 	SetTx(tx *types.TxResult) error
+	// SetAccount saves the account activity summary to the permanent storage
+	SetAccount(account *indexerTypes.Account) error
+	// SetAccountTx links the transaction at the given position to the address
+	SetAccountTx(address string, blockNum uint64, txIndex uint32) error
+	// SetPackage saves the package deployment to the permanent storage
+	SetPackage(pkg *indexerTypes.Package) error
+	// SetPackageImport links the importer package path to the imported package path
+	SetPackageImport(importedPath, importerPath string) error
+	// SetSearchDocument saves the full-text search document, along with the postings
+	// of its terms (term -> positions)
+	SetSearchDocument(doc *indexerTypes.SearchDocument, terms map[string][]uint32) error
+	// SetBlockFees saves the block fee summary to the permanent storage
+	SetBlockFees(fees *indexerTypes.BlockFees) error
+	// SetFuncGasStats saves the realm function gas usage summary to the permanent storage
+	SetFuncGasStats(stats *indexerTypes.FuncGasStats) error
+	// SetRealmFailure saves the failed realm call to the permanent storage
+	SetRealmFailure(failure *indexerTypes.RealmFailure) error
+	// SetTransfer saves the native coin movement to the permanent storage
+	SetTransfer(transfer *indexerTypes.Transfer) error
+	// SetBalanceDelta saves the per-block balance change to the permanent storage
+	SetBalanceDelta(delta *indexerTypes.BalanceDelta) error
+	// SetToken saves the GRC20 token to the permanent storage
+	SetToken(token *indexerTypes.Token) error
+	// SetTokenTransfer saves the GRC20 token movement to the permanent storage
+	SetTokenTransfer(transfer *indexerTypes.TokenTransfer) error
+	// SetTokenBalance saves the GRC20 token balance to the permanent storage
+	SetTokenBalance(balance *indexerTypes.TokenBalance) error
+	// SetNFTCollection saves the GRC721 collection to the permanent storage
+	SetNFTCollection(collection *indexerTypes.NFTCollection) error
+	// SetNFT saves the GRC721 token ownership state, indexed by its current owner
+	SetNFT(nft *indexerTypes.NFT) error
+	// RemoveNFTOwner removes the GRC721 token from the index of its previous owner
+	RemoveNFTOwner(owner, collection, tokenID string) error
+	// SetNFTEvent saves the GRC721 ownership change to the permanent storage
+	SetNFTEvent(event *indexerTypes.NFTEvent) error
+	// SetValidatorSet saves the validator set change to the permanent storage
+	SetValidatorSet(set *indexerTypes.ValidatorSet) error
+	// SetBlockSignatures saves the validator signatures of the block to the permanent storage
+	SetBlockSignatures(signatures *indexerTypes.BlockSignatures) error
+	// SetBlockResults saves the BeginBlock / EndBlock results of the block to the permanent storage
+	SetBlockResults(results *indexerTypes.BlockResults) error
 
 	// Commit stores all the provided info on the storage and make
 	// it available for other storage readers
@@ -0,0 +1,23 @@
+package types
+
+import (
+	"time"
+
+	"github.com/gnolang/gno/tm2/pkg/std"
+)
+
+// Account is the activity summary of a single address.
+// It is kept up to date by the fetcher as transactions are indexed
+type Account struct {
+	FirstSeenTime    time.Time // time of the first block the address appeared in
+	LastSeenTime     time.Time // time of the latest block the address appeared in
+	Address          string    // bech32 address
+	Sent             std.Coins // coins sent using bank.MsgSend
+	Received         std.Coins // coins received using bank.MsgSend
+	GenesisBalance   std.Coins // coins allocated at genesis
+	RealmsCalled     []string  // sorted package paths called using vm.MsgCall
+	PackagesDeployed []string  // sorted package paths deployed using vm.MsgAddPackage
+	FirstSeenHeight  int64     // height of the first block the address appeared in
+	LastSeenHeight   int64     // height of the latest block the address appeared in
+	TxCount          uint64    // number of transactions the address took part in
+}
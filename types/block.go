@@ -0,0 +1,18 @@
+package types
+
+import (
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
+)
+
+// BlockResults are the results of the block execution, beyond its transactions
+type BlockResults struct {
+	BeginBlockEvents []abci.Event           // events emitted in BeginBlock
+	EndBlockEvents   []abci.Event           // events emitted in EndBlock
+	ValidatorUpdates []abci.ValidatorUpdate // validator set changes returned in EndBlock
+	Height           int64                  // height of the block
+}
+
+// IsEmpty checks if the block execution produced nothing beyond its transactions
+func (r *BlockResults) IsEmpty() bool {
+	return len(r.BeginBlockEvents) == 0 && len(r.EndBlockEvents) == 0 && len(r.ValidatorUpdates) == 0
+}
@@ -5,14 +5,19 @@ import (
 	"github.com/gnolang/tx-indexer/events"
 )
 
-// NewBlockEvent is the event for when new blocks appear
 var (
+	// NewBlockEvent is the event for when new blocks appear
 	NewBlockEvent events.Type = "newHeads"
+
+	// PendingTxEvent is the event for when mempool transactions appear, or change status
+	PendingTxEvent events.Type = "newPendingTransactions"
 )
 
 type NewBlock struct {
-	Block   *types.Block
-	Results []*types.TxResult
+	Block        *types.Block
+	BlockResults *BlockResults // BeginBlock / EndBlock results, empty if none
+	Fees         *BlockFees    // fee summary of the block, nil if it has no decodable transactions
+	Results      []*types.TxResult
 }
 
 func (n *NewBlock) GetType() events.Type {
@@ -22,3 +27,15 @@ func (n *NewBlock) GetType() events.Type {
 func (n *NewBlock) GetData() any {
 	return n
 }
+
+type PendingTxUpdate struct {
+	Tx *PendingTx
+}
+
+func (p *PendingTxUpdate) GetType() events.Type {
+	return PendingTxEvent
+}
+
+func (p *PendingTxUpdate) GetData() any {
+	return p
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"time"
+
+	"github.com/gnolang/gno/tm2/pkg/bft/types"
+	"github.com/gnolang/gno/tm2/pkg/std"
+)
+
+// BlockFees is the fee summary of a single block, built from
+// the fees of its transactions at index time
+type BlockFees struct {
+	Time   time.Time // time of the block
+	Txs    []TxFee   // fees of the decodable block transactions, in index order
+	Height int64     // height of the block
+}
+
+// TxFee is the fee paid by a single transaction
+type TxFee struct {
+	Denom     string // denomination of the gas fee
+	Amount    int64  // amount of the gas fee
+	GasWanted int64  // gas limit of the transaction
+	GasUsed   int64  // gas consumed by the transaction
+	Index     uint32 // index of the transaction within the block
+}
+
+// NewTxFee creates the fee summary of the decoded transaction
+func NewTxFee(tx *std.Tx, txResult *types.TxResult) TxFee {
+	return TxFee{
+		Denom:     tx.Fee.GasFee.Denom,
+		Amount:    tx.Fee.GasFee.Amount,
+		GasWanted: tx.Fee.GasWanted,
+		GasUsed:   txResult.Response.GasUsed,
+		Index:     txResult.Index,
+	}
+}
+
+// Price returns the fee paid per unit of gas wanted,
+// or 0 if the transaction declared no gas limit
+func (f TxFee) Price() float64 {
+	if f.GasWanted <= 0 {
+		return 0
+	}
+
+	return float64(f.Amount) / float64(f.GasWanted)
+}
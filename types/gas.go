@@ -0,0 +1,87 @@
+package types
+
+import "math/bits"
+
+// FuncGasStats is the gas usage summary of the vm.MsgCall calls
+// made to a single realm function within a single block
+type FuncGasStats struct {
+	PkgPath        string   // package path of the called realm
+	Func           string   // name of the called function
+	GasUsedBuckets []uint64 // gas used histogram, see GasBucket
+	Height         int64    // height of the block
+	Calls          uint64   // number of calls
+	Failures       uint64   // number of failed calls
+	TotalGasWanted int64    // sum of the gas wanted of the calls
+	TotalGasUsed   int64    // sum of the gas used by the calls
+	MinGasUsed     int64    // lowest gas used by a call
+	MaxGasUsed     int64    // highest gas used by a call
+}
+
+// GasBucket returns the gas histogram bucket of the gas amount.
+// Bucket 0 holds the zero amounts, and bucket i > 0 the amounts in [2^(i-1), 2^i)
+func GasBucket(gas int64) int {
+	if gas <= 0 {
+		return 0
+	}
+
+	return bits.Len64(uint64(gas))
+}
+
+// GasBucketBounds returns the inclusive gas amount bounds of the histogram bucket
+func GasBucketBounds(bucket int) (int64, int64) {
+	if bucket <= 0 {
+		return 0, 0
+	}
+
+	return 1 << (bucket - 1), 1<<bucket - 1
+}
+
+// AddCall accounts for a single call in the summary
+func (s *FuncGasStats) AddCall(gasWanted, gasUsed int64, success bool) {
+	if s.Calls == 0 || gasUsed < s.MinGasUsed {
+		s.MinGasUsed = gasUsed
+	}
+
+	s.MaxGasUsed = max(s.MaxGasUsed, gasUsed)
+
+	s.Calls++
+	s.TotalGasWanted += gasWanted
+	s.TotalGasUsed += gasUsed
+
+	if !success {
+		s.Failures++
+	}
+
+	bucket := GasBucket(gasUsed)
+	for len(s.GasUsedBuckets) <= bucket {
+		s.GasUsedBuckets = append(s.GasUsedBuckets, 0)
+	}
+
+	s.GasUsedBuckets[bucket]++
+}
+
+// Merge accounts for the calls of the other summary in the summary
+func (s *FuncGasStats) Merge(other *FuncGasStats) {
+	if other.Calls == 0 {
+		return
+	}
+
+	if s.Calls == 0 || other.MinGasUsed < s.MinGasUsed {
+		s.MinGasUsed = other.MinGasUsed
+	}
+
+	s.MaxGasUsed = max(s.MaxGasUsed, other.MaxGasUsed)
+
+	s.Calls += other.Calls
+	s.Failures += other.Failures
+	s.TotalGasWanted += other.TotalGasWanted
+	s.TotalGasUsed += other.TotalGasUsed
+
+	for len(s.GasUsedBuckets) < len(other.GasUsedBuckets) {
+		s.GasUsedBuckets = append(s.GasUsedBuckets, 0)
+	}
+
+	for bucket, count := range other.GasUsedBuckets {
+		s.GasUsedBuckets[bucket] += count
+	}
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGasBucket(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		gas    int64
+		bucket int
+	}{
+		{-1, 0},
+		{0, 0},
+		{1, 1},
+		{2, 2},
+		{3, 2},
+		{4, 3},
+		{1023, 10},
+		{1024, 11},
+	}
+
+	for _, testCase := range testTable {
+		bucket := GasBucket(testCase.gas)
+		assert.Equal(t, testCase.bucket, bucket, "gas %d", testCase.gas)
+
+		// Make sure the bucket bounds contain the gas amount
+		from, to := GasBucketBounds(bucket)
+		assert.LessOrEqual(t, from, max(testCase.gas, 0))
+		assert.GreaterOrEqual(t, to, max(testCase.gas, 0))
+	}
+}
+
+func TestFuncGasStats_Merge(t *testing.T) {
+	t.Parallel()
+
+	var a, b, all FuncGasStats
+
+	a.AddCall(100, 3, true)
+	a.AddCall(100, 50, false)
+
+	b.AddCall(200, 1, true)
+
+	all.AddCall(100, 3, true)
+	all.AddCall(100, 50, false)
+	all.AddCall(200, 1, true)
+
+	merged := FuncGasStats{}
+	merged.Merge(&a)
+	merged.Merge(&b)
+	merged.Merge(&FuncGasStats{})
+
+	assert.Equal(t, all, merged)
+	assert.Equal(t, FuncGasStats{
+		GasUsedBuckets: []uint64{0, 1, 1, 0, 0, 0, 1},
+		Calls:          3,
+		Failures:       1,
+		TotalGasWanted: 400,
+		TotalGasUsed:   54,
+		MinGasUsed:     1,
+		MaxGasUsed:     50,
+	}, merged)
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"time"
+
+	"github.com/gnolang/gno/tm2/pkg/std"
+)
+
+// Genesis is the chain genesis state, beyond its transactions
+type Genesis struct {
+	GenesisTime time.Time        // time of the genesis
+	ChainID     string           // chain ID of the genesis
+	AuthParams  string           // Amino JSON auth module parameters
+	BankParams  string           // Amino JSON bank module parameters
+	VMParams    string           // Amino JSON VM module parameters
+	RealmParams string           // Amino JSON realm parameters, set by the VM module
+	Balances    []GenesisBalance // initial balances, in genesis order
+	Packages    []string         // package paths deployed by the genesis transactions, in genesis order
+}
+
+// GenesisBalance is the initial balance of a single address
+type GenesisBalance struct {
+	Address string    // bech32 address
+	Coins   std.Coins // initial coins
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+)
+
+// HashLength is the length of block and transaction hashes
+const HashLength = 32
+
+var errInvalidHash = errors.New("invalid hash")
+
+// hashDecoders are the supported hash encodings
+var hashDecoders = []func(string) ([]byte, error){
+	base64.StdEncoding.DecodeString,
+	base64.URLEncoding.DecodeString,
+	base64.RawURLEncoding.DecodeString,
+	hex.DecodeString,
+}
+
+// DecodeHash decodes the block or transaction hash,
+// encoded as standard base64, URL-safe base64 (padded or not) or hex
+func DecodeHash(hash string) ([]byte, error) {
+	for _, decode := range hashDecoders {
+		if decoded, err := decode(hash); err == nil && len(decoded) == HashLength {
+			return decoded, nil
+		}
+	}
+
+	return nil, errInvalidHash
+}
+
+// NormalizeHash converts the block or transaction hash to standard base64,
+// which is the format hashes are indexed and served in.
+// Hashes that can't be decoded are returned unchanged
+func NormalizeHash(hash string) string {
+	decoded, err := DecodeHash(hash)
+	if err != nil {
+		return hash
+	}
+
+	return base64.StdEncoding.EncodeToString(decoded)
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeHash(t *testing.T) {
+	t.Parallel()
+
+	// Make sure the hash contains bytes that differ between base64 encodings
+	hash := bytes.Repeat([]byte{0xfb, 0xff}, HashLength/2)
+
+	testTable := []struct {
+		name    string
+		encoded string
+	}{
+		{
+			"standard base64",
+			base64.StdEncoding.EncodeToString(hash),
+		},
+		{
+			"URL-safe base64",
+			base64.URLEncoding.EncodeToString(hash),
+		},
+		{
+			"unpadded URL-safe base64",
+			base64.RawURLEncoding.EncodeToString(hash),
+		},
+		{
+			"hex",
+			hex.EncodeToString(hash),
+		},
+		{
+			"uppercase hex",
+			strings.ToUpper(hex.EncodeToString(hash)),
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			decoded, err := DecodeHash(testCase.encoded)
+			require.NoError(t, err)
+
+			assert.Equal(t, hash, decoded)
+			assert.Equal(t, base64.StdEncoding.EncodeToString(hash), NormalizeHash(testCase.encoded))
+		})
+	}
+}
+
+func TestDecodeHash_Invalid(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name    string
+		encoded string
+	}{
+		{
+			"invalid encoding",
+			"totally invalid hash",
+		},
+		{
+			"invalid length",
+			base64.StdEncoding.EncodeToString([]byte("short")),
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := DecodeHash(testCase.encoded)
+			assert.ErrorIs(t, err, errInvalidHash)
+
+			// Make sure invalid hashes are left unchanged
+			assert.Equal(t, testCase.encoded, NormalizeHash(testCase.encoded))
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package types
+
+// TransferKind is the origin of a native coin movement
+type TransferKind string
+
+const (
+	TransferKindSend           TransferKind = "send"            // bank.MsgSend amount
+	TransferKindCall           TransferKind = "call"            // vm.MsgCall send, to the realm
+	TransferKindRun            TransferKind = "run"             // vm.MsgRun send, to the caller itself
+	TransferKindAddPackage     TransferKind = "add_package"     // vm.MsgAddPackage send, to the package
+	TransferKindFee            TransferKind = "fee"             // gas fee, to the fee collector
+	TransferKindStorageDeposit TransferKind = "storage_deposit" // storage deposit lock, to the realm deposit address
+	TransferKindStorageUnlock  TransferKind = "storage_unlock"  // storage deposit refund, from the realm deposit address
+)
+
+// Transfer is a single native coin movement of a successful transaction
+type Transfer struct {
+	From     string       // bech32 address of the sender
+	To       string       // bech32 address of the recipient
+	Denom    string       // denomination of the moved coins
+	Kind     TransferKind // origin of the movement
+	Amount   int64        // amount of the moved coins
+	Height   int64        // height of the transaction
+	Index    uint32       // index of the transaction within the block
+	Position uint32       // position of the movement within the transaction
+}
+
+// BalanceDelta is the net balance change of an address in a single denomination,
+// within a single block. Balances start from the genesis balance,
+// and only account for the indexed coin movements
+type BalanceDelta struct {
+	Address string // bech32 address
+	Denom   string // denomination of the balance
+	Delta   int64  // net balance change within the block
+	Balance int64  // balance after the block
+	Height  int64  // height of the block
+}
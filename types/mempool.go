@@ -0,0 +1,21 @@
+package types
+
+import "time"
+
+// PendingTxStatus is the inclusion status of a transaction seen in the node mempool
+type PendingTxStatus string
+
+const (
+	PendingTxPending  PendingTxStatus = "pending"  // the transaction is in the mempool
+	PendingTxIncluded PendingTxStatus = "included" // the transaction is included in an indexed block
+	PendingTxDropped  PendingTxStatus = "dropped"  // the transaction left the mempool without being included
+)
+
+// PendingTx is a transaction seen in the node mempool
+type PendingTx struct {
+	FirstSeen time.Time       // time the transaction was first seen in the mempool
+	Status    PendingTxStatus // inclusion status of the transaction
+	Tx        []byte          // amino encoded transaction
+	Hash      []byte          // hash of the transaction
+	Height    int64           // height of the block the transaction is included in, if any
+}
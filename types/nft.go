@@ -0,0 +1,43 @@
+package types
+
+// NFTEventKind is the kind of a GRC721 ownership change
+type NFTEventKind string
+
+const (
+	NFTEventMint     NFTEventKind = "mint"     // `Mint` event, the token is created
+	NFTEventTransfer NFTEventKind = "transfer" // `Transfer` event, the token changes owner
+	NFTEventBurn     NFTEventKind = "burn"     // `Burn` event, the token is destroyed
+)
+
+// NFTCollection is a GRC721 collection, known from its deployment or from its events
+type NFTCollection struct {
+	PkgPath         string // path of the realm the collection belongs to
+	Name            string // name of the collection, if announced at deployment
+	Symbol          string // symbol of the collection, the `slug` event attribute
+	Minted          uint64 // number of indexed mints
+	Burned          uint64 // number of indexed burns
+	Transfers       uint64 // number of indexed transfers, excluding mints and burns
+	FirstSeenHeight int64  // height the collection was first seen at
+}
+
+// NFT is the current ownership state of a single GRC721 token
+type NFT struct {
+	Collection string // path of the realm the token belongs to
+	TokenID    string // identifier of the token within the collection
+	Owner      string // bech32 address of the current owner, empty if burned
+	MintHeight int64  // height the token was minted at, 0 if not indexed
+	Height     int64  // height of the latest ownership change
+	Burned     bool   // whether the token was burned
+}
+
+// NFTEvent is a single GRC721 ownership change, part of the token provenance
+type NFTEvent struct {
+	Collection string       // path of the realm the token belongs to
+	TokenID    string       // identifier of the token within the collection
+	Kind       NFTEventKind // kind of the ownership change
+	From       string       // bech32 address of the previous owner, empty for mints
+	To         string       // bech32 address of the new owner, empty for burns
+	Height     int64        // height of the transaction
+	Index      uint32       // index of the transaction within the block
+	EventIndex uint32       // index of the event within the transaction
+}
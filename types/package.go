@@ -0,0 +1,24 @@
+package types
+
+// Package is a single package / realm deployment,
+// built from the indexed vm.MsgAddPackage messages
+type Package struct {
+	Path          string        // the gno path of the package
+	Name          string        // the name of the package
+	Creator       string        // bech32 address of the deployer
+	Files         []string      // names of the package source files
+	Imports       []string      // sorted import paths of the package source files
+	RecentCallers []PackageCall // most recent vm.MsgCall calls, newest first
+	DeployHeight  int64         // height of the deployment transaction
+	DeployIndex   uint32        // index of the deployment transaction within the block
+	Success       bool          // flag indicating if the deployment succeeded
+}
+
+// PackageCall is a single vm.MsgCall made to a package
+type PackageCall struct {
+	Caller  string // bech32 address of the caller
+	Func    string // name of the called function
+	Height  int64  // height of the call transaction
+	Index   uint32 // index of the call transaction within the block
+	Success bool   // flag indicating if the call succeeded
+}
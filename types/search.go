@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+)
+
+// SearchKind is the kind of content a search document was built from
+type SearchKind string
+
+const (
+	SearchKindPackageFile SearchKind = "package_file" // source file of a deployed package
+	SearchKindMemo        SearchKind = "memo"         // transaction memo
+	SearchKindEvent       SearchKind = "event"        // GnoEvent attribute value
+)
+
+// SearchDocument is a single piece of indexed text,
+// pointing back to the transaction it was taken from
+type SearchDocument struct {
+	Kind           SearchKind // the kind of the indexed content
+	PackagePath    string     // the package path, for package files and events
+	FileName       string     // the source file name, for package files
+	AttributeKey   string     // the attribute key, for events
+	Height         int64      // height of the transaction
+	Index          uint32     // index of the transaction within the block
+	EventIndex     uint32     // index of the event within the transaction, for events
+	AttributeIndex uint32     // index of the attribute within the event, for events
+	Length         uint32     // number of indexed terms
+}
+
+// ID returns the unique identifier of the document
+func (d *SearchDocument) ID() string {
+	switch d.Kind {
+	case SearchKindPackageFile:
+		return fmt.Sprintf("%s/%d/%d/%s/%s", d.Kind, d.Height, d.Index, d.PackagePath, d.FileName)
+	case SearchKindEvent:
+		return fmt.Sprintf("%s/%d/%d/%d/%d", d.Kind, d.Height, d.Index, d.EventIndex, d.AttributeIndex)
+	default:
+		return fmt.Sprintf("%s/%d/%d", d.Kind, d.Height, d.Index)
+	}
+}
+
+// SearchPosting is a single term occurrence list within a search document
+type SearchPosting struct {
+	Term       string     // the indexed term
+	DocumentID string     // the ID of the document containing the term
+	Kind       SearchKind // the kind of the document containing the term
+	Positions  []uint32   // sorted term positions within the document
+}
@@ -0,0 +1,34 @@
+package types
+
+// Token is a GRC20 token, known from its deployment or from its events
+type Token struct {
+	ID              string // token identifier, the `token` event attribute (ex. `gno.land/r/demo/foo20.FOO`)
+	PkgPath         string // path of the realm the token belongs to
+	Name            string // name of the token, if announced at deployment
+	Symbol          string // symbol of the token
+	Decimals        int64  // decimals of the token, if announced at deployment
+	TotalSupply     int64  // minted minus burned amount, from the indexed transfers
+	TransferCount   uint64 // number of indexed transfers, including mints and burns
+	FirstSeenHeight int64  // height the token was first seen at
+	Announced       bool   // whether the name, symbol and decimals were announced at deployment
+}
+
+// TokenTransfer is a single GRC20 token movement, from a `Transfer` event.
+// Mints have no sender, and burns have no recipient
+type TokenTransfer struct {
+	Token      string // token identifier
+	From       string // bech32 address of the sender, empty for mints
+	To         string // bech32 address of the recipient, empty for burns
+	Amount     int64  // amount of the moved tokens
+	Height     int64  // height of the transaction
+	Index      uint32 // index of the transaction within the block
+	EventIndex uint32 // index of the event within the transaction
+}
+
+// TokenBalance is the GRC20 token balance of a single address
+type TokenBalance struct {
+	Token   string // token identifier
+	Address string // bech32 address of the holder
+	Balance int64  // current balance, from the indexed transfers
+	Height  int64  // height of the latest balance change
+}
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/gnolang/gno/tm2/pkg/amino"
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
+)
+
+const (
+	msgTracesHeader   = "Msg Traces:\n"
+	gnoStackHeader    = "\nStacktrace:\n"
+	goStackHeader     = "\nStack Trace:\n"
+	errorFooter       = "--= /Error =--"
+	msgTraceSeparator = " - "
+)
+
+// TxError is the structured error of a failed transaction
+type TxError struct {
+	Codespace     string // amino package of the error type (ex. `std`, `vm`)
+	Code          string // amino type URL of the error (ex. `/std.OutOfGasError`)
+	Name          string // name of the error type (ex. `OutOfGasError`)
+	Message       string // most specific error message available
+	GnoStacktrace string // Gno VM stacktrace, if the transaction panicked in the VM
+}
+
+// RealmFailure is a failed transaction message calling a realm
+type RealmFailure struct {
+	PkgPath  string  // path of the called realm
+	Func     string  // name of the called function
+	Error    TxError // error of the transaction, without the Gno stacktrace
+	Height   int64   // height of the transaction
+	Index    uint32  // index of the transaction within the block
+	MsgIndex uint32  // index of the message within the transaction
+}
+
+// NewTxError decodes the error of the transaction response,
+// using the error log for the message and stacktrace details.
+// Returns nil if the transaction succeeded
+func NewTxError(response abci.ResponseDeliverTx) *TxError {
+	if response.IsOK() {
+		return nil
+	}
+
+	code := amino.GetTypeURL(response.Error)
+
+	codespace, name, found := strings.Cut(strings.TrimPrefix(code, "/"), ".")
+	if !found {
+		codespace, name = "", codespace
+	}
+
+	txError := &TxError{
+		Codespace:     codespace,
+		Code:          code,
+		Name:          name,
+		Message:       response.Error.Error(),
+		GnoStacktrace: parseGnoStacktrace(response.Log),
+	}
+
+	// String errors hold the actual error message,
+	// while typed errors only describe their type
+	if _, ok := response.Error.(abci.StringError); !ok {
+		if message := parseErrorMessage(response.Log); message != "" {
+			txError.Message = message
+		}
+	}
+
+	return txError
+}
+
+// parseErrorMessage extracts the message of the first
+// error trace from the error log, if any
+func parseErrorMessage(log string) string {
+	_, traces, found := strings.Cut(log, msgTracesHeader)
+	if !found {
+		return ""
+	}
+
+	trace, _, _ := strings.Cut(traces, "\n")
+
+	_, message, found := strings.Cut(trace, msgTraceSeparator)
+	if !found {
+		return ""
+	}
+
+	return strings.TrimSpace(message)
+}
+
+// parseGnoStacktrace extracts the Gno VM stacktrace from the error log, if any
+func parseGnoStacktrace(log string) string {
+	_, stacktrace, found := strings.Cut(log, gnoStackHeader)
+	if !found {
+		return ""
+	}
+
+	for _, end := range []string{goStackHeader, errorFooter} {
+		stacktrace, _, _ = strings.Cut(stacktrace, end)
+	}
+
+	return strings.TrimSpace(stacktrace)
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"testing"
+
+	abci "github.com/gnolang/gno/tm2/pkg/bft/abci/types"
+	"github.com/gnolang/gno/tm2/pkg/errors"
+	"github.com/gnolang/gno/tm2/pkg/sdk"
+	"github.com/gnolang/gno/tm2/pkg/std"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// deliverTxFromError creates a failed transaction response the same way the SDK does
+func deliverTxFromError(err error) abci.ResponseDeliverTx {
+	result := sdk.ABCIResultFromError(err)
+
+	return abci.ResponseDeliverTx{
+		ResponseBase: abci.ResponseBase{
+			Error: result.Error,
+			Log:   result.Log,
+		},
+	}
+}
+
+func TestNewTxError(t *testing.T) {
+	t.Parallel()
+
+	t.Run("successful transaction", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, NewTxError(abci.ResponseDeliverTx{}))
+	})
+
+	t.Run("typed error", func(t *testing.T) {
+		t.Parallel()
+
+		txError := NewTxError(deliverTxFromError(std.ErrUnauthorized("signature verification failed")))
+		require.NotNil(t, txError)
+
+		assert.Equal(t, &TxError{
+			Codespace: "std",
+			Code:      "/std.UnauthorizedError",
+			Name:      "UnauthorizedError",
+			Message:   "signature verification failed",
+		}, txError)
+	})
+
+	t.Run("typed error without log", func(t *testing.T) {
+		t.Parallel()
+
+		txError := NewTxError(abci.ResponseDeliverTx{
+			ResponseBase: abci.ResponseBase{
+				Error: std.OutOfGasError{},
+				Log:   "out of gas, gasWanted: 10, gasUsed: 11",
+			},
+		})
+		require.NotNil(t, txError)
+
+		assert.Equal(t, "/std.OutOfGasError", txError.Code)
+		assert.Equal(t, "out of gas error", txError.Message)
+		assert.Empty(t, txError.GnoStacktrace)
+	})
+
+	t.Run("VM panic", func(t *testing.T) {
+		t.Parallel()
+
+		stacktrace := "panic: boom\ncall Transfer()\n    gno.land/r/demo/foo/foo.gno:10"
+
+		err := errors.Wrapf(
+			errors.New("boom"),
+			"VM panic: %s\nStacktrace:\n%s\n",
+			"boom", stacktrace,
+		)
+
+		txError := NewTxError(deliverTxFromError(err))
+		require.NotNil(t, txError)
+
+		assert.Equal(t, "abci", txError.Codespace)
+		assert.Equal(t, "StringError", txError.Name)
+		assert.Equal(t, "boom", txError.Message)
+		assert.Equal(t, stacktrace, txError.GnoStacktrace)
+	})
+}
@@ -0,0 +1,24 @@
+package types
+
+// Validator is a single member of a validator set
+type Validator struct {
+	Address     string // bech32 address
+	PubKey      string // bech32 public key
+	VotingPower int64  // voting power within the set
+}
+
+// ValidatorSet is the validator set of the blocks starting at the given height,
+// up to the next validator set change
+type ValidatorSet struct {
+	Hash       []byte      // validators hash of the blocks the set applies to
+	Validators []Validator // members of the set, in set order
+	Height     int64       // height of the first block the set applies to
+}
+
+// BlockSignatures are the validator signatures of a single block,
+// as committed in the next block
+type BlockSignatures struct {
+	Signers []string // bech32 addresses of the validators that signed the block
+	Missed  []string // bech32 addresses of the validators of the block set that didn't sign it
+	Height  int64    // height of the signed block
+}